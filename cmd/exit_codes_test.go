@@ -58,6 +58,11 @@ func TestExitCodeFromError(t *testing.T) {
 			err:      asc.ErrConflict,
 			expected: ExitConflict,
 		},
+		{
+			name:     "blocking issues return blocking",
+			err:      shared.NewBlockingIssuesError(errors.New("validate: found 2 blocking issue(s)")),
+			expected: ExitBlocking,
+		},
 		{
 			name:     "generic error returns generic error",
 			err:      errors.New("something went wrong"),
@@ -106,6 +111,9 @@ func TestExitCodeConstants(t *testing.T) {
 	if ExitConflict != 5 {
 		t.Errorf("ExitConflict = %d, want 5", ExitConflict)
 	}
+	if ExitBlocking != 6 {
+		t.Errorf("ExitBlocking = %d, want 6", ExitBlocking)
+	}
 }
 
 func TestAPIErrorCodeToExitCode(t *testing.T) {
@@ -432,6 +440,7 @@ func isolatedCLITestEnv(configPath string) []string {
 		"ASC_PRIVATE_KEY_PATH",
 		"ASC_PRIVATE_KEY",
 		"ASC_PRIVATE_KEY_B64",
+		"ASC_PRIVATE_KEY_BASE64",
 		"ASC_PROFILE",
 		"ASC_CONFIG_PATH",
 		"ASC_BYPASS_KEYCHAIN",
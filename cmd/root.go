@@ -2,12 +2,16 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
+	"github.com/peterbourgon/ff/v3"
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/registry"
@@ -15,11 +19,15 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/suggest"
 )
 
-var versionRequested bool
+var (
+	versionRequested bool
+	configFile       string
+)
 
 // RootCommand returns the root command
 func RootCommand(version string) *ffcli.Command {
 	versionRequested = false
+	configFile = ""
 	root := &ffcli.Command{
 		Name:        "asc",
 		ShortUsage:  "asc <subcommand> [flags]",
@@ -31,7 +39,12 @@ func RootCommand(version string) *ffcli.Command {
 	}
 
 	root.FlagSet.BoolVar(&versionRequested, "version", false, "Print version and exit")
+	root.FlagSet.StringVar(&configFile, "config", "", "Read preference flags (output, app, profile, timeout, ...) from a JSON file keyed by flag name (explicit flags > config file > env)")
 	shared.BindRootFlags(root.FlagSet)
+	applyConfigFileOptions(root)
+	for _, sub := range root.Subcommands {
+		validateCommandScopedFlags(sub)
+	}
 
 	var (
 		rootSubcommandNames     []string
@@ -64,3 +77,105 @@ func RootCommand(version string) *ffcli.Command {
 
 	return root
 }
+
+// configFileKeys are the flag names a --config file may set. They are
+// session-wide preferences; guard flags such as --confirm, --force, and
+// --version are deliberately absent so a file can never bypass a prompt or
+// break every invocation.
+var configFileKeys = []string{
+	"app",
+	"base-url",
+	"no-color",
+	"no-truncate",
+	"output",
+	"pretty",
+	"profile",
+	"progress",
+	"quiet",
+	"rate-limit",
+	"retry-log",
+	"strict-auth",
+	"timeout",
+	"verbose",
+}
+
+// errInvalidConfigFile marks --config problems so they exit with ExitUsage.
+var errInvalidConfigFile = errors.New("invalid --config file")
+
+// configFileParser returns ff.JSONParser restricted to configFileKeys for
+// the command using fs. The "output" key only sets an output-format --output;
+// commands whose --output is a file or directory path ignore it.
+func configFileParser(fs *flag.FlagSet) ff.ConfigFileParser {
+	return func(r io.Reader, set func(name, value string) error) error {
+		err := ff.JSONParser(r, func(name, value string) error {
+			if !slices.Contains(configFileKeys, name) {
+				return fmt.Errorf("unsupported key %q (supported: %s)", name, strings.Join(configFileKeys, ", "))
+			}
+			if name == "output" && (fs == nil || !shared.IsOutputFormatFlag(fs.Lookup(name))) {
+				return nil
+			}
+			return set(name, value)
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", errInvalidConfigFile, err)
+		}
+		return nil
+	}
+}
+
+// applyConfigFileOptions lets every command in the tree read the
+// configFileKeys flags from the file named by the root --config flag. ff only
+// fills flags that were not set explicitly, and env fallbacks apply only to
+// still-empty flags, so the resulting precedence is flag > config file > env.
+// Keys a command does not define are skipped for that command.
+func applyConfigFileOptions(cmd *ffcli.Command) {
+	cmd.Options = append(cmd.Options,
+		ff.WithConfigFileVia(&configFile),
+		ff.WithConfigFileParser(configFileParser(cmd.FlagSet)),
+		ff.WithIgnoreUndefined(true),
+	)
+	for _, sub := range cmd.Subcommands {
+		applyConfigFileOptions(sub)
+	}
+}
+
+// validateCommandScopedFlags checks the pagination, --raw, list output,
+// --columns, and --interactive flags against the selected command's own flags before the
+// command runs.
+func validateCommandScopedFlags(cmd *ffcli.Command) {
+	for _, sub := range cmd.Subcommands {
+		validateCommandScopedFlags(sub)
+	}
+	if cmd.Exec == nil || cmd.FlagSet == nil {
+		return
+	}
+	exec := cmd.Exec
+	fs := cmd.FlagSet
+	cmd.Exec = func(ctx context.Context, args []string) error {
+		paginate := false
+		if f := fs.Lookup("paginate"); f != nil {
+			paginate = f.Value.String() == "true"
+		}
+		if err := shared.ValidatePaginationFlags(paginate); err != nil {
+			return err
+		}
+		listCommand := fs.Lookup("paginate") != nil || fs.Lookup("limit") != nil
+		format := ""
+		if f := fs.Lookup("output"); shared.IsOutputFormatFlag(f) {
+			format = f.Value.String()
+		}
+		if err := shared.ValidateRawFlags(format); err != nil {
+			return err
+		}
+		if err := shared.ValidateListOutputFlags(listCommand, format); err != nil {
+			return err
+		}
+		if err := shared.ValidateColumnsFlags(listCommand, format); err != nil {
+			return err
+		}
+		if err := shared.ValidateInteractiveFlag(fs.Lookup("confirm") != nil); err != nil {
+			return err
+		}
+		return exec(ctx, args)
+	}
+}
@@ -19,7 +19,7 @@ type rootCommandGroup struct {
 var rootUsageGroups = []rootCommandGroup{
 	{
 		title:    "GETTING STARTED COMMANDS",
-		commands: []string{"auth", "doctor", "install-skills", "init", "docs"},
+		commands: []string{"auth", "doctor", "config", "install-skills", "init", "docs"},
 	},
 	{
 		title:    "EXPERIMENTAL COMMANDS",
@@ -50,7 +50,7 @@ var rootUsageGroups = []rootCommandGroup{
 	},
 	{
 		title:    "REVIEW & RELEASE COMMANDS",
-		commands: []string{"review", "reviews", "submit", "validate", "publish"},
+		commands: []string{"review", "reviews", "submit", "validate", "publish", "changelog"},
 	},
 	{
 		title:    "MONETIZATION COMMANDS",
@@ -70,7 +70,7 @@ var rootUsageGroups = []rootCommandGroup{
 	},
 	{
 		title:    "UTILITY COMMANDS",
-		commands: []string{"version", "completion"},
+		commands: []string{"get", "patch", "open", "version", "completion", "help"},
 	},
 }
 
@@ -196,11 +196,16 @@ func writeRootFlags(b *strings.Builder, fs *flag.FlagSet) {
 		if f.Name == "output" {
 			usage = strings.Replace(usage, "json (default),", "json,", 1)
 		}
+		// Single-letter flags are shorthands and render with one dash.
+		name := "--" + f.Name
+		if len(f.Name) == 1 {
+			name = "-" + f.Name
+		}
 		if f.DefValue != "" {
-			_, _ = fmt.Fprintf(tw, "  --%s\t%s (default: %s)\n", f.Name, usage, f.DefValue)
+			_, _ = fmt.Fprintf(tw, "  %s\t%s (default: %s)\n", name, usage, f.DefValue)
 			return
 		}
-		_, _ = fmt.Fprintf(tw, "  --%s\t%s\n", f.Name, usage)
+		_, _ = fmt.Fprintf(tw, "  %s\t%s\n", name, usage)
 	})
 	_ = tw.Flush()
 	b.WriteString("\n")
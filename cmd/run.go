@@ -64,13 +64,24 @@ func Run(args []string, versionInfo string) int {
 		return ExitSuccess
 	}
 
+	// Get the selected command and its full subcommand path
+	selected, commandPath := selectedCommand(root, args)
+	commandName := strings.Join(commandPath, " ")
+	jsonErrors := wantsJSONErrors(selected)
+	shared.SetJSONUsageErrors(jsonErrors)
+	defer shared.SetJSONUsageErrors(false)
+	var printUsage func()
+	if jsonErrors {
+		// Usage text would corrupt the JSON error on stderr; it is printed
+		// below only for usage errors that were not deferred.
+		printUsage = selected.FlagSet.Usage
+		selected.FlagSet.Usage = func() {}
+	}
+
 	start := time.Now()
 	runErr := root.Run(runCtx)
 	elapsed := time.Since(start)
 
-	// Get command name (full subcommand path)
-	commandName := getCommandName(root, args)
-
 	// Write JUnit report if requested
 	if shared.ReportFormat() == shared.ReportFormatJUnit && shared.ReportFile() != "" {
 		reportErr := writeJUnitReport(commandName, runErr, elapsed)
@@ -84,12 +95,29 @@ func Run(args []string, versionInfo string) int {
 	}
 
 	if runErr != nil {
-		if _, ok := errors.AsType[shared.ReportedError](runErr); ok {
-			return ExitCodeFromError(runErr)
-		}
 		if errors.Is(runErr, flag.ErrHelp) {
+			if jsonErrors {
+				if shared.IsDeferredUsageError(runErr) {
+					fmt.Fprint(os.Stderr, errfmt.FormatStderrJSON(runErr))
+				} else if printUsage != nil {
+					printUsage()
+				}
+			}
 			return ExitUsage
 		}
+		if jsonErrors {
+			// Reported errors still get a JSON error so scripts can rely on
+			// stderr; the report itself went to stdout.
+			fmt.Fprint(os.Stderr, errfmt.FormatStderrJSON(runErr))
+			return ExitCodeFromError(runErr)
+		}
+		if _, ok := errors.AsType[shared.ReportedError](runErr); ok {
+			// --quiet suppressed the output that reported this error, so surface it.
+			if shared.Quiet() {
+				fmt.Fprint(os.Stderr, errfmt.FormatStderr(runErr))
+			}
+			return ExitCodeFromError(runErr)
+		}
 		fmt.Fprint(os.Stderr, errfmt.FormatStderr(runErr))
 		return ExitCodeFromError(runErr)
 	}
@@ -111,8 +139,14 @@ func isVersionOnlyInvocation(args []string) bool {
 
 // getCommandName extracts the full subcommand path from the parsed args.
 // args is os.Args[1:] (without program name).
-// It finds the first token matching a known subcommand name, then walks the tree.
 func getCommandName(root *ffcli.Command, args []string) string {
+	_, path := selectedCommand(root, args)
+	return strings.Join(path, " ")
+}
+
+// selectedCommand returns the subcommand the args select along with its path.
+// It finds the first token matching a known subcommand name, then walks the tree.
+func selectedCommand(root *ffcli.Command, args []string) (*ffcli.Command, []string) {
 	current := root
 	path := []string{current.Name}
 
@@ -145,7 +179,25 @@ func getCommandName(root *ffcli.Command, args []string) string {
 		break
 	}
 
-	return strings.Join(path, " ")
+	return current, path
+}
+
+// wantsJSONErrors reports whether the selected command was run with an
+// explicit --output json, in which case errors are written to stderr as JSON.
+func wantsJSONErrors(cmd *ffcli.Command) bool {
+	if cmd == nil || cmd.FlagSet == nil {
+		return false
+	}
+	explicit := false
+	cmd.FlagSet.Visit(func(f *flag.Flag) {
+		if f.Name == "output" {
+			explicit = true
+		}
+	})
+	if !explicit {
+		return false
+	}
+	return strings.EqualFold(strings.TrimSpace(cmd.FlagSet.Lookup("output").Value.String()), "json")
 }
 
 func findDirectSubcommand(current *ffcli.Command, token string) *ffcli.Command {
@@ -123,6 +123,149 @@ func TestRun_NoArgsShowsHelpReturnsSuccess(t *testing.T) {
 	}
 }
 
+func TestRun_QuietSuppressesOutputButReportsFailures(t *testing.T) {
+	resetReportFlags(t)
+
+	dir := t.TempDir()
+	appInfoDir := filepath.Join(dir, "app-info")
+	if err := os.MkdirAll(appInfoDir, 0o755); err != nil {
+		t.Fatalf("mkdir app-info: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(appInfoDir, "en-US.json"), []byte(`{"subtitle":"Only subtitle"}`), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	stdout, stderr := captureCommandOutput(t, func() {
+		code := Run([]string{"--quiet", "metadata", "validate", "--dir", dir}, "1.0.0")
+		if code != ExitBlocking {
+			t.Fatalf("Run() exit code = %d, want %d", code, ExitBlocking)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected --quiet to suppress stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "metadata validate: found") {
+		t.Fatalf("expected reported failure on stderr, got %q", stderr)
+	}
+}
+
+func TestRun_ConfigFileSuppliesFlagValues(t *testing.T) {
+	resetReportFlags(t)
+
+	configPath := filepath.Join(t.TempDir(), "asc.json")
+	if err := os.WriteFile(configPath, []byte(`{"timeout": "soon"}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	_, stderr := captureCommandOutput(t, func() {
+		code := Run([]string{"--config", configPath, "completion", "--shell", "bash"}, "1.0.0")
+		if code != ExitUsage {
+			t.Fatalf("Run() exit code = %d, want %d", code, ExitUsage)
+		}
+	})
+	if !strings.Contains(stderr, `"timeout"`) {
+		t.Fatalf("expected config file value to reach --timeout, got %q", stderr)
+	}
+}
+
+func TestRun_ExplicitFlagOverridesConfigFile(t *testing.T) {
+	resetReportFlags(t)
+
+	configPath := filepath.Join(t.TempDir(), "asc.json")
+	if err := os.WriteFile(configPath, []byte(`{"timeout": "soon"}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	stdout, _ := captureCommandOutput(t, func() {
+		code := Run([]string{"--config", configPath, "--timeout", "5s", "completion", "--shell", "bash"}, "1.0.0")
+		if code != ExitSuccess {
+			t.Fatalf("Run() exit code = %d, want %d", code, ExitSuccess)
+		}
+	})
+	if strings.TrimSpace(stdout) == "" {
+		t.Fatal("expected bash completion script on stdout")
+	}
+}
+
+func TestConfigFileOutputOnlySetsOutputFormatFlags(t *testing.T) {
+	root := RootCommand("1.0.0")
+	find := func(path ...string) *flag.FlagSet {
+		t.Helper()
+		cmd := root
+		for _, name := range path {
+			found := false
+			for _, sub := range cmd.Subcommands {
+				if sub.Name == name {
+					cmd, found = sub, true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("command %q not found", strings.Join(path, " "))
+			}
+		}
+		return cmd.FlagSet
+	}
+
+	for _, test := range []struct {
+		path []string
+		want string
+	}{
+		{path: []string{"apps", "list"}, want: "table"},
+		{path: []string{"signing", "fetch"}, want: "./signing"},
+		{path: []string{"certificates", "download"}, want: ""},
+	} {
+		fs := find(test.path...)
+		if err := configFileParser(fs)(strings.NewReader(`{"output": "table"}`), fs.Set); err != nil {
+			t.Fatalf("%s: configFileParser() error: %v", strings.Join(test.path, " "), err)
+		}
+		if got := fs.Lookup("output").Value.String(); got != test.want {
+			t.Fatalf("%s: expected --output %q, got %q", strings.Join(test.path, " "), test.want, got)
+		}
+	}
+}
+
+func TestRun_ConfigFileRejectsUnsupportedKeys(t *testing.T) {
+	for _, body := range []string{`{"confirm": true}`, `{"version": "1.2.3"}`, `{"force": true}`, `{"shell": "bash"}`} {
+		t.Run(body, func(t *testing.T) {
+			resetReportFlags(t)
+
+			configPath := filepath.Join(t.TempDir(), "asc.json")
+			if err := os.WriteFile(configPath, []byte(body), 0o600); err != nil {
+				t.Fatalf("WriteFile() error: %v", err)
+			}
+
+			stdout, stderr := captureCommandOutput(t, func() {
+				code := Run([]string{"--config", configPath, "completion", "--shell", "bash"}, "1.0.0")
+				if code != ExitUsage {
+					t.Fatalf("Run() exit code = %d, want %d", code, ExitUsage)
+				}
+			})
+			if stdout != "" {
+				t.Fatalf("expected no stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, "unsupported key") {
+				t.Fatalf("expected unsupported key error, got %q", stderr)
+			}
+		})
+	}
+}
+
+func TestRun_MissingConfigFileReturnsError(t *testing.T) {
+	resetReportFlags(t)
+
+	_, stderr := captureCommandOutput(t, func() {
+		code := Run([]string{"--config", filepath.Join(t.TempDir(), "missing.json"), "completion", "--shell", "bash"}, "1.0.0")
+		if code == ExitSuccess {
+			t.Fatal("expected non-zero exit code for missing config file")
+		}
+	})
+	if !strings.Contains(stderr, "missing.json") {
+		t.Fatalf("expected missing config path in stderr, got %q", stderr)
+	}
+}
+
 func TestHasPositionalArgs_EndOfFlagsSeparator(t *testing.T) {
 	root := RootCommand("1.0.0")
 
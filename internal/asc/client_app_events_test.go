@@ -739,6 +739,8 @@ func TestAppEventMethodsRequireIDs(t *testing.T) {
 }
 
 func TestAppEventMethodsReturnAPIError(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "0")
+
 	response := jsonResponse(http.StatusInternalServerError, `{"errors":[{"title":"Server error","detail":"boom"}]}`)
 	tests := []struct {
 		name string
@@ -206,8 +206,27 @@ func (c *Client) DeleteAppScreenshotSet(ctx context.Context, setID string) error
 }
 
 // GetAppScreenshots retrieves screenshots for a set.
-func (c *Client) GetAppScreenshots(ctx context.Context, setID string) (*AppScreenshotsResponse, error) {
+func (c *Client) GetAppScreenshots(ctx context.Context, setID string, opts ...AppScreenshotsOption) (*AppScreenshotsResponse, error) {
+	query := &appScreenshotsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
+	setID = strings.TrimSpace(setID)
+	if query.nextURL == "" && setID == "" {
+		return nil, fmt.Errorf("setID is required")
+	}
+
 	path := fmt.Sprintf("/v1/appScreenshotSets/%s/appScreenshots", setID)
+	if query.nextURL != "" {
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("appScreenshots: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildAppScreenshotsQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
+
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -28,6 +29,8 @@ const (
 	// tokenLifetime is the JWT token lifetime for App Store Connect API authentication.
 	// 10 minutes is a good balance between security (shorter-lived tokens) and usability.
 	tokenLifetime = 10 * time.Minute
+	// MaxTokenLifetime is the longest JWT lifetime App Store Connect accepts.
+	MaxTokenLifetime = 20 * time.Minute
 	// jwtRefreshSkew refreshes a token a bit early to avoid edge-of-expiry races.
 	jwtRefreshSkew = 30 * time.Second
 
@@ -65,6 +68,16 @@ var retryLogOverride struct {
 	val *bool
 }
 
+var baseURLOverride struct {
+	mu  sync.RWMutex
+	val string
+}
+
+var timeoutOverride struct {
+	mu  sync.RWMutex
+	val time.Duration
+}
+
 var debugOverride struct {
 	mu          sync.RWMutex
 	enabled     *bool
@@ -91,6 +104,14 @@ func SetRetryLogOverride(value *bool) {
 	retryLogOverride.val = value
 }
 
+// SetTimeoutOverride sets an explicit request timeout.
+// When positive, it takes precedence over env/config and command defaults. 0 clears it.
+func SetTimeoutOverride(value time.Duration) {
+	timeoutOverride.mu.Lock()
+	defer timeoutOverride.mu.Unlock()
+	timeoutOverride.val = value
+}
+
 // SetDebugOverride sets an explicit debug override.
 // When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
 func SetDebugOverride(value *bool) {
@@ -223,6 +244,63 @@ func setConfigLoaderForTest(loader func() (*config.Config, error)) {
 	configLoaded = false
 }
 
+// SetBaseURLOverride sets an explicit API base URL (from --base-url). It must
+// already be normalized with NormalizeBaseURL. An empty value clears it.
+func SetBaseURLOverride(value string) {
+	baseURLOverride.mu.Lock()
+	defer baseURLOverride.mu.Unlock()
+	baseURLOverride.val = value
+}
+
+// NormalizeBaseURL validates an API base URL override and trims any trailing
+// slash. Only absolute https URLs without a query or fragment are accepted.
+func NormalizeBaseURL(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL: %w", err)
+	}
+	if parsed.Scheme != "https" || parsed.Host == "" || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", fmt.Errorf("base URL must be an absolute https URL, got %q", value)
+	}
+	return strings.TrimRight(value, "/"), nil
+}
+
+// ResolveBaseURL returns the App Store Connect API base URL requests are sent
+// to (for example, a regional endpoint or a mock server).
+// Precedence: explicit override > ASC_BASE_URL > BaseURL. An invalid
+// ASC_BASE_URL is an error rather than a silent fallback to production.
+func ResolveBaseURL() (string, error) {
+	baseURLOverride.mu.RLock()
+	override := baseURLOverride.val
+	baseURLOverride.mu.RUnlock()
+	if override != "" {
+		return override, nil
+	}
+	if value, ok := envValue("ASC_BASE_URL"); ok && value != "" {
+		normalized, err := NormalizeBaseURL(value)
+		if err != nil {
+			return "", fmt.Errorf("ASC_BASE_URL: %w", err)
+		}
+		return normalized, nil
+	}
+	return BaseURL, nil
+}
+
+// ResolveBaseHost returns the host of ResolveBaseURL, used to decide which
+// absolute URLs (such as pagination links) may be followed.
+func ResolveBaseHost() (string, error) {
+	baseURL, err := ResolveBaseURL()
+	if err != nil {
+		return "", err
+	}
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL: %w", err)
+	}
+	return parsed.Host, nil
+}
+
 func envValue(name string) (string, bool) {
 	value, ok := os.LookupEnv(name)
 	return strings.TrimSpace(value), ok
@@ -232,6 +310,7 @@ func envValue(name string) (string, bool) {
 type RetryableError struct {
 	Err        error
 	RetryAfter time.Duration
+	StatusCode int // HTTP status that triggered the retry, when known
 }
 
 func (e *RetryableError) Error() string {
@@ -321,6 +400,23 @@ func ResolveRetryOptions() RetryOptions {
 	return opts
 }
 
+// WithMaxRetries overrides the configured retry count for this client.
+// 0 disables retries; negative values use DefaultMaxRetries.
+func WithMaxRetries(n int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = &n
+	}
+}
+
+// retryOptions resolves retry options from config/env and applies client overrides.
+func (c *Client) retryOptions() RetryOptions {
+	opts := ResolveRetryOptions()
+	if c.maxRetries != nil {
+		opts.MaxRetries = *c.maxRetries
+	}
+	return opts
+}
+
 // WithRetry executes a function with retry logic for rate limiting.
 // It uses exponential backoff with jitter and respects Retry-After headers.
 func WithRetry[T any](ctx context.Context, fn func() (T, error), opts RetryOptions) (T, error) {
@@ -408,6 +504,47 @@ func logRetry(delay time.Duration, attempt, maxRetries int, err error) {
 	retryLogger.Info("retrying request", "delay", delay.String(), "attempt", attempt, "maxRetries", maxRetries, "error", err)
 }
 
+// transientRetryDelay is the pause before RetryOnce repeats a failed call.
+var transientRetryDelay = 250 * time.Millisecond
+
+// IsTransient reports whether err may go away if the request is repeated.
+// Cancellation and definitive API answers (not found, unauthorized,
+// forbidden, bad request, conflict, or any other 4xx) are not transient.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	for _, definitive := range []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrBadRequest, ErrConflict} {
+		if errors.Is(err, definitive) {
+			return false
+		}
+	}
+	if apiErr, ok := errors.AsType[*APIError](err); ok && apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 {
+		return false
+	}
+	return true
+}
+
+// RetryOnce calls fn and, if it fails with a transient error, calls it one
+// more time after a short pause. Validators use it so a single network blip
+// does not turn into a misleading finding.
+func RetryOnce[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	result, err := fn()
+	if !IsTransient(err) {
+		return result, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return result, err
+	case <-time.After(transientRetryDelay):
+	}
+	return fn()
+}
+
 // ResolveTimeout returns the request timeout, optionally overridden by config/env.
 func ResolveTimeout() time.Duration {
 	return ResolveTimeoutWithDefault(DefaultTimeout)
@@ -426,8 +563,16 @@ func ResolveUploadTimeout() time.Duration {
 }
 
 // ResolveTimeoutWithDefault returns the request timeout using a custom default.
-// ASC_TIMEOUT and ASC_TIMEOUT_SECONDS override the default when set.
+// An explicit override (--timeout) wins; otherwise ASC_TIMEOUT and
+// ASC_TIMEOUT_SECONDS override the default when set.
 func ResolveTimeoutWithDefault(defaultTimeout time.Duration) time.Duration {
+	timeoutOverride.mu.RLock()
+	override := timeoutOverride.val
+	timeoutOverride.mu.RUnlock()
+	if override > 0 {
+		return override
+	}
+
 	cfg := loadConfig()
 	var timeout config.DurationValue
 	var timeoutSeconds config.DurationValue
@@ -475,25 +620,42 @@ type Client struct {
 	jwtMu              sync.Mutex
 	cachedJWT          string
 	cachedJWTExpiresAt time.Time
+
+	responseCache *responseCache
+	maxRetries    *int
+	rateLimiter   *rateLimiter
 }
 
+// KeyID returns the API key ID the client signs requests with.
+func (c *Client) KeyID() string {
+	return c.keyID
+}
+
+// IssuerID returns the issuer ID the client signs requests with.
+func (c *Client) IssuerID() string {
+	return c.issuerID
+}
+
+// ClientOption configures optional client behavior.
+type ClientOption func(*Client)
+
 // NewClient creates a new ASC client.
-func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
-	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, newDefaultHTTPClient(ResolveTimeout()))
+func NewClient(keyID, issuerID, privateKeyPath string, opts ...ClientOption) (*Client, error) {
+	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, newDefaultHTTPClient(ResolveTimeout()), opts...)
 }
 
 // NewClientWithHTTPClient creates a new ASC client using the provided HTTP client.
 // If httpClient is nil, a default client with ASC timeouts is used.
-func NewClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+func NewClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if httpClient == nil {
 		httpClient = newDefaultHTTPClient(ResolveTimeout())
 	}
-	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, httpClient)
+	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, httpClient, opts...)
 }
 
 // NewClientFromPEM creates a new ASC client from in-memory private key PEM content.
-func NewClientFromPEM(keyID, issuerID, privateKeyPEM string) (*Client, error) {
-	return newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM, newDefaultHTTPClient(ResolveTimeout()))
+func NewClientFromPEM(keyID, issuerID, privateKeyPEM string, opts ...ClientOption) (*Client, error) {
+	return newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM, newDefaultHTTPClient(ResolveTimeout()), opts...)
 }
 
 func newDefaultHTTPClient(timeout time.Duration) *http.Client {
@@ -517,7 +679,7 @@ func newDefaultHTTPClient(timeout time.Duration) *http.Client {
 	}
 }
 
-func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if err := auth.ValidateKeyFile(privateKeyPath); err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
@@ -527,23 +689,29 @@ func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient
 		return nil, fmt.Errorf("failed to load private key: %w", err)
 	}
 
-	return newClientWithPrivateKey(keyID, issuerID, key, httpClient), nil
+	return newClientWithPrivateKey(keyID, issuerID, key, httpClient, opts...), nil
 }
 
-func newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM string, httpClient *http.Client) (*Client, error) {
+func newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	key, err := auth.LoadPrivateKeyFromPEM([]byte(privateKeyPEM))
 	if err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
 
-	return newClientWithPrivateKey(keyID, issuerID, key, httpClient), nil
+	return newClientWithPrivateKey(keyID, issuerID, key, httpClient, opts...), nil
 }
 
-func newClientWithPrivateKey(keyID, issuerID string, privateKey *ecdsa.PrivateKey, httpClient *http.Client) *Client {
-	return &Client{
-		httpClient: httpClient,
+func newClientWithPrivateKey(keyID, issuerID string, privateKey *ecdsa.PrivateKey, httpClient *http.Client, opts ...ClientOption) *Client {
+	client := &Client{
+		httpClient: withVerboseTransport(withHTTPFixtures(httpClient)),
 		keyID:      keyID,
 		issuerID:   issuerID,
 		privateKey: privateKey,
 	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(client)
+		}
+	}
+	return client
 }
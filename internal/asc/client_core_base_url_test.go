@@ -0,0 +1,87 @@
+package asc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResolveBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		set     bool
+		want    string
+		wantErr bool
+	}{
+		{name: "unset", want: BaseURL},
+		{name: "empty", value: "  ", set: true, want: BaseURL},
+		{name: "override", value: "https://asc-mock.example.com/", set: true, want: "https://asc-mock.example.com"},
+		{name: "override with port", value: "https://localhost:8443", set: true, want: "https://localhost:8443"},
+		{name: "insecure scheme rejected", value: "http://asc-mock.example.com", set: true, wantErr: true},
+		{name: "relative rejected", value: "/v1", set: true, wantErr: true},
+		{name: "query rejected", value: "https://asc-mock.example.com?x=1", set: true, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.set {
+				t.Setenv("ASC_BASE_URL", test.value)
+			} else {
+				t.Setenv("ASC_BASE_URL", "")
+			}
+			got, err := ResolveBaseURL()
+			if test.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "ASC_BASE_URL") {
+					t.Fatalf("ResolveBaseURL() = %q, %v; want ASC_BASE_URL error", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveBaseURL() error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("ResolveBaseURL() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidateNextURL_UsesConfiguredBaseHost(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "https://asc-mock.example.com")
+
+	if err := validateNextURL("https://asc-mock.example.com/v1/apps?cursor=abc"); err != nil {
+		t.Fatalf("validateNextURL() error: %v", err)
+	}
+	err := validateNextURL("https://api.appstoreconnect.apple.com/v1/apps?cursor=abc")
+	if err == nil || !strings.Contains(err.Error(), `expected "asc-mock.example.com"`) {
+		t.Fatalf("expected untrusted host error, got %v", err)
+	}
+	if got, err := resolveRequestURL("/v1/apps"); err != nil || got != "https://asc-mock.example.com/v1/apps" {
+		t.Fatalf("resolveRequestURL() = %q, %v", got, err)
+	}
+}
+
+func TestResolveBaseURL_OverrideWinsOverEnv(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "https://env.example.com")
+	SetBaseURLOverride("https://flag.example.com")
+	t.Cleanup(func() { SetBaseURLOverride("") })
+
+	if got, _ := ResolveBaseURL(); got != "https://flag.example.com" {
+		t.Fatalf("ResolveBaseURL() = %q, want override", got)
+	}
+	if got, _ := ResolveBaseHost(); got != "flag.example.com" {
+		t.Fatalf("ResolveBaseHost() = %q, want flag.example.com", got)
+	}
+
+	SetBaseURLOverride("")
+	if got, _ := ResolveBaseURL(); got != "https://env.example.com" {
+		t.Fatalf("ResolveBaseURL() after clear = %q, want env value", got)
+	}
+}
+
+func TestNormalizeBaseURL_RejectsInvalidValues(t *testing.T) {
+	for _, value := range []string{"", "http://localhost:8443", "localhost:8443", "https://x.example.com/#frag"} {
+		if _, err := NormalizeBaseURL(value); err == nil {
+			t.Fatalf("NormalizeBaseURL(%q) expected error", value)
+		}
+	}
+}
@@ -22,3 +22,21 @@ func TestResolveUploadTimeout_UsesUploadTimeoutEnv(t *testing.T) {
 		t.Fatalf("ResolveUploadTimeout() = %s, want 17s", got)
 	}
 }
+
+func TestResolveTimeout_OverrideWinsOverEnvAndDefault(t *testing.T) {
+	t.Setenv("ASC_TIMEOUT", "17s")
+	SetTimeoutOverride(5 * time.Minute)
+	t.Cleanup(func() { SetTimeoutOverride(0) })
+
+	if got := ResolveTimeout(); got != 5*time.Minute {
+		t.Fatalf("ResolveTimeout() = %s, want 5m0s", got)
+	}
+	if got := ResolveTimeoutWithDefault(time.Hour); got != 5*time.Minute {
+		t.Fatalf("ResolveTimeoutWithDefault() = %s, want 5m0s", got)
+	}
+
+	SetTimeoutOverride(0)
+	if got := ResolveTimeout(); got != 17*time.Second {
+		t.Fatalf("ResolveTimeout() after clear = %s, want 17s", got)
+	}
+}
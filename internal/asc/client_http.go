@@ -25,11 +25,11 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 		return nil, fmt.Errorf("failed to generate JWT: %w", err)
 	}
 
-	url := path
-	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
-		url = BaseURL + path
+	requestURL, err := resolveRequestURL(path)
+	if err != nil {
+		return nil, err
 	}
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -41,6 +41,19 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 	return req, nil
 }
 
+// resolveRequestURL resolves an API path against ResolveBaseURL. Absolute URLs
+// (e.g. pagination links) are returned unchanged.
+func resolveRequestURL(path string) (string, error) {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path, nil
+	}
+	baseURL, err := ResolveBaseURL()
+	if err != nil {
+		return "", err
+	}
+	return baseURL + path, nil
+}
+
 // generateJWT generates a JWT for ASC API authentication
 func (c *Client) generateJWT() (string, error) {
 	now := time.Now()
@@ -64,12 +77,29 @@ func (c *Client) generateJWT() (string, error) {
 
 // GenerateJWT generates a JWT for ASC API authentication.
 func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string, error) {
+	return signJWT(keyID, issuerID, privateKey, time.Now(), tokenLifetime)
+}
+
+// SignToken signs a fresh JWT with the client's credentials that expires after
+// ttl. It bypasses the request token cache.
+func (c *Client) SignToken(ttl time.Duration) (string, time.Time, error) {
+	if ttl < time.Second || ttl > MaxTokenLifetime {
+		return "", time.Time{}, fmt.Errorf("token lifetime must be between 1s and %s", MaxTokenLifetime)
+	}
 	now := time.Now()
+	signedToken, err := signJWT(c.keyID, c.issuerID, c.privateKey, now, ttl)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signedToken, jwt.NewNumericDate(now.Add(ttl)).Time, nil
+}
+
+func signJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey, now time.Time, ttl time.Duration) (string, error) {
 	claims := jwt.RegisteredClaims{
 		Issuer:    issuerID,
 		Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
 		IssuedAt:  jwt.NewNumericDate(now),
-		ExpiresAt: jwt.NewNumericDate(now.Add(tokenLifetime)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
@@ -85,8 +115,19 @@ func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string,
 }
 
 // do performs an HTTP request and returns the response.
-// GET/HEAD requests use retry logic for rate limiting by default.
+// GET/HEAD requests are retried on 429 and 5xx responses; other methods are
+// retried only on 429 and 503. GET responses are served from the response
+// cache when one is configured. Successful response bodies are recorded for
+// --raw while raw capture is enabled.
 func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	data, err := c.doRetrying(ctx, method, path, body)
+	if err == nil {
+		recordRawResponse(data)
+	}
+	return data, err
+}
+
+func (c *Client) doRetrying(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
 	var bodyBytes []byte
 	if body != nil {
 		var err error
@@ -96,23 +137,50 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 		}
 	}
 
-	request := func() ([]byte, error) {
+	idempotent := shouldRetryMethod(method)
+	attempt := func() ([]byte, error) {
 		var reader io.Reader
 		if bodyBytes != nil {
 			reader = bytes.NewReader(bodyBytes)
 		}
-		return c.doOnce(ctx, method, path, reader)
+		data, err := c.doOnce(ctx, method, path, reader)
+		if err != nil && !idempotent {
+			// A write that failed with a generic server error may have been
+			// applied, so only retry when the server rejected it outright.
+			if re, ok := errors.AsType[*RetryableError](err); ok && !isRetryableWriteStatus(re.StatusCode) {
+				return nil, re.Err
+			}
+		}
+		return data, err
+	}
+
+	retryOpts := c.retryOptions()
+	request := func() ([]byte, error) {
+		return WithRetry(ctx, attempt, retryOpts)
 	}
 
-	if shouldRetryMethod(method) {
-		retryOpts := ResolveRetryOptions()
-		return WithRetry(ctx, request, retryOpts)
+	if c.responseCache == nil {
+		return request()
+	}
+	if isCacheableMethod(method) {
+		key, err := responseCacheKey(method, path)
+		if err != nil {
+			return nil, err
+		}
+		return c.responseCache.get(ctx, key, request)
 	}
 
-	return request()
+	data, err := request()
+	if err == nil {
+		c.responseCache.clear()
+	}
+	return data, err
 }
 
 func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	if err := c.rateLimiter.wait(ctx); err != nil {
+		return nil, err
+	}
 	start := time.Now()
 	debugSettings := resolveDebugSettings()
 
@@ -162,13 +230,24 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 			return nil, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, respBody),
 				RetryAfter: retryAfter,
+				StatusCode: resp.StatusCode,
 			}
 		}
 
-		if err := ParseErrorWithStatus(respBody, resp.StatusCode); err != nil {
-			return nil, err
+		apiErr := parseErrorResponse(resp, respBody)
+		if apiErr == nil {
+			apiErr = fmt.Errorf("API request failed with status %d", resp.StatusCode)
 		}
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		// Other server errors are usually transient; keep the parsed API error
+		// so callers can still inspect it once retries are exhausted.
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return nil, &RetryableError{
+				Err:        apiErr,
+				RetryAfter: parseRetryAfterHeader(resp.Header.Get("Retry-After")),
+				StatusCode: resp.StatusCode,
+			}
+		}
+		return nil, apiErr
 	}
 
 	return io.ReadAll(resp.Body)
@@ -222,6 +301,12 @@ func shouldRetryMethod(method string) bool {
 	}
 }
 
+// isRetryableWriteStatus reports whether a non-idempotent request can be safely
+// retried after the given status, i.e. the server did not process it.
+func isRetryableWriteStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
 func buildRetryableError(statusCode int, retryAfter time.Duration, respBody []byte) error {
 	base := "API request failed"
 	switch statusCode {
@@ -274,7 +359,7 @@ func parseRetryAfterHeader(value string) time.Duration {
 }
 
 // validateNextURL validates that a pagination URL is safe to use.
-// It ensures the URL is on the same host as BaseURL and uses HTTPS.
+// It ensures the URL is on the same host as ResolveBaseURL and uses HTTPS.
 func validateNextURL(nextURL string) error {
 	if nextURL == "" {
 		return nil
@@ -291,14 +376,13 @@ func validateNextURL(nextURL string) error {
 		return fmt.Errorf("invalid pagination URL: %w", err)
 	}
 
-	baseURL, err := url.Parse(BaseURL)
+	// Allow URLs on the same host requests are sent to
+	baseHost, err := ResolveBaseHost()
 	if err != nil {
-		return fmt.Errorf("invalid base URL: %w", err)
+		return err
 	}
-
-	// Allow URLs on the same host as BaseURL
-	if parsedURL.Host != baseURL.Host {
-		return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, baseURL.Host)
+	if parsedURL.Host != baseHost {
+		return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, baseHost)
 	}
 
 	// Require HTTPS for authentication endpoints
@@ -450,6 +534,9 @@ func (c *Client) doStream(ctx context.Context, path string, accept string) (*htt
 		req.Header.Set("Accept", accept)
 	}
 
+	if err := c.rateLimiter.wait(ctx); err != nil {
+		return nil, err
+	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
@@ -457,7 +544,7 @@ func (c *Client) doStream(ctx context.Context, path string, accept string) (*htt
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
-		if err := ParseErrorWithStatus(respBody, resp.StatusCode); err != nil {
+		if err := parseErrorResponse(resp, respBody); err != nil {
 			return nil, err
 		}
 		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
@@ -474,6 +561,9 @@ func (c *Client) doStreamNoAuth(ctx context.Context, method, rawURL, accept stri
 		req.Header.Set("Accept", accept)
 	}
 
+	if err := c.rateLimiter.wait(ctx); err != nil {
+		return nil, err
+	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
@@ -481,7 +571,7 @@ func (c *Client) doStreamNoAuth(ctx context.Context, method, rawURL, accept stri
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
-		if err := ParseErrorWithStatus(respBody, resp.StatusCode); err != nil {
+		if err := parseErrorResponse(resp, respBody); err != nil {
 			return nil, err
 		}
 		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
@@ -499,6 +589,28 @@ func BuildRequestBody(data any) (io.Reader, error) {
 	return &buf, nil
 }
 
+// parseErrorResponse parses a failed response body and attaches the request ID
+// from the response headers, when present, to the resulting APIError. A 401
+// caused by local clock skew is reported as a ClockSkewError.
+func parseErrorResponse(resp *http.Response, body []byte) error {
+	err := ParseErrorWithStatus(body, resp.StatusCode)
+	if apiErr, ok := errors.AsType[*APIError](err); ok {
+		apiErr.RequestID = requestIDFromHeader(resp.Header)
+	}
+	return detectClockSkew(resp, err)
+}
+
+// requestIDFromHeader returns the request ID App Store Connect sent back, or
+// "" when the response has none.
+func requestIDFromHeader(header http.Header) string {
+	for _, name := range []string{"X-Request-Id", "X-Apple-Request-Uuid"} {
+		if value := strings.TrimSpace(header.Get(name)); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 // ParseError parses an error response (status code unknown)
 func ParseError(body []byte) error {
 	return ParseErrorWithStatus(body, 0)
@@ -35,3 +35,35 @@ func TestGenerateJWT_RefreshesExpiredOrNearExpiryToken(t *testing.T) {
 		t.Fatalf("expected client cache to update with fresh token, got %q", client.cachedJWT)
 	}
 }
+
+func TestSignToken_BypassesCacheAndHonorsTTL(t *testing.T) {
+	client := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+	client.cachedJWT = "cached-token"
+	client.cachedJWTExpiresAt = time.Now().Add(5 * time.Minute)
+
+	before := time.Now()
+	token, expiresAt, err := client.SignToken(3 * time.Minute)
+	if err != nil {
+		t.Fatalf("SignToken() error: %v", err)
+	}
+	if token == "" || token == "cached-token" {
+		t.Fatalf("expected freshly signed token, got %q", token)
+	}
+	if client.cachedJWT != "cached-token" {
+		t.Fatalf("expected request cache to be untouched, got %q", client.cachedJWT)
+	}
+	if expiresAt.Before(before.Add(3*time.Minute-time.Second)) || expiresAt.After(before.Add(3*time.Minute+time.Second)) {
+		t.Fatalf("expected expiry about 3m from now, got %v", expiresAt)
+	}
+}
+
+func TestSignToken_RejectsLifetimeOverMax(t *testing.T) {
+	client := newTestClient(t, nil, jsonResponse(200, `{"data":[]}`))
+
+	if _, _, err := client.SignToken(MaxTokenLifetime + time.Minute); err == nil {
+		t.Fatal("expected error for lifetime over max")
+	}
+	if _, _, err := client.SignToken(0); err == nil {
+		t.Fatal("expected error for zero lifetime")
+	}
+}
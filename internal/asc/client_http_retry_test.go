@@ -0,0 +1,136 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func setFastRetryEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("ASC_MAX_RETRIES", "3")
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "5ms")
+}
+
+func TestDo_RetriesGETOnServerError(t *testing.T) {
+	setFastRetryEnv(t)
+
+	var calls atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		if calls.Add(1) == 1 {
+			return jsonResponse(http.StatusInternalServerError, `{"errors":[{"title":"Server error","detail":"boom"}]}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{}}}`)
+	})
+
+	if _, err := client.GetApp(context.Background(), "app-1"); err != nil {
+		t.Fatalf("GetApp() error: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestDo_DoesNotRetryWriteOnInternalServerError(t *testing.T) {
+	setFastRetryEnv(t)
+
+	var calls atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		calls.Add(1)
+		return jsonResponse(http.StatusInternalServerError, `{"errors":[{"title":"Server error","detail":"boom"}]}`)
+	})
+
+	_, err := client.do(context.Background(), http.MethodPost, "/v1/apps", strings.NewReader(`{}`))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if IsRetryable(err) {
+		t.Fatalf("expected non-retryable error for write, got %v", err)
+	}
+	if _, ok := errors.AsType[*APIError](err); !ok {
+		t.Fatalf("expected APIError, got %T", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 request, got %d", got)
+	}
+}
+
+func TestDo_RetriesWriteOnRateLimit(t *testing.T) {
+	setFastRetryEnv(t)
+
+	var calls atomic.Int32
+	var bodies []string
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		buf := new(strings.Builder)
+		if req.Body != nil {
+			_, _ = io.Copy(buf, req.Body)
+		}
+		bodies = append(bodies, buf.String())
+		if calls.Add(1) == 1 {
+			return jsonResponse(http.StatusTooManyRequests, `{"errors":[{"title":"Rate limit","detail":"slow down"}]}`)
+		}
+		return jsonResponse(http.StatusCreated, `{"data":{}}`)
+	})
+
+	if _, err := client.do(context.Background(), http.MethodPost, "/v1/apps", strings.NewReader(`{"data":{}}`)); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 requests, got %d", got)
+	}
+	for i, body := range bodies {
+		if body != `{"data":{}}` {
+			t.Fatalf("expected request body to be replayed on attempt %d, got %q", i+1, body)
+		}
+	}
+}
+
+func TestWithMaxRetries_CapsAttempts(t *testing.T) {
+	setFastRetryEnv(t)
+
+	var calls atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		calls.Add(1)
+		return jsonResponse(http.StatusBadGateway, `{"errors":[{"title":"Bad gateway","detail":"upstream"}]}`)
+	}, WithMaxRetries(1))
+
+	_, err := client.GetApp(context.Background(), "app-1")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	apiErr, ok := errors.AsType[*APIError](err)
+	if !ok || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected wrapped 502 APIError, got %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 requests with WithMaxRetries(1), got %d", got)
+	}
+}
+
+func TestDo_RetryRespectsContextCancellation(t *testing.T) {
+	setFastRetryEnv(t)
+
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		response := jsonResponse(http.StatusServiceUnavailable, `{"errors":[{"title":"Unavailable","detail":"later"}]}`)
+		response.Header.Set("Retry-After", "60")
+		return response
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetApp(ctx, "app-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected cancellation to stop waiting on Retry-After, took %s", elapsed)
+	}
+}
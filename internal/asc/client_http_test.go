@@ -62,6 +62,39 @@ func assertAuthorized(t *testing.T, req *http.Request) {
 	}
 }
 
+func TestGetApp_ErrorIncludesCodeAndRequestID(t *testing.T) {
+	response := jsonResponse(http.StatusNotFound, `{"errors":[{"id":"err-1","status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'apps' with id '123'"}]}`)
+	response.Header.Set("X-Request-Id", "req-abc")
+	client := newTestClient(t, nil, response)
+
+	_, err := client.GetApp(context.Background(), "123")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	apiErr, ok := errors.AsType[*APIError](err)
+	if !ok {
+		t.Fatalf("expected APIError, got %T", err)
+	}
+	if apiErr.RequestID != "req-abc" {
+		t.Fatalf("expected request ID req-abc, got %q", apiErr.RequestID)
+	}
+	want := "NOT_FOUND: The specified resource does not exist: There is no resource of type 'apps' with id '123' (request-id: req-abc)"
+	if err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestRequestIDFromHeader_FallsBackToAppleRequestUUID(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Apple-Request-Uuid", "uuid-1")
+	if got := requestIDFromHeader(header); got != "uuid-1" {
+		t.Fatalf("requestIDFromHeader() = %q, want uuid-1", got)
+	}
+	if got := requestIDFromHeader(http.Header{}); got != "" {
+		t.Fatalf("expected empty request ID, got %q", got)
+	}
+}
+
 func TestGetApps_RateLimitedIncludesRetryAfter(t *testing.T) {
 	t.Setenv("ASC_MAX_RETRIES", "0")
 
@@ -3882,6 +3915,31 @@ func TestGetAppScreenshots(t *testing.T) {
 	}
 }
 
+func TestGetAppScreenshots_WithLimitAndNextURL(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Query().Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", req.URL.Query().Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+	if _, err := client.GetAppScreenshots(context.Background(), "SET_123", WithAppScreenshotsLimit(200)); err != nil {
+		t.Fatalf("GetAppScreenshots() error: %v", err)
+	}
+
+	next := "https://api.appstoreconnect.apple.com/v1/appScreenshotSets/SET_123/appScreenshots?cursor=abc"
+	response = jsonResponse(http.StatusOK, `{"data":[]}`)
+	client = newTestClient(t, func(req *http.Request) {
+		if req.URL.String() != next {
+			t.Fatalf("expected next URL %q, got %q", next, req.URL.String())
+		}
+		assertAuthorized(t, req)
+	}, response)
+	if _, err := client.GetAppScreenshots(context.Background(), "", WithAppScreenshotsNextURL(next)); err != nil {
+		t.Fatalf("GetAppScreenshots() error: %v", err)
+	}
+}
+
 func TestGetAppScreenshot(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"appScreenshots","id":"SHOT_123","attributes":{"fileName":"shot.png","fileSize":1024}}}`)
 	client := newTestClient(t, func(req *http.Request) {
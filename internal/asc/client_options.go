@@ -230,6 +230,9 @@ type AppStoreVersionLocalizationPreviewSetsOption func(*appStoreVersionLocalizat
 // AppStoreVersionLocalizationScreenshotSetsOption is a functional option for app store version screenshot sets list endpoints.
 type AppStoreVersionLocalizationScreenshotSetsOption func(*appStoreVersionLocalizationScreenshotSetsQuery)
 
+// AppScreenshotsOption is a functional option for screenshot set screenshots list endpoints.
+type AppScreenshotsOption func(*appScreenshotsQuery)
+
 // AppStoreVersionExperimentTreatmentLocalizationPreviewSetsOption is a functional option for treatment localization preview set list endpoints.
 type AppStoreVersionExperimentTreatmentLocalizationPreviewSetsOption func(*appStoreVersionExperimentTreatmentLocalizationPreviewSetsQuery)
 
@@ -3338,6 +3341,24 @@ func WithAppStoreVersionLocalizationScreenshotSetsNextURL(next string) AppStoreV
 	}
 }
 
+// WithAppScreenshotsLimit sets the max number of screenshots to return.
+func WithAppScreenshotsLimit(limit int) AppScreenshotsOption {
+	return func(q *appScreenshotsQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithAppScreenshotsNextURL uses a next page URL directly.
+func WithAppScreenshotsNextURL(next string) AppScreenshotsOption {
+	return func(q *appScreenshotsQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
 // WithAppStoreVersionExperimentTreatmentLocalizationPreviewSetsLimit sets the max number of preview sets to return.
 func WithAppStoreVersionExperimentTreatmentLocalizationPreviewSetsLimit(limit int) AppStoreVersionExperimentTreatmentLocalizationPreviewSetsOption {
 	return func(q *appStoreVersionExperimentTreatmentLocalizationPreviewSetsQuery) {
@@ -25,7 +25,7 @@ type PageConsumer func(page PaginatedResponse) error
 // PaginateAll fetches all pages and aggregates results.
 // It uses reflection to create an empty result container of the same type as
 // firstPage, eliminating the need for a type switch per response type.
-func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc) (PaginatedResponse, error) {
+func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc, opts ...PaginateOption) (PaginatedResponse, error) {
 	if firstPage == nil {
 		return nil, nil
 	}
@@ -42,17 +42,49 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		return nil, err
 	}
 
+	cfg := newPaginateConfig(opts)
+	firstPage, err = cfg.resumePagination(ctx, firstPage, fetchNext)
+	if err != nil {
+		return nil, err
+	}
+	cfg.writeRawPage()
+	observer := cfg.observer
+	if observer != nil {
+		defer observer.PaginationDone()
+	}
+
 	page := 1
+	items := 0
 	seenNext := make(map[string]struct{})
+	if cfg.resumeURL != "" {
+		seenNext[cfg.resumeURL] = struct{}{}
+	}
 	for {
 		// Aggregate data from current page using reflection over the Data field.
 		if err := aggregatePageData(result, firstPage); err != nil {
 			return nil, fmt.Errorf("page %d: %w", page, err)
 		}
+		items += pageItemCount(firstPage)
+		if observer != nil {
+			observer.PageFetched(page, items)
+		}
 
 		// Check for next page
 		links := firstPage.GetLinks()
 		if links == nil || links.Next == "" {
+			if err := cfg.saveNext(""); err != nil {
+				return result, err
+			}
+			break
+		}
+		if err := cfg.saveNext(links.Next); err != nil {
+			return result, err
+		}
+		if cfg.reachedMaxItems(items) {
+			// Keep whole pages so links.next still points at the first unreturned item.
+			if resultLinks := result.GetLinks(); resultLinks != nil {
+				resultLinks.Next = links.Next
+			}
 			break
 		}
 
@@ -67,6 +99,7 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		if err != nil {
 			return result, fmt.Errorf("page %d: %w", page, err)
 		}
+		cfg.writeRawPage()
 
 		// Validate that the response type matches
 		if reflect.TypeOf(nextPage) != reflect.TypeOf(firstPage) {
@@ -81,7 +114,7 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 
 // PaginateEach iterates pages and invokes consume for each page without
 // aggregating all page data in memory.
-func PaginateEach(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc, consume PageConsumer) error {
+func PaginateEach(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc, consume PageConsumer, opts ...PaginateOption) error {
 	if firstPage == nil {
 		return nil
 	}
@@ -94,17 +127,41 @@ func PaginateEach(ctx context.Context, firstPage PaginatedResponse, fetchNext Pa
 		return nil
 	}
 
+	cfg := newPaginateConfig(opts)
+	current, err := cfg.resumePagination(ctx, firstPage, fetchNext)
+	if err != nil {
+		return err
+	}
+	cfg.writeRawPage()
+	observer := cfg.observer
+	if observer != nil {
+		defer observer.PaginationDone()
+	}
+
 	page := 1
-	current := firstPage
+	items := 0
 	seenNext := make(map[string]struct{})
+	if cfg.resumeURL != "" {
+		seenNext[cfg.resumeURL] = struct{}{}
+	}
 
 	for {
 		if err := consume(current); err != nil {
 			return fmt.Errorf("page %d: %w", page, err)
 		}
+		items += pageItemCount(current)
+		if observer != nil {
+			observer.PageFetched(page, items)
+		}
 
 		links := current.GetLinks()
 		if links == nil || links.Next == "" {
+			return cfg.saveNext("")
+		}
+		if err := cfg.saveNext(links.Next); err != nil {
+			return err
+		}
+		if cfg.reachedMaxItems(items) {
 			return nil
 		}
 		if _, ok := seenNext[links.Next]; ok {
@@ -116,6 +173,7 @@ func PaginateEach(ctx context.Context, firstPage PaginatedResponse, fetchNext Pa
 		if err != nil {
 			return fmt.Errorf("page %d: %w", page+1, err)
 		}
+		cfg.writeRawPage()
 		if reflect.TypeOf(nextPage) != reflect.TypeOf(current) {
 			return fmt.Errorf("page %d: unexpected response type (expected %T, got %T)", page+1, current, nextPage)
 		}
@@ -333,6 +333,10 @@ type appStoreVersionLocalizationScreenshotSetsQuery struct {
 	listQuery
 }
 
+type appScreenshotsQuery struct {
+	listQuery
+}
+
 type appStoreVersionExperimentsQuery struct {
 	listQuery
 	states []string
@@ -1434,6 +1438,12 @@ func buildAppStoreVersionLocalizationScreenshotSetsQuery(query *appStoreVersionL
 	return values.Encode()
 }
 
+func buildAppScreenshotsQuery(query *appScreenshotsQuery) string {
+	values := url.Values{}
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
 func buildAppStoreVersionExperimentsQuery(query *appStoreVersionExperimentsQuery) string {
 	values := url.Values{}
 	addCSV(values, "filter[state]", query.states)
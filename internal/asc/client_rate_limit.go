@@ -0,0 +1,63 @@
+package asc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithRequestsPerSecond throttles every outgoing request from this client to at
+// most n requests per second. Values <= 0 leave the client unlimited.
+func WithRequestsPerSecond(n float64) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = newRateLimiter(n)
+	}
+}
+
+// rateLimiter is a token bucket with a burst of one: each request reserves the
+// next free slot and waits until it arrives.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+	now      func() time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	interval := time.Duration(float64(time.Second) / requestsPerSecond)
+	if interval <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: interval, now: time.Now}
+}
+
+// wait blocks until the caller may send a request or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_SpacesRequests(t *testing.T) {
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{}}}`)
+	}, WithRequestsPerSecond(50))
+
+	start := time.Now()
+	for range 4 {
+		if _, err := client.GetApp(context.Background(), "app-1"); err != nil {
+			t.Fatalf("GetApp() error: %v", err)
+		}
+	}
+
+	// First request is immediate; the remaining three wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("expected requests to be throttled, finished in %s", elapsed)
+	}
+}
+
+func TestRateLimiter_DisabledForNonPositiveRate(t *testing.T) {
+	for _, rate := range []float64{0, -1} {
+		if limiter := newRateLimiter(rate); limiter != nil {
+			t.Fatalf("expected nil limiter for rate %v", rate)
+		}
+	}
+
+	var limiter *rateLimiter
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("expected nil limiter to never block, got %v", err)
+	}
+}
+
+func TestRateLimiter_WaitHonorsContextCancellation(t *testing.T) {
+	limiter := newRateLimiter(0.1)
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("first wait error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline error, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RawListResponse is a list response whose resources are kept as raw JSON.
+// It lets passthrough requests paginate endpoints that have no typed model.
+type RawListResponse struct {
+	Data  []json.RawMessage `json:"data"`
+	Links Links             `json:"links,omitempty"`
+}
+
+// GetLinks returns the links field for pagination.
+func (r *RawListResponse) GetLinks() *Links {
+	return &r.Links
+}
+
+// GetData returns the data field for aggregation.
+func (r *RawListResponse) GetData() any {
+	return r.Data
+}
+
+// GetRaw performs an authenticated GET for an API path or App Store Connect URL
+// and returns the response body unparsed.
+func (c *Client) GetRaw(ctx context.Context, path string) (json.RawMessage, error) {
+	if err := validateNextURL(path); err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("failed to parse response: invalid JSON")
+	}
+	return json.RawMessage(data), nil
+}
+
+// GetRawList performs an authenticated GET for a list endpoint and decodes the
+// page so it can be passed to PaginateAll.
+func (c *Client) GetRawList(ctx context.Context, path string) (*RawListResponse, error) {
+	data, err := c.GetRaw(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response RawListResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse list response (is this a list endpoint?): %w", err)
+	}
+	return &response, nil
+}
+
+// PatchRaw sends an authenticated PATCH with a JSON body to an API path or App
+// Store Connect URL and returns the response body unparsed.
+func (c *Client) PatchRaw(ctx context.Context, path string, body []byte) (json.RawMessage, error) {
+	if err := validateNextURL(path); err != nil {
+		return nil, err
+	}
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("request body must be valid JSON")
+	}
+
+	data, err := c.do(ctx, http.MethodPatch, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("failed to parse response: invalid JSON")
+	}
+	return json.RawMessage(data), nil
+}
@@ -0,0 +1,78 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetRaw_ReturnsBodyUnchanged(t *testing.T) {
+	body := `{"data":{"type":"apps","id":"app-1"},"meta":{"custom":true}}`
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("expected path /v1/apps/app-1, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, jsonResponse(http.StatusOK, body))
+
+	got, err := client.GetRaw(context.Background(), "/v1/apps/app-1")
+	if err != nil {
+		t.Fatalf("GetRaw() error: %v", err)
+	}
+	if string(got) != body {
+		t.Fatalf("expected %s, got %s", body, got)
+	}
+}
+
+func TestGetRaw_RejectsUntrustedHost(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+	}, jsonResponse(http.StatusOK, `{}`))
+
+	_, err := client.GetRaw(context.Background(), "https://example.com/v1/apps")
+	if err == nil || !strings.Contains(err.Error(), "untrusted host") {
+		t.Fatalf("expected untrusted host error, got %v", err)
+	}
+}
+
+func TestGetRawList_RejectsSingleResourceResponse(t *testing.T) {
+	client := newTestClient(t, nil, jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1"}}`))
+
+	if _, err := client.GetRawList(context.Background(), "/v1/apps/app-1"); err == nil {
+		t.Fatal("expected error for non-list response")
+	}
+}
+
+func TestPatchRaw_SendsBodyAndAllowsEmptyResponse(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appStoreVersions/v1/relationships/build" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, jsonResponse(http.StatusNoContent, ""))
+
+	got, err := client.PatchRaw(context.Background(), "/v1/appStoreVersions/v1/relationships/build", []byte(`{"data":{"type":"builds","id":"b1"}}`))
+	if err != nil {
+		t.Fatalf("PatchRaw() error: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil body for empty response, got %s", got)
+	}
+}
+
+func TestPatchRaw_RejectsInvalidJSONBody(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+	}, jsonResponse(http.StatusOK, `{}`))
+
+	if _, err := client.PatchRaw(context.Background(), "/v1/apps/app-1", []byte(`{"data":`)); err == nil {
+		t.Fatal("expected error for invalid JSON body")
+	}
+}
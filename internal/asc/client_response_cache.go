@@ -0,0 +1,88 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// WithResponseCache memoizes successful GET responses for the lifetime of the client.
+// Concurrent requests for the same URL share a single in-flight fetch, and any
+// successful write clears the cache so later reads observe the change.
+func WithResponseCache() ClientOption {
+	return func(c *Client) {
+		c.responseCache = newResponseCache()
+	}
+}
+
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]*responseCacheEntry
+}
+
+type responseCacheEntry struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: make(map[string]*responseCacheEntry)}
+}
+
+// get returns the cached body for key, calling fetch on a miss. Failed fetches
+// are shared with concurrent waiters but never stored.
+func (rc *responseCache) get(ctx context.Context, key string, fetch func() ([]byte, error)) ([]byte, error) {
+	rc.mu.Lock()
+	if entry, ok := rc.entries[key]; ok {
+		rc.mu.Unlock()
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if entry.err != nil {
+			return nil, entry.err
+		}
+		return bytes.Clone(entry.data), nil
+	}
+
+	entry := &responseCacheEntry{done: make(chan struct{})}
+	rc.entries[key] = entry
+	rc.mu.Unlock()
+
+	entry.data, entry.err = fetch()
+	if entry.err != nil {
+		rc.mu.Lock()
+		if rc.entries[key] == entry {
+			delete(rc.entries, key)
+		}
+		rc.mu.Unlock()
+	}
+	close(entry.done)
+
+	if entry.err != nil {
+		return nil, entry.err
+	}
+	return bytes.Clone(entry.data), nil
+}
+
+func (rc *responseCache) clear() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	clear(rc.entries)
+}
+
+func responseCacheKey(method, path string) (string, error) {
+	requestURL, err := resolveRequestURL(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(method) + " " + requestURL, nil
+}
+
+func isCacheableMethod(method string) bool {
+	return strings.EqualFold(method, http.MethodGet)
+}
@@ -0,0 +1,160 @@
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func newCountingTestClient(t *testing.T, handler func(*http.Request) *http.Response, opts ...ClientOption) *Client {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return handler(req), nil
+	})
+	return newClientWithPrivateKey("KEY123", "ISS456", key, &http.Client{Transport: transport}, opts...)
+}
+
+func TestResponseCache_ServesRepeatedGETFromCache(t *testing.T) {
+	var calls atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		calls.Add(1)
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"name":"Demo"}}}`)
+	}, WithResponseCache())
+
+	for range 3 {
+		resp, err := client.GetApp(context.Background(), "app-1")
+		if err != nil {
+			t.Fatalf("GetApp() error: %v", err)
+		}
+		if resp.Data.Attributes.Name != "Demo" {
+			t.Fatalf("expected cached app name, got %q", resp.Data.Attributes.Name)
+		}
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 HTTP request, got %d", got)
+	}
+}
+
+func TestResponseCache_KeysByURL(t *testing.T) {
+	var calls atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		calls.Add(1)
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app","attributes":{}}}`)
+	}, WithResponseCache())
+
+	for _, id := range []string{"app-1", "app-2", "app-1"} {
+		if _, err := client.GetApp(context.Background(), id); err != nil {
+			t.Fatalf("GetApp(%q) error: %v", id, err)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 HTTP requests, got %d", got)
+	}
+}
+
+func TestResponseCache_DoesNotCacheErrors(t *testing.T) {
+	var calls atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		if calls.Add(1) == 1 {
+			return jsonResponse(http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","title":"Not Found","detail":"missing"}]}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{}}}`)
+	}, WithResponseCache())
+
+	if _, err := client.GetApp(context.Background(), "app-1"); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if _, err := client.GetApp(context.Background(), "app-1"); err != nil {
+		t.Fatalf("expected second request to succeed, got %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 HTTP requests, got %d", got)
+	}
+}
+
+func TestResponseCache_WriteClearsCache(t *testing.T) {
+	var gets atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			gets.Add(1)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{}}}`)
+	}, WithResponseCache())
+
+	ctx := context.Background()
+	if _, err := client.do(ctx, http.MethodGet, "/v1/apps/app-1", nil); err != nil {
+		t.Fatalf("GET error: %v", err)
+	}
+	if _, err := client.do(ctx, http.MethodPatch, "/v1/apps/app-1", nil); err != nil {
+		t.Fatalf("PATCH error: %v", err)
+	}
+	if _, err := client.do(ctx, http.MethodGet, "/v1/apps/app-1", nil); err != nil {
+		t.Fatalf("GET error: %v", err)
+	}
+
+	if got := gets.Load(); got != 2 {
+		t.Fatalf("expected cache to be cleared after write (2 GETs), got %d", got)
+	}
+}
+
+func TestResponseCache_SharesConcurrentFetches(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		calls.Add(1)
+		<-release
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{}}}`)
+	}, WithResponseCache())
+
+	const workers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for range workers {
+		wg.Go(func() {
+			_, err := client.GetApp(context.Background(), "app-1")
+			errs <- err
+		})
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("GetApp() error: %v", err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected concurrent requests to share 1 HTTP request, got %d", got)
+	}
+}
+
+func TestResponseCache_DisabledByDefault(t *testing.T) {
+	var calls atomic.Int32
+	client := newCountingTestClient(t, func(req *http.Request) *http.Response {
+		calls.Add(1)
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{}}}`)
+	})
+
+	for range 2 {
+		if _, err := client.GetApp(context.Background(), "app-1"); err != nil {
+			t.Fatalf("GetApp() error: %v", err)
+		}
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 HTTP requests without cache, got %d", got)
+	}
+}
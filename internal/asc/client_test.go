@@ -2774,6 +2774,64 @@ func TestWithRetry_NegativeRetriesUsesDefault(t *testing.T) {
 	}
 }
 
+func TestRetryOnce_RetriesTransientErrorOnce(t *testing.T) {
+	callCount := 0
+
+	got, err := RetryOnce(context.Background(), func() (string, error) {
+		callCount++
+		if callCount == 1 {
+			return "", fmt.Errorf("request failed: connection reset by peer")
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != "ok" || callCount != 2 {
+		t.Fatalf("expected success on second call, got %q after %d calls", got, callCount)
+	}
+}
+
+func TestRetryOnce_DoesNotRetryDefinitiveErrors(t *testing.T) {
+	for _, wantErr := range []error{
+		&APIError{Code: "NOT_FOUND", StatusCode: 404},
+		&APIError{Code: "FORBIDDEN", StatusCode: 403},
+		&APIError{Code: "ENTITY_ERROR", StatusCode: 422},
+		context.Canceled,
+	} {
+		callCount := 0
+		_, err := RetryOnce(context.Background(), func() (string, error) {
+			callCount++
+			return "", wantErr
+		})
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("expected error %v, got %v", wantErr, err)
+		}
+		if callCount != 1 {
+			t.Fatalf("expected 1 call for %v, got %d", wantErr, callCount)
+		}
+	}
+}
+
+func TestRetryOnce_ReturnsSecondFailure(t *testing.T) {
+	callCount := 0
+	secondErr := fmt.Errorf("request failed: timeout")
+
+	_, err := RetryOnce(context.Background(), func() (string, error) {
+		callCount++
+		if callCount == 1 {
+			return "", fmt.Errorf("request failed: connection reset by peer")
+		}
+		return "", secondErr
+	})
+	if !errors.Is(err, secondErr) {
+		t.Fatalf("expected second error, got %v", err)
+	}
+	if callCount != 2 {
+		t.Fatalf("expected 2 calls, got %d", callCount)
+	}
+}
+
 func TestWithRetry_AttemptCountInErrorMessage(t *testing.T) {
 	const maxRetries = 2
 	callCount := 0
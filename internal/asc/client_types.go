@@ -220,10 +220,11 @@ const (
 
 // Platform constants — re-exported from types package.
 const (
-	PlatformIOS      = types.PlatformIOS
-	PlatformMacOS    = types.PlatformMacOS
-	PlatformTVOS     = types.PlatformTVOS
-	PlatformVisionOS = types.PlatformVisionOS
+	PlatformIOS       = types.PlatformIOS
+	PlatformMacOS     = types.PlatformMacOS
+	PlatformTVOS      = types.PlatformTVOS
+	PlatformVisionOS  = types.PlatformVisionOS
+	PlatformUniversal = types.PlatformUniversal
 )
 
 // ChecksumAlgorithm constants — re-exported from types package.
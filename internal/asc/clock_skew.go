@@ -0,0 +1,53 @@
+package asc
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ClockSkewTolerance is how far the local clock may drift from App Store
+// Connect before a rejected token is reported as a clock problem.
+const ClockSkewTolerance = 30 * time.Second
+
+// clockNow is the local clock compared against the server Date header.
+var clockNow = time.Now
+
+// ClockSkewError reports an authentication failure that was most likely caused
+// by the local clock: API tokens are signed with local timestamps, so App Store
+// Connect rejects them when the clocks disagree.
+type ClockSkewError struct {
+	// Skew is local time minus server time; positive means the local clock is ahead.
+	Skew time.Duration
+	Err  error
+}
+
+func (e *ClockSkewError) Error() string {
+	direction := "ahead of"
+	if e.Skew < 0 {
+		direction = "behind"
+	}
+	seconds := int64(e.Skew.Abs().Round(time.Second) / time.Second)
+	return fmt.Sprintf("your system clock is off by %d seconds (%s App Store Connect), so the API token was rejected; sync the system clock and retry: %v", seconds, direction, e.Err)
+}
+
+func (e *ClockSkewError) Unwrap() error {
+	return e.Err
+}
+
+// detectClockSkew wraps err in a ClockSkewError when resp is a 401 whose Date
+// header differs from the local clock by more than ClockSkewTolerance.
+func detectClockSkew(resp *http.Response, err error) error {
+	if err == nil || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		return err
+	}
+	serverTime, parseErr := http.ParseTime(resp.Header.Get("Date"))
+	if parseErr != nil {
+		return err
+	}
+	skew := clockNow().Sub(serverTime)
+	if skew.Abs() <= ClockSkewTolerance {
+		return err
+	}
+	return &ClockSkewError{Skew: skew, Err: err}
+}
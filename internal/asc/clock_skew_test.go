@@ -0,0 +1,70 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func unauthorizedResponse(serverTime time.Time) *http.Response {
+	response := jsonResponse(http.StatusUnauthorized, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED","title":"Authentication credentials are missing or invalid."}]}`)
+	if !serverTime.IsZero() {
+		response.Header.Set("Date", serverTime.UTC().Format(http.TimeFormat))
+	}
+	return response
+}
+
+func TestGetApps_UnauthorizedWithClockSkewReportsSkew(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	previous := clockNow
+	clockNow = func() time.Time { return now }
+	t.Cleanup(func() { clockNow = previous })
+
+	client := newTestClient(t, nil, unauthorizedResponse(now.Add(-95*time.Second)))
+
+	_, err := client.GetApps(context.Background())
+	skewErr, ok := errors.AsType[*ClockSkewError](err)
+	if !ok {
+		t.Fatalf("expected ClockSkewError, got %T: %v", err, err)
+	}
+	if skewErr.Skew != 95*time.Second {
+		t.Fatalf("expected skew 95s, got %s", skewErr.Skew)
+	}
+	if !strings.Contains(err.Error(), "your system clock is off by 95 seconds (ahead of App Store Connect)") {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+	if apiErr, ok := errors.AsType[*APIError](err); !ok || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the 401 APIError to remain reachable, got %v", err)
+	}
+}
+
+func TestGetApps_UnauthorizedWithinToleranceKeepsAPIError(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	previous := clockNow
+	clockNow = func() time.Time { return now }
+	t.Cleanup(func() { clockNow = previous })
+
+	for _, response := range []*http.Response{
+		unauthorizedResponse(now.Add(10 * time.Second)),
+		unauthorizedResponse(time.Time{}),
+	} {
+		client := newTestClient(t, nil, response)
+		_, err := client.GetApps(context.Background())
+		if _, ok := errors.AsType[*ClockSkewError](err); ok {
+			t.Fatalf("did not expect ClockSkewError, got %v", err)
+		}
+		if _, ok := errors.AsType[*APIError](err); !ok {
+			t.Fatalf("expected APIError, got %T", err)
+		}
+	}
+}
+
+func TestClockSkewErrorBehindMessage(t *testing.T) {
+	err := &ClockSkewError{Skew: -2 * time.Minute, Err: errors.New("unauthorized")}
+	if !strings.Contains(err.Error(), "off by 120 seconds (behind App Store Connect)") {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
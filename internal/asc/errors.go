@@ -21,7 +21,8 @@ type APIError struct {
 	Code             string
 	Title            string
 	Detail           string
-	StatusCode       int // HTTP status code that triggered this error (0 if unknown)
+	StatusCode       int    // HTTP status code that triggered this error (0 if unknown)
+	RequestID        string // request ID response header; quote it when filing issues with Apple
 	AssociatedErrors map[string][]APIAssociatedError
 }
 
@@ -49,6 +50,12 @@ func (e *APIError) Error() string {
 	default:
 		baseMessage = "API error"
 	}
+	if code != "" && baseMessage != code {
+		baseMessage = fmt.Sprintf("%s: %s", code, baseMessage)
+	}
+	if requestID := strings.TrimSpace(sanitizeTerminal(e.RequestID)); requestID != "" {
+		baseMessage = fmt.Sprintf("%s (request-id: %s)", baseMessage, requestID)
+	}
 
 	associated := formatAssociatedErrors(e.AssociatedErrors)
 	if associated == "" {
@@ -56,3 +56,24 @@ func TestAPIErrorError_AssociatedErrorsSortedByResourcePath(t *testing.T) {
 		t.Fatalf("expected associated errors to be sorted by path, got %q", message)
 	}
 }
+
+func TestAPIErrorError_PrefixesCodeAndAppendsRequestID(t *testing.T) {
+	err := &APIError{
+		Code:      "ENTITY_ERROR.ATTRIBUTE.REQUIRED",
+		Title:     "The provided entity is missing a required attribute",
+		Detail:    "You must provide a value for 'whatsNew'",
+		RequestID: "abc",
+	}
+
+	want := "ENTITY_ERROR.ATTRIBUTE.REQUIRED: The provided entity is missing a required attribute: You must provide a value for 'whatsNew' (request-id: abc)"
+	if got := err.Error(); got != want {
+		t.Fatalf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestAPIErrorError_CodeOnlyIsNotRepeated(t *testing.T) {
+	err := &APIError{Code: "FORBIDDEN"}
+	if got := err.Error(); got != "FORBIDDEN" {
+		t.Fatalf("Error() = %q, want %q", got, "FORBIDDEN")
+	}
+}
@@ -0,0 +1,160 @@
+package asc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	httpRecordEnvVar = "ASC_HTTP_RECORD"
+	httpReplayEnvVar = "ASC_HTTP_REPLAY"
+)
+
+// httpFixture is one recorded request/response pair on disk.
+type httpFixture struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Status       int         `json:"status"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+// fixtureFileName returns the recording name for a request, keyed by method
+// and URL. Request bodies are not part of the key.
+func fixtureFileName(method, rawURL string) string {
+	sum := sha256.Sum256([]byte(method + " " + rawURL))
+	return strings.ToLower(method) + "-" + hex.EncodeToString(sum[:8]) + ".json"
+}
+
+// withHTTPFixtures swaps in the record/replay transport when ASC_HTTP_REPLAY or
+// ASC_HTTP_RECORD is set. Replay wins when both are set, so a replayed run
+// never touches the network. The caller's client is never modified.
+func withHTTPFixtures(httpClient *http.Client) *http.Client {
+	if httpClient == nil {
+		return httpClient
+	}
+	if dir, ok := envValue(httpReplayEnvVar); ok && dir != "" {
+		wrapped := *httpClient
+		wrapped.Transport = &replayTransport{dir: dir}
+		return &wrapped
+	}
+	if dir, ok := envValue(httpRecordEnvVar); ok && dir != "" {
+		base := httpClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		wrapped := *httpClient
+		wrapped.Transport = &recordTransport{base: base, dir: dir}
+		return &wrapped
+	}
+	return httpClient
+}
+
+// recordTransport passes requests through and saves each response to dir.
+type recordTransport struct {
+	base http.RoundTripper
+	dir  string
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	fixture := httpFixture{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Status: resp.StatusCode,
+		Header: resp.Header.Clone(),
+	}
+	if utf8.Valid(body) {
+		fixture.Body = string(body)
+	} else {
+		fixture.Body = base64.StdEncoding.EncodeToString(body)
+		fixture.BodyEncoding = "base64"
+	}
+	if err := writeHTTPFixture(t.dir, fixture); err != nil {
+		return nil, fmt.Errorf("%s: %w", httpRecordEnvVar, err)
+	}
+	return resp, nil
+}
+
+func writeHTTPFixture(dir string, fixture httpFixture) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Recordings hold API responses, so keep them private like other outputs.
+	return os.WriteFile(filepath.Join(dir, fixtureFileName(fixture.Method, fixture.URL)), append(data, '\n'), 0o600)
+}
+
+// replayTransport serves responses from recordings and never hits the network.
+type replayTransport struct {
+	dir string
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	rawURL := req.URL.String()
+	path := filepath.Join(t.dir, fixtureFileName(req.Method, rawURL))
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: no recording for %s %s", httpReplayEnvVar, req.Method, rawURL)
+		}
+		return nil, fmt.Errorf("%s: %w", httpReplayEnvVar, err)
+	}
+
+	var fixture httpFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("%s: invalid recording %s: %w", httpReplayEnvVar, path, err)
+	}
+	body := []byte(fixture.Body)
+	if fixture.BodyEncoding == "base64" {
+		body, err = base64.StdEncoding.DecodeString(fixture.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid recording %s: %w", httpReplayEnvVar, path, err)
+		}
+	}
+
+	header := fixture.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.Status, http.StatusText(fixture.Status)),
+		StatusCode:    fixture.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package asc
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestHTTPFixtures_RecordThenReplay(t *testing.T) {
+	dir := t.TempDir()
+	binary := []byte{0x1f, 0x8b, 0xff, 0x00}
+	calls := 0
+	base := verboseRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		body := io.NopCloser(strings.NewReader(`{"data":[]}`))
+		if req.URL.Path == "/v1/salesReports" {
+			body = io.NopCloser(strings.NewReader(string(binary)))
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       body,
+		}, nil
+	})
+
+	t.Setenv("ASC_HTTP_REPLAY", "")
+	t.Setenv("ASC_HTTP_RECORD", dir)
+	recording := withHTTPFixtures(&http.Client{Transport: base})
+	for _, target := range []string{"https://api.appstoreconnect.apple.com/v1/apps?limit=1", "https://api.appstoreconnect.apple.com/v1/salesReports"} {
+		resp, err := recording.Get(target)
+		if err != nil {
+			t.Fatalf("record GET %s: %v", target, err)
+		}
+		_, _ = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("expected 2 recordings, got %d (err %v)", len(entries), err)
+	}
+
+	t.Setenv("ASC_HTTP_RECORD", "")
+	t.Setenv("ASC_HTTP_REPLAY", dir)
+	replaying := withHTTPFixtures(&http.Client{Transport: base})
+
+	resp, err := replaying.Get("https://api.appstoreconnect.apple.com/v1/apps?limit=1")
+	if err != nil {
+		t.Fatalf("replay GET: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != `{"data":[]}` {
+		t.Fatalf("unexpected replay: %d %q", resp.StatusCode, body)
+	}
+	if resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("expected recorded headers, got %v", resp.Header)
+	}
+
+	resp, err = replaying.Get("https://api.appstoreconnect.apple.com/v1/salesReports")
+	if err != nil {
+		t.Fatalf("replay binary GET: %v", err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if string(body) != string(binary) {
+		t.Fatalf("expected binary body to round-trip, got %v", body)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected replay to skip the network, base transport called %d times", calls)
+	}
+}
+
+func TestHTTPFixtures_ReplayMissingRecording(t *testing.T) {
+	t.Setenv("ASC_HTTP_RECORD", "")
+	t.Setenv("ASC_HTTP_REPLAY", t.TempDir())
+
+	client := withHTTPFixtures(&http.Client{})
+	_, err := client.Get("https://api.appstoreconnect.apple.com/v1/apps")
+	if err == nil || !strings.Contains(err.Error(), "ASC_HTTP_REPLAY: no recording for GET https://api.appstoreconnect.apple.com/v1/apps") {
+		t.Fatalf("expected missing recording error, got %v", err)
+	}
+}
+
+func TestHTTPFixtures_DisabledByDefault(t *testing.T) {
+	t.Setenv("ASC_HTTP_RECORD", "")
+	t.Setenv("ASC_HTTP_REPLAY", "")
+
+	original := &http.Client{}
+	if withHTTPFixtures(original) != original {
+		t.Fatal("expected client to be returned unchanged")
+	}
+}
@@ -190,6 +190,9 @@ func (c *Client) doNotary(ctx context.Context, method, path string, body io.Read
 		return nil, err
 	}
 
+	if err := c.rateLimiter.wait(ctx); err != nil {
+		return nil, err
+	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("notary request failed: %w", err)
@@ -198,7 +201,7 @@ func (c *Client) doNotary(ctx context.Context, method, path string, body io.Read
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
-		if err := ParseErrorWithStatus(respBody, resp.StatusCode); err != nil {
+		if err := parseErrorResponse(resp, respBody); err != nil {
 			return nil, err
 		}
 		return nil, fmt.Errorf("notary API request failed with status %d", resp.StatusCode)
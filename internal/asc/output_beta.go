@@ -41,6 +41,13 @@ type BetaTesterBuildsUpdateResult struct {
 	Action   string   `json:"action"`
 }
 
+// BetaGroupBuildsUpdateResult represents CLI output for beta group build updates.
+type BetaGroupBuildsUpdateResult struct {
+	GroupID  string   `json:"groupId"`
+	BuildIDs []string `json:"buildIds"`
+	Action   string   `json:"action"`
+}
+
 // AppBetaTestersUpdateResult represents CLI output for app beta tester updates.
 type AppBetaTestersUpdateResult struct {
 	AppID     string   `json:"appId"`
@@ -123,6 +130,12 @@ func betaTesterBuildsUpdateResultRows(result *BetaTesterBuildsUpdateResult) ([]s
 	return headers, rows
 }
 
+func betaGroupBuildsUpdateResultRows(result *BetaGroupBuildsUpdateResult) ([]string, [][]string) {
+	headers := []string{"Group ID", "Build IDs", "Action"}
+	rows := [][]string{{result.GroupID, strings.Join(result.BuildIDs, ","), result.Action}}
+	return headers, rows
+}
+
 func appBetaTestersUpdateResultRows(result *AppBetaTestersUpdateResult) ([]string, [][]string) {
 	headers := []string{"App ID", "Tester IDs", "Action"}
 	rows := [][]string{{result.AppID, strings.Join(result.TesterIDs, ","), result.Action}}
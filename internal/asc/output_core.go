@@ -27,6 +27,11 @@ func PrintTable(data any) error {
 	return renderByRegistry(data, RenderTable)
 }
 
+// PrintCSV prints data as CSV using the same columns as table output.
+func PrintCSV(data any) error {
+	return renderByRegistry(data, RenderCSV)
+}
+
 // PrintJSON prints data as minified JSON (best for AI agents).
 func PrintJSON(data any) error {
 	enc := json.NewEncoder(os.Stdout)
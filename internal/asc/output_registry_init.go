@@ -340,6 +340,7 @@ func init() {
 	registerRows(betaTesterGroupsUpdateResultRows)
 	registerRows(betaTesterAppsUpdateResultRows)
 	registerRows(betaTesterBuildsUpdateResultRows)
+	registerRows(betaGroupBuildsUpdateResultRows)
 	registerRows(appBetaTestersUpdateResultRows)
 	registerRows(betaFeedbackSubmissionDeleteResultRows)
 	registerRows(appStoreVersionLocalizationDeleteResultRows)
@@ -358,10 +359,12 @@ func init() {
 	registerRows(passTypeIDDeleteResultRows)
 	registerRows(bundleIDCapabilityDeleteResultRows)
 	registerRows(certificateRevokeResultRows)
+	registerRows(certificateDownloadResultRows)
 	registerRows(profileDeleteResultRows)
 	registerRows(endUserLicenseAgreementRows)
 	registerRows(endUserLicenseAgreementDeleteResultRows)
 	registerRows(profileDownloadResultRows)
+	registerRows(profilesDownloadResultRows)
 	registerRows(signingFetchResultRows)
 	registerRows(xcodeCloudRunResultRows)
 	registerRows(xcodeCloudStatusResultRows)
@@ -3085,12 +3085,17 @@ func TestPrintTable_AppStoreVersionReleaseRequestResult(t *testing.T) {
 	resp := &AppStoreVersionReleaseRequestResult{
 		ReleaseRequestID: "RELEASE_123",
 		VersionID:        "VERSION_123",
+		State:            "PROCESSING_FOR_APP_STORE",
 	}
 
 	output := captureStdout(t, func() error {
 		return PrintTable(resp)
 	})
 
+	if !strings.Contains(output, "PROCESSING_FOR_APP_STORE") {
+		t.Fatalf("expected resulting state in output, got: %s", output)
+	}
+
 	if !strings.Contains(output, "Release Request ID") {
 		t.Fatalf("expected release request header, got: %s", output)
 	}
@@ -39,6 +39,14 @@ func init() {
 		render(oh, or)
 		return nil
 	})
+
+	registerDirect(func(v *validation.AllReport, render func([]string, [][]string)) error {
+		h, r := allValidationSummaryRows(v)
+		render(h, r)
+		oh, or := allValidationCheckRows(v)
+		render(oh, or)
+		return nil
+	})
 }
 
 func validationSummaryRows(report *validation.Report) ([]string, [][]string) {
@@ -66,7 +74,7 @@ func validationCheckRows(report *validation.Report) ([]string, [][]string) {
 	rows := make([][]string, 0, len(report.Checks))
 	for _, check := range report.Checks {
 		rows = append(rows, []string{
-			string(check.Severity),
+			formatCheckSeverity(check),
 			check.ID,
 			check.Locale,
 			check.Field,
@@ -102,7 +110,7 @@ func testflightValidationCheckRows(report *validation.TestFlightReport) ([]strin
 	rows := make([][]string, 0, len(report.Checks))
 	for _, check := range report.Checks {
 		rows = append(rows, []string{
-			string(check.Severity),
+			formatCheckSeverity(check),
 			check.ID,
 			check.Locale,
 			check.Field,
@@ -137,7 +145,7 @@ func iapValidationCheckRows(report *validation.IAPReport) ([]string, [][]string)
 	rows := make([][]string, 0, len(report.Checks))
 	for _, check := range report.Checks {
 		rows = append(rows, []string{
-			string(check.Severity),
+			formatCheckSeverity(check),
 			check.ID,
 			check.Locale,
 			check.Field,
@@ -172,7 +180,7 @@ func subscriptionsValidationCheckRows(report *validation.SubscriptionsReport) ([
 	rows := make([][]string, 0, len(report.Checks))
 	for _, check := range report.Checks {
 		rows = append(rows, []string{
-			string(check.Severity),
+			formatCheckSeverity(check),
 			check.ID,
 			check.Locale,
 			check.Field,
@@ -184,6 +192,51 @@ func subscriptionsValidationCheckRows(report *validation.SubscriptionsReport) ([
 	return headers, rows
 }
 
+func allValidationSummaryRows(report *validation.AllReport) ([]string, [][]string) {
+	headers := []string{"Domain", "Errors", "Warnings", "Infos", "Blocking"}
+	rows := make([][]string, 0, 4)
+	for _, section := range report.Sections() {
+		rows = append(rows, []string{
+			section.Domain,
+			fmt.Sprintf("%d", section.Summary.Errors),
+			fmt.Sprintf("%d", section.Summary.Warnings),
+			fmt.Sprintf("%d", section.Summary.Infos),
+			fmt.Sprintf("%d", section.Summary.Blocking),
+		})
+	}
+	rows = append(rows, []string{
+		"total",
+		fmt.Sprintf("%d", report.Summary.Errors),
+		fmt.Sprintf("%d", report.Summary.Warnings),
+		fmt.Sprintf("%d", report.Summary.Infos),
+		fmt.Sprintf("%d", report.Summary.Blocking),
+	})
+	return headers, rows
+}
+
+func allValidationCheckRows(report *validation.AllReport) ([]string, [][]string) {
+	headers := []string{"Domain", "Severity", "Check ID", "Locale", "Field", "Resource", "Message", "Remediation"}
+	var rows [][]string
+	for _, section := range report.Sections() {
+		for _, check := range section.Checks {
+			rows = append(rows, []string{
+				section.Domain,
+				formatCheckSeverity(check),
+				check.ID,
+				check.Locale,
+				check.Field,
+				formatResource(check.ResourceType, check.ResourceID),
+				check.Message,
+				check.Remediation,
+			})
+		}
+	}
+	if len(rows) == 0 {
+		return headers, [][]string{{"", "info", "validation.ok", "", "", "", "No issues found", ""}}
+	}
+	return headers, rows
+}
+
 func formatResource(resourceType, resourceID string) string {
 	if resourceType == "" && resourceID == "" {
 		return ""
@@ -197,6 +250,13 @@ func formatResource(resourceType, resourceID string) string {
 	return strings.TrimSpace(resourceType) + ":" + strings.TrimSpace(resourceID)
 }
 
+func formatCheckSeverity(check validation.CheckResult) string {
+	if check.Ignored {
+		return string(check.Severity) + " (ignored)"
+	}
+	return string(check.Severity)
+}
+
 func formatBool(value bool) string {
 	if value {
 		return "true"
@@ -54,6 +54,7 @@ type AppStoreVersionAttachBuildResult struct {
 type AppStoreVersionReleaseRequestResult struct {
 	ReleaseRequestID string `json:"releaseRequestId"`
 	VersionID        string `json:"versionId"`
+	State            string `json:"state,omitempty"`
 }
 
 func appStoreVersionsRows(resp *AppStoreVersionsResponse) ([]string, [][]string) {
@@ -156,7 +157,7 @@ func appStoreVersionAttachBuildRows(result *AppStoreVersionAttachBuildResult) ([
 }
 
 func appStoreVersionReleaseRequestRows(result *AppStoreVersionReleaseRequestResult) ([]string, [][]string) {
-	headers := []string{"Release Request ID", "Version ID"}
-	rows := [][]string{{result.ReleaseRequestID, result.VersionID}}
+	headers := []string{"Release Request ID", "Version ID", "State"}
+	rows := [][]string{{result.ReleaseRequestID, result.VersionID, result.State}}
 	return headers, rows
 }
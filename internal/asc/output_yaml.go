@@ -0,0 +1,40 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PrintYAML prints data as YAML using the same field names and ordering as JSON output.
+func PrintYAML(data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encode yaml: %w", err)
+	}
+
+	// JSON is valid YAML, so decoding into a node keeps key order and types.
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return fmt.Errorf("encode yaml: %w", err)
+	}
+	resetYAMLStyle(&node)
+
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return fmt.Errorf("encode yaml: %w", err)
+	}
+	return enc.Close()
+}
+
+// resetYAMLStyle drops the flow/quoted styles inherited from the JSON source
+// so the encoder emits block-style YAML and only quotes where required.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
@@ -0,0 +1,45 @@
+package asc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrintYAML_UsesJSONFieldNamesAndOrder(t *testing.T) {
+	resp := &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{
+				Type: ResourceTypeApps,
+				ID:   "123",
+				Attributes: AppAttributes{
+					Name:     "Demo",
+					BundleID: "com.example.demo",
+				},
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintYAML(resp)
+	})
+
+	for _, want := range []string{
+		"data:\n  - type: apps\n    id: \"123\"\n",
+		"attributes:\n      name: Demo\n      bundleId: com.example.demo\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestPrintYAML_QuotesAmbiguousStrings(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return PrintYAML(map[string]any{"flag": "true", "count": 2, "note": "a: b"})
+	})
+
+	want := "count: 2\nflag: \"true\"\nnote: 'a: b'\n"
+	if output != want {
+		t.Fatalf("expected %q, got %q", want, output)
+	}
+}
@@ -0,0 +1,55 @@
+package asc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// CursorSaver persists the next-page URL after each page is consumed. It is
+// called with an empty string once pagination reaches the last page.
+type CursorSaver func(next string) error
+
+// WithResumeCursor replaces the loop's first page with the page at resumeURL,
+// typically a links.next value saved by a previous run. Empty means start from
+// the first page.
+func WithResumeCursor(resumeURL string) PaginateOption {
+	return func(cfg *paginateConfig) {
+		cfg.resumeURL = resumeURL
+	}
+}
+
+// WithCursorSaver reports links.next to save after every page of this loop.
+func WithCursorSaver(save CursorSaver) PaginateOption {
+	return func(cfg *paginateConfig) {
+		cfg.saveCursor = save
+	}
+}
+
+// resumePagination swaps firstPage for the page at the loop's resume URL, if
+// one is configured.
+func (cfg paginateConfig) resumePagination(ctx context.Context, firstPage PaginatedResponse, fetchNext PaginateFunc) (PaginatedResponse, error) {
+	if cfg.resumeURL == "" {
+		return firstPage, nil
+	}
+	resumed, err := fetchNext(ctx, cfg.resumeURL)
+	if err != nil {
+		return nil, fmt.Errorf("resume cursor: %w", err)
+	}
+	if reflect.TypeOf(resumed) != reflect.TypeOf(firstPage) {
+		return nil, fmt.Errorf("resume cursor: unexpected response type (expected %T, got %T)", firstPage, resumed)
+	}
+	return resumed, nil
+}
+
+// saveNext records next (or completion, when next is empty) if the loop has a
+// cursor saver.
+func (cfg paginateConfig) saveNext(next string) error {
+	if cfg.saveCursor == nil {
+		return nil
+	}
+	if err := cfg.saveCursor(next); err != nil {
+		return fmt.Errorf("save cursor: %w", err)
+	}
+	return nil
+}
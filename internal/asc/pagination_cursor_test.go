@@ -0,0 +1,89 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPaginateAll_SavesCursorAfterEachPage(t *testing.T) {
+	var saved []string
+	save := WithCursorSaver(func(next string) error {
+		saved = append(saved, next)
+		return nil
+	})
+
+	if _, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 3), fetchMockBetaGroupsPage(2, 3), save); err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	if want := []string{"page=2", "page=3", ""}; !slices.Equal(saved, want) {
+		t.Fatalf("expected saved cursors %v, got %v", want, saved)
+	}
+}
+
+func TestPaginateAll_KeepsLastCursorWhenFetchFails(t *testing.T) {
+	var last string
+	save := WithCursorSaver(func(next string) error {
+		last = next
+		return nil
+	})
+
+	_, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 3), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		if nextURL == "page=3" {
+			return nil, errors.New("connection reset")
+		}
+		return fetchMockBetaGroupsPage(2, 3)(ctx, nextURL)
+	}, save)
+	if err == nil {
+		t.Fatal("expected fetch error")
+	}
+	if last != "page=3" {
+		t.Fatalf("expected cursor to point at the failed page, got %q", last)
+	}
+}
+
+func TestPaginateAll_ResumesFromCursorOnlyWhenConfigured(t *testing.T) {
+	result, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 3), fetchMockBetaGroupsPage(2, 3), WithResumeCursor("page=3"))
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	groups := result.(*BetaGroupsResponse)
+	if len(groups.Data) != 2 || groups.Data[0].ID != "group-3-0" {
+		t.Fatalf("expected only the resumed page, got %+v", groups.Data)
+	}
+
+	result, err = PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 3), fetchMockBetaGroupsPage(2, 3))
+	if err != nil {
+		t.Fatalf("second PaginateAll() error: %v", err)
+	}
+	if got := len(result.(*BetaGroupsResponse).Data); got != 6 {
+		t.Fatalf("expected a loop without a resume cursor to start from the first page, got %d items", got)
+	}
+}
+
+func TestPaginateEach_ResumesAndSavesCursor(t *testing.T) {
+	var saved []string
+	save := WithCursorSaver(func(next string) error {
+		saved = append(saved, next)
+		return nil
+	})
+
+	var ids []string
+	err := PaginateEach(context.Background(), makeBetaGroupsPage(1, 1, 3), fetchMockBetaGroupsPage(1, 3), func(page PaginatedResponse) error {
+		for _, item := range page.(*BetaGroupsResponse).Data {
+			ids = append(ids, item.ID)
+		}
+		return nil
+	}, WithResumeCursor("page=2"), save)
+	if err != nil {
+		t.Fatalf("PaginateEach() error: %v", err)
+	}
+	if want := []string{"group-2-0", "group-3-0"}; !slices.Equal(ids, want) {
+		t.Fatalf("expected ids %v, got %v", want, ids)
+	}
+	if want := []string{"page=3", ""}; !slices.Equal(saved, want) {
+		t.Fatalf("expected saved cursors %v, got %v", want, saved)
+	}
+}
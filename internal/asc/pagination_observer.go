@@ -0,0 +1,66 @@
+package asc
+
+import (
+	"reflect"
+)
+
+// PageObserver receives progress updates from PaginateAll and PaginateEach.
+type PageObserver interface {
+	// PageFetched reports the running page and item counts after each page.
+	PageFetched(pages, items int)
+	// PaginationDone is called once when a pagination loop finishes.
+	PaginationDone()
+}
+
+// PaginateOption configures a single PaginateAll or PaginateEach call.
+type PaginateOption func(*paginateConfig)
+
+type paginateConfig struct {
+	observer   PageObserver
+	maxItems   int
+	resumeURL  string
+	saveCursor CursorSaver
+	rawPages   func(body []byte)
+}
+
+func newPaginateConfig(opts []PaginateOption) paginateConfig {
+	var cfg paginateConfig
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+// WithMaxItems stops the loop after the page that brings the total to at least
+// n items. Values <= 0 mean unlimited.
+func WithMaxItems(n int) PaginateOption {
+	return func(cfg *paginateConfig) {
+		cfg.maxItems = max(n, 0)
+	}
+}
+
+// WithPageObserver reports this loop's progress to observer.
+func WithPageObserver(observer PageObserver) PaginateOption {
+	return func(cfg *paginateConfig) {
+		cfg.observer = observer
+	}
+}
+
+// pageItemCount returns the number of items in a page's Data slice.
+func pageItemCount(page PaginatedResponse) int {
+	if page == nil || reflect.ValueOf(page).IsNil() {
+		return 0
+	}
+	data := reflect.ValueOf(page.GetData())
+	if data.Kind() != reflect.Slice {
+		return 0
+	}
+	return data.Len()
+}
+
+// reachedMaxItems reports whether items meets the loop's --max-items cap.
+func (cfg paginateConfig) reachedMaxItems(items int) bool {
+	return cfg.maxItems > 0 && items >= cfg.maxItems
+}
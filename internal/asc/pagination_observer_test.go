@@ -0,0 +1,135 @@
+package asc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+type recordingPageObserver struct {
+	updates []string
+	done    int
+}
+
+func (o *recordingPageObserver) PageFetched(pages, items int) {
+	o.updates = append(o.updates, fmt.Sprintf("%d/%d", pages, items))
+}
+
+func (o *recordingPageObserver) PaginationDone() {
+	o.done++
+}
+
+func fetchMockBetaGroupsPage(perPage, totalPages int) PaginateFunc {
+	return func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		page, err := parseMockPageNum(nextURL)
+		if err != nil {
+			return nil, err
+		}
+		return makeBetaGroupsPage(page, perPage, totalPages), nil
+	}
+}
+
+func TestPaginateAll_ReportsProgressToObserver(t *testing.T) {
+	observer := &recordingPageObserver{}
+
+	if _, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 3), fetchMockBetaGroupsPage(2, 3), WithPageObserver(observer)); err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	if want := []string{"1/2", "2/4", "3/6"}; !slices.Equal(observer.updates, want) {
+		t.Fatalf("expected updates %v, got %v", want, observer.updates)
+	}
+	if observer.done != 1 {
+		t.Fatalf("expected PaginationDone once, got %d", observer.done)
+	}
+}
+
+func TestPaginateEach_ReportsProgressToObserver(t *testing.T) {
+	observer := &recordingPageObserver{}
+
+	err := PaginateEach(context.Background(), makeBetaGroupsPage(1, 3, 2), fetchMockBetaGroupsPage(3, 2), func(PaginatedResponse) error {
+		return nil
+	}, WithPageObserver(observer))
+	if err != nil {
+		t.Fatalf("PaginateEach() error: %v", err)
+	}
+
+	if want := []string{"1/3", "2/6"}; !slices.Equal(observer.updates, want) {
+		t.Fatalf("expected updates %v, got %v", want, observer.updates)
+	}
+	if observer.done != 1 {
+		t.Fatalf("expected PaginationDone once, got %d", observer.done)
+	}
+}
+
+func TestPaginateAll_ObserverIsScopedToItsLoop(t *testing.T) {
+	observer := &recordingPageObserver{}
+
+	if _, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 2), fetchMockBetaGroupsPage(2, 2), WithPageObserver(observer)); err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	// A second loop without the option, like an internal lookup, is not observed.
+	if _, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 3), fetchMockBetaGroupsPage(2, 3)); err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	if want := []string{"1/2", "2/4"}; !slices.Equal(observer.updates, want) {
+		t.Fatalf("expected only the observed loop's updates %v, got %v", want, observer.updates)
+	}
+	if observer.done != 1 {
+		t.Fatalf("expected PaginationDone once, got %d", observer.done)
+	}
+}
+
+func TestPaginateAll_StopsAtMaxItemsWithNextLink(t *testing.T) {
+	fetchCalls := 0
+	fetch := fetchMockBetaGroupsPage(2, 5)
+	result, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 5), func(ctx context.Context, nextURL string) (PaginatedResponse, error) {
+		fetchCalls++
+		return fetch(ctx, nextURL)
+	}, WithMaxItems(3))
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	groups := result.(*BetaGroupsResponse)
+	if len(groups.Data) != 4 {
+		t.Fatalf("expected two whole pages (4 items), got %d", len(groups.Data))
+	}
+	if fetchCalls != 1 {
+		t.Fatalf("expected 1 follow-up fetch, got %d", fetchCalls)
+	}
+	if groups.Links.Next != "page=3" {
+		t.Fatalf("expected links.next to point at page 3, got %q", groups.Links.Next)
+	}
+}
+
+func TestPaginateAll_MaxItemsBeyondTotalFetchesEverything(t *testing.T) {
+	result, err := PaginateAll(context.Background(), makeBetaGroupsPage(1, 2, 3), fetchMockBetaGroupsPage(2, 3), WithMaxItems(100))
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+
+	groups := result.(*BetaGroupsResponse)
+	if len(groups.Data) != 6 {
+		t.Fatalf("expected 6 items, got %d", len(groups.Data))
+	}
+	if groups.Links.Next != "" {
+		t.Fatalf("expected no next link after final page, got %q", groups.Links.Next)
+	}
+}
+
+func TestPaginateEach_StopsAtMaxItems(t *testing.T) {
+	pages := 0
+	err := PaginateEach(context.Background(), makeBetaGroupsPage(1, 2, 3), fetchMockBetaGroupsPage(2, 3), func(PaginatedResponse) error {
+		pages++
+		return nil
+	}, WithMaxItems(2))
+	if err != nil {
+		t.Fatalf("PaginateEach() error: %v", err)
+	}
+	if pages != 1 {
+		t.Fatalf("expected pagination to stop after 1 page, got %d", pages)
+	}
+}
@@ -50,3 +50,49 @@ func PollUntil[T any](ctx context.Context, interval time.Duration, check func(co
 		}
 	}
 }
+
+// PollUntilWithBackoff behaves like PollUntil but doubles the wait between
+// checks after each attempt, starting at initial and capped at maxInterval.
+func PollUntilWithBackoff[T any](ctx context.Context, initial, maxInterval time.Duration, check func(context.Context) (T, bool, error)) (T, error) {
+	var zero T
+
+	if initial <= 0 {
+		return zero, fmt.Errorf("poll interval must be greater than zero")
+	}
+	if maxInterval < initial {
+		maxInterval = initial
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	interval := initial
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		default:
+		}
+
+		value, done, err := check(ctx)
+		if err != nil {
+			return zero, err
+		}
+		if done {
+			return value, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return zero, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
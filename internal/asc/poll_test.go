@@ -131,3 +131,66 @@ func TestPollUntilRespectsCanceledContextDuringPolling(t *testing.T) {
 		t.Fatalf("expected at least 2 poll calls before cancel, got %d", calls)
 	}
 }
+
+func TestPollUntilWithBackoffRetriesUntilDone(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	got, err := PollUntilWithBackoff(context.Background(), time.Millisecond, 4*time.Millisecond, func(ctx context.Context) (string, bool, error) {
+		calls++
+		if calls < 4 {
+			return "pending", false, nil
+		}
+		return "done", true, nil
+	})
+	if err != nil {
+		t.Fatalf("PollUntilWithBackoff() error = %v", err)
+	}
+	if got != "done" {
+		t.Fatalf("PollUntilWithBackoff() = %q, want %q", got, "done")
+	}
+	if calls != 4 {
+		t.Fatalf("expected 4 poll calls, got %d", calls)
+	}
+}
+
+func TestPollUntilWithBackoffIncreasesWaitBetweenChecks(t *testing.T) {
+	t.Parallel()
+
+	var stamps []time.Time
+	_, err := PollUntilWithBackoff(context.Background(), 5*time.Millisecond, 20*time.Millisecond, func(ctx context.Context) (int, bool, error) {
+		stamps = append(stamps, time.Now())
+		return 0, len(stamps) == 4, nil
+	})
+	if err != nil {
+		t.Fatalf("PollUntilWithBackoff() error = %v", err)
+	}
+	// Waits are 5ms, 10ms, 20ms; the last gap must reach the capped interval.
+	if gap := stamps[3].Sub(stamps[2]); gap < 20*time.Millisecond {
+		t.Fatalf("expected third wait of at least 20ms, got %v", gap)
+	}
+}
+
+func TestPollUntilWithBackoffReturnsPollError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("boom")
+	_, err := PollUntilWithBackoff(context.Background(), time.Millisecond, time.Second, func(ctx context.Context) (int, bool, error) {
+		return 0, false, expectedErr
+	})
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("PollUntilWithBackoff() error = %v, want %v", err, expectedErr)
+	}
+}
+
+func TestPollUntilWithBackoffRejectsZeroInterval(t *testing.T) {
+	t.Parallel()
+
+	_, err := PollUntilWithBackoff(context.Background(), 0, time.Second, func(ctx context.Context) (int, bool, error) {
+		t.Fatal("check should not be called with zero interval")
+		return 0, false, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "poll interval must be greater than zero") {
+		t.Fatalf("expected zero interval error, got %v", err)
+	}
+}
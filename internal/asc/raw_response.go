@@ -0,0 +1,58 @@
+package asc
+
+import "sync"
+
+// rawResponses holds the most recent successful response body while raw
+// capture is enabled. --raw prints the body of a command's own request, which
+// is the last one it makes before rendering, rather than every lookup made on
+// its behalf.
+var rawResponses struct {
+	mu      sync.Mutex
+	enabled bool
+	last    []byte
+}
+
+// SetRawResponseCapture enables or disables recording of response bodies and
+// drops any body recorded so far.
+func SetRawResponseCapture(enabled bool) {
+	rawResponses.mu.Lock()
+	defer rawResponses.mu.Unlock()
+	rawResponses.enabled = enabled
+	rawResponses.last = nil
+}
+
+// TakeLastRawResponse returns the most recently recorded response body and
+// clears it, or nil when nothing was recorded.
+func TakeLastRawResponse() []byte {
+	rawResponses.mu.Lock()
+	defer rawResponses.mu.Unlock()
+	last := rawResponses.last
+	rawResponses.last = nil
+	return last
+}
+
+func recordRawResponse(body []byte) {
+	rawResponses.mu.Lock()
+	defer rawResponses.mu.Unlock()
+	if rawResponses.enabled {
+		rawResponses.last = body
+	}
+}
+
+// WithRawPages passes the unparsed body of every page in this loop to write,
+// in order, as the loop consumes it.
+func WithRawPages(write func(body []byte)) PaginateOption {
+	return func(cfg *paginateConfig) {
+		cfg.rawPages = write
+	}
+}
+
+// writeRawPage hands the page the loop just received to its raw writer.
+func (cfg paginateConfig) writeRawPage() {
+	if cfg.rawPages == nil {
+		return
+	}
+	if body := TakeLastRawResponse(); body != nil {
+		cfg.rawPages(body)
+	}
+}
@@ -24,6 +24,14 @@ type CertificateRevokeResult struct {
 	Revoked bool   `json:"revoked"`
 }
 
+// CertificateDownloadResult represents CLI output for certificate downloads.
+type CertificateDownloadResult struct {
+	ID         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	Format     string `json:"format"`
+	OutputPath string `json:"outputPath"`
+}
+
 // ProfileDeleteResult represents CLI output for profile deletions.
 type ProfileDeleteResult struct {
 	ID      string `json:"id"`
@@ -37,6 +45,12 @@ type ProfileDownloadResult struct {
 	OutputPath string `json:"outputPath"`
 }
 
+// ProfilesDownloadResult represents CLI output for bulk profile downloads.
+type ProfilesDownloadResult struct {
+	OutputDir string                  `json:"outputDir"`
+	Profiles  []ProfileDownloadResult `json:"profiles"`
+}
+
 func bundleIDsRows(resp *BundleIDsResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Identifier", "Platform", "Seed ID"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -113,6 +127,17 @@ func profilesRows(resp *ProfilesResponse) ([]string, [][]string) {
 	return headers, rows
 }
 
+func certificateDownloadResultRows(result *CertificateDownloadResult) ([]string, [][]string) {
+	headers := []string{"ID", "Name", "Format", "Output Path"}
+	rows := [][]string{{
+		result.ID,
+		compactWhitespace(result.Name),
+		result.Format,
+		result.OutputPath,
+	}}
+	return headers, rows
+}
+
 func profileDeleteResultRows(result *ProfileDeleteResult) ([]string, [][]string) {
 	headers := []string{"ID", "Deleted"}
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Deleted)}}
@@ -129,6 +154,19 @@ func profileDownloadResultRows(result *ProfileDownloadResult) ([]string, [][]str
 	return headers, rows
 }
 
+func profilesDownloadResultRows(result *ProfilesDownloadResult) ([]string, [][]string) {
+	headers := []string{"ID", "Name", "Output Path"}
+	rows := make([][]string, 0, len(result.Profiles))
+	for _, item := range result.Profiles {
+		rows = append(rows, []string{
+			item.ID,
+			compactWhitespace(item.Name),
+			item.OutputPath,
+		})
+	}
+	return headers, rows
+}
+
 func joinSigningList(values []string) string {
 	if len(values) == 0 {
 		return ""
@@ -0,0 +1,175 @@
+package asc
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+	ansiReset  = "\033[0m"
+)
+
+// tableColor enables state highlighting in RenderTable. The CLI decides when
+// color is appropriate (TTY, NO_COLOR, --no-color) and sets it before rendering.
+var tableColor atomic.Bool
+
+// SetTableColor toggles colored state values in RenderTable output.
+func SetTableColor(enabled bool) {
+	tableColor.Store(enabled)
+}
+
+type stateTone int
+
+const (
+	stateToneNone stateTone = iota
+	stateToneBad
+	stateToneGood
+	stateTonePending
+)
+
+var (
+	badStates = map[string]struct{}{
+		"REJECTED": {}, "METADATA_REJECTED": {}, "DEVELOPER_REJECTED": {}, "BETA_REJECTED": {},
+		"INVALID": {}, "INVALID_BINARY": {}, "FAILED": {}, "FAILURE": {}, "FAIL": {},
+		"ERROR": {}, "ERRORS": {}, "PROCESSING_EXCEPTION": {},
+	}
+	goodStates = map[string]struct{}{
+		"READY": {}, "READY_FOR_SALE": {}, "READY_FOR_DISTRIBUTION": {}, "READY_FOR_REVIEW": {},
+		"READY_TO_SUBMIT": {}, "READY_FOR_TESTING": {}, "READY_FOR_BETA_TESTING": {},
+		"READY_FOR_BETA_SUBMISSION": {},
+		"VALID":                     {}, "APPROVED": {}, "ACCEPTED": {}, "COMPLETE": {}, "COMPLETED": {},
+		"PASS": {}, "PASSED": {}, "OK": {}, "SUCCESS": {}, "SUCCEEDED": {},
+	}
+	pendingStates = map[string]struct{}{
+		"WAITING": {}, "WAITING_FOR_REVIEW": {}, "WAITING_FOR_BETA_REVIEW": {},
+		"WAITING_FOR_EXPORT_COMPLIANCE": {}, "PENDING": {}, "PENDING_RELEASE": {},
+		"PENDING_DEVELOPER_RELEASE": {}, "PENDING_APPLE_RELEASE": {}, "PENDING_CONTRACT": {},
+		"IN_REVIEW": {}, "IN_PROGRESS": {}, "IN_PROCESSING": {}, "PROCESSING": {},
+		"PREPARE_FOR_SUBMISSION": {}, "QUEUED": {}, "RUNNING": {},
+		"WARN": {}, "WARNING": {}, "WARNINGS": {},
+	}
+)
+
+// stateIconWidth is the display width colorizeState adds before a value.
+const stateIconWidth = 2
+
+// classifyState maps a table cell to a tone when its whole value is a known
+// state. Free text that merely mentions a state is left alone.
+func classifyState(value string) stateTone {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	normalized = strings.NewReplacer(" ", "_", "-", "_").Replace(normalized)
+	if _, ok := badStates[normalized]; ok {
+		return stateToneBad
+	}
+	if _, ok := goodStates[normalized]; ok {
+		return stateToneGood
+	}
+	if _, ok := pendingStates[normalized]; ok {
+		return stateTonePending
+	}
+	return stateToneNone
+}
+
+// isStateHeader reports whether a column or field name holds state values,
+// such as "State", "Delivery State", "Review Status", or "Severity".
+func isStateHeader(header string) bool {
+	words := strings.Fields(strings.ToLower(header))
+	if len(words) == 0 || words[len(words)-1] == "id" {
+		return false
+	}
+	for _, word := range words {
+		if word == "state" || word == "status" || word == "severity" {
+			return true
+		}
+	}
+	return false
+}
+
+// classifyStateRows returns the tone of every cell in a state column, or nil
+// when the table has none. In two-column field/value tables, values whose
+// field is a state name count as state cells.
+func classifyStateRows(headers []string, rows [][]string) [][]stateTone {
+	columns := make([]bool, len(headers))
+	found := false
+	for i, header := range headers {
+		columns[i] = isStateHeader(header)
+		found = found || columns[i]
+	}
+	keyValue := len(headers) == 2 && strings.EqualFold(strings.TrimSpace(headers[1]), "value")
+	if !found && !keyValue {
+		return nil
+	}
+
+	tones := make([][]stateTone, len(rows))
+	for r, row := range rows {
+		tones[r] = make([]stateTone, len(row))
+		for c, cell := range row {
+			stateCell := c < len(columns) && columns[c]
+			if keyValue && c == 1 {
+				stateCell = isStateHeader(row[0])
+			}
+			if stateCell {
+				tones[r][c] = classifyState(cell)
+			}
+		}
+	}
+	return tones
+}
+
+// stateIconReserve returns, per column, the width colorizeStateRows may add.
+func stateIconReserve(columns int, tones [][]stateTone) []int {
+	if tones == nil {
+		return nil
+	}
+	reserve := make([]int, columns)
+	for _, row := range tones {
+		for c, tone := range row {
+			if tone != stateToneNone && c < columns {
+				reserve[c] = stateIconWidth
+			}
+		}
+	}
+	return reserve
+}
+
+// colorizeState prefixes a state icon and wraps each line of value in the
+// tone's ANSI color, so wrapped cells stay colored line by line.
+func colorizeState(value string, tone stateTone) string {
+	var color, icon string
+	switch tone {
+	case stateToneBad:
+		color, icon = ansiRed, "✗ "
+	case stateToneGood:
+		color, icon = ansiGreen, "✓ "
+	case stateTonePending:
+		color, icon = ansiYellow, "● "
+	default:
+		return value
+	}
+	lines := strings.Split(value, "\n")
+	for i, line := range lines {
+		if i == 0 {
+			line = icon + line
+		}
+		lines[i] = color + line + ansiReset
+	}
+	return strings.Join(lines, "\n")
+}
+
+func colorizeStateRows(rows [][]string, tones [][]stateTone) [][]string {
+	colored := make([][]string, len(rows))
+	for i, row := range rows {
+		colored[i] = make([]string, len(row))
+		for j, cell := range row {
+			tone := stateToneNone
+			if i < len(tones) && j < len(tones[i]) {
+				tone = tones[i][j]
+			}
+			colored[i][j] = colorizeState(cell, tone)
+		}
+	}
+	return colored
+}
@@ -0,0 +1,139 @@
+package asc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// tableColumns restricts RenderTable and RenderCSV while a WithTableColumns
+// call is active. err records the first unknown column so the caller can
+// report it after the renderers return.
+var tableColumns struct {
+	mu      sync.Mutex
+	columns []string
+	err     error
+}
+
+// WithTableColumns runs fn with RenderTable and RenderCSV rendering only the
+// named columns, in the given order. Names match headers case-insensitively,
+// ignoring spaces and punctuation, so "created-date" selects "Created Date".
+// A table without one of the columns is skipped and the error is returned.
+func WithTableColumns(columns []string, fn func() error) error {
+	if len(columns) == 0 {
+		return fn()
+	}
+
+	tableColumns.mu.Lock()
+	tableColumns.columns = columns
+	tableColumns.err = nil
+	tableColumns.mu.Unlock()
+	defer func() {
+		tableColumns.mu.Lock()
+		tableColumns.columns = nil
+		tableColumns.err = nil
+		tableColumns.mu.Unlock()
+	}()
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	tableColumns.mu.Lock()
+	defer tableColumns.mu.Unlock()
+	return tableColumns.err
+}
+
+// selectTableColumns applies the active WithTableColumns selection. It
+// reports false when a requested column is missing and nothing should render.
+func selectTableColumns(headers []string, rows [][]string) ([]string, [][]string, bool) {
+	tableColumns.mu.Lock()
+	defer tableColumns.mu.Unlock()
+	if len(tableColumns.columns) == 0 {
+		return headers, rows, true
+	}
+
+	indexes := make([]int, 0, len(tableColumns.columns))
+	for _, column := range tableColumns.columns {
+		index := -1
+		for i, header := range headers {
+			if columnKey(header) == columnKey(column) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			if tableColumns.err == nil {
+				tableColumns.err = fmt.Errorf("unknown column %q (available: %s)", column, strings.Join(columnNames(headers), ", "))
+			}
+			return nil, nil, false
+		}
+		indexes = append(indexes, index)
+	}
+
+	selectedHeaders := make([]string, len(indexes))
+	for i, index := range indexes {
+		selectedHeaders[i] = headers[index]
+	}
+	selectedRows := make([][]string, len(rows))
+	for r, row := range rows {
+		selected := make([]string, len(indexes))
+		for i, index := range indexes {
+			if index < len(row) {
+				selected[i] = row[index]
+			}
+		}
+		selectedRows[r] = selected
+	}
+	return selectedHeaders, selectedRows, true
+}
+
+// knownTableColumns holds the column key of every header a registered output
+// type renders, built from zero values on first use.
+var knownTableColumns = sync.OnceValue(func() map[string]struct{} {
+	known := make(map[string]struct{})
+	add := func(headers []string, _ [][]string) {
+		for _, header := range headers {
+			known[columnKey(header)] = struct{}{}
+		}
+	}
+	for t, fn := range outputRegistry {
+		if headers, rows, err := fn(reflect.Zero(t).Interface()); err == nil {
+			add(headers, rows)
+		}
+	}
+	for t, fn := range directRenderRegistry {
+		_ = fn(reflect.Zero(t).Interface(), add)
+	}
+	return known
+})
+
+// IsKnownTableColumn reports whether name matches a header of any registered
+// table output, so a mistyped --columns name can be rejected before the
+// command makes any request.
+func IsKnownTableColumn(name string) bool {
+	_, ok := knownTableColumns()[columnKey(name)]
+	return ok
+}
+
+// columnKey folds a header or --columns name to letters and digits.
+func columnKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// columnNames returns headers as lowercase, hyphenated --columns names.
+func columnNames(headers []string) []string {
+	names := make([]string, len(headers))
+	for i, header := range headers {
+		names[i] = strings.Join(strings.Fields(strings.ToLower(header)), "-")
+	}
+	return names
+}
@@ -0,0 +1,53 @@
+package asc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWithTableColumns_SelectsAndOrdersColumns(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return WithTableColumns([]string{"bundle-id", "id"}, func() error {
+			RenderCSV([]string{"ID", "Name", "Bundle ID"}, [][]string{{"1", "Demo", "com.example.demo"}})
+			return nil
+		})
+	})
+
+	if output != "Bundle ID,ID\ncom.example.demo,1\n" {
+		t.Fatalf("expected selected CSV columns, got %q", output)
+	}
+}
+
+func TestWithTableColumns_ReportsUnknownColumn(t *testing.T) {
+	var err error
+	output := captureStdout(t, func() error {
+		err = WithTableColumns([]string{"id", "version"}, func() error {
+			RenderTable([]string{"ID", "Bundle ID"}, [][]string{{"1", "com.example.demo"}})
+			return nil
+		})
+		return nil
+	})
+
+	if output != "" {
+		t.Fatalf("expected no output for unknown column, got %q", output)
+	}
+	if err == nil || !strings.Contains(err.Error(), `unknown column "version" (available: id, bundle-id)`) {
+		t.Fatalf("expected unknown column error, got %v", err)
+	}
+	if len(tableColumns.columns) != 0 {
+		t.Fatal("expected column selection to be reset after WithTableColumns")
+	}
+}
+
+func TestIsKnownTableColumn(t *testing.T) {
+	for _, name := range []string{"id", "bundle-id", "Bundle ID", "state", "created-date"} {
+		if !IsKnownTableColumn(name) {
+			t.Errorf("expected %q to be a known column", name)
+		}
+	}
+	for _, name := range []string{"", "bogus", "bundel-id"} {
+		if IsKnownTableColumn(name) {
+			t.Errorf("expected %q to be unknown", name)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package asc
+
+import (
+	"strings"
+
+	"github.com/olekukonko/tablewriter/pkg/twwarp"
+	"github.com/olekukonko/tablewriter/pkg/twwidth"
+)
+
+// minFittedColumnWidth is the narrowest a column is wrapped to. It keeps
+// IDs, states, and timestamps intact so only long free-form text wraps;
+// columns are never narrowed below their header width either.
+const minFittedColumnWidth = 32
+
+// fitTableRows word-wraps cell text so a bordered table renders within
+// maxWidth terminal columns. The widest columns are narrowed first, so short
+// columns such as IDs and states keep their full width. Tables made only of
+// short columns are left as-is even when they overflow. reserved optionally
+// holds, per column, display width that is added to cells after fitting.
+func fitTableRows(headers []string, rows [][]string, maxWidth int, reserved ...int) [][]string {
+	columns := len(headers)
+	if columns == 0 || maxWidth <= 0 {
+		return rows
+	}
+
+	natural := make([]int, columns)
+	floors := make([]int, columns)
+	for i, header := range headers {
+		natural[i] = cellWidth(header)
+		floors[i] = max(natural[i], minFittedColumnWidth)
+	}
+	extra := make([]int, columns)
+	copy(extra, reserved)
+	for _, row := range rows {
+		for i := 0; i < columns && i < len(row); i++ {
+			natural[i] = max(natural[i], cellWidth(row[i])+extra[i])
+		}
+	}
+
+	// Each column adds a border and one space of padding on either side.
+	available := maxWidth - (3*columns + 1)
+	widths := append([]int(nil), natural...)
+	total := 0
+	for _, width := range widths {
+		total += width
+	}
+	for total > available {
+		widest := -1
+		for i, width := range widths {
+			if width > min(floors[i], natural[i]) && (widest == -1 || width > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest == -1 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+
+	fitted := make([][]string, len(rows))
+	for r, row := range rows {
+		fitted[r] = append([]string(nil), row...)
+		for i := 0; i < columns && i < len(row); i++ {
+			if widths[i] < natural[i] {
+				fitted[r][i] = wrapCell(row[i], max(widths[i]-extra[i], 1))
+			}
+		}
+	}
+	return fitted
+}
+
+// cellWidth returns the display width of the widest line in a cell.
+func cellWidth(value string) int {
+	width := 0
+	for _, line := range strings.Split(value, "\n") {
+		width = max(width, twwidth.Width(line))
+	}
+	return width
+}
+
+// wrapCell word-wraps value to width, hard-breaking words that do not fit.
+func wrapCell(value string, width int) string {
+	var lines []string
+	for _, line := range strings.Split(value, "\n") {
+		if twwidth.Width(line) <= width {
+			lines = append(lines, line)
+			continue
+		}
+		wrapped, _ := twwarp.WrapString(line, width)
+		for _, part := range wrapped {
+			lines = append(lines, breakWord(part, width)...)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func breakWord(value string, width int) []string {
+	var parts []string
+	var current strings.Builder
+	currentWidth := 0
+	for _, r := range value {
+		runeWidth := twwidth.Width(string(r))
+		if currentWidth+runeWidth > width && currentWidth > 0 {
+			parts = append(parts, current.String())
+			current.Reset()
+			currentWidth = 0
+		}
+		current.WriteRune(r)
+		currentWidth += runeWidth
+	}
+	return append(parts, current.String())
+}
@@ -1,7 +1,9 @@
 package asc
 
 import (
+	"encoding/csv"
 	"os"
+	"sync/atomic"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/olekukonko/tablewriter/renderer"
@@ -11,7 +13,31 @@ import (
 // RenderTable writes a bordered Unicode table to stdout.
 // Headers preserve their original casing and are center-aligned.
 // Data rows are left-aligned for readability.
+// Values in state and status columns are colored when enabled via SetTableColor.
+// Cells are word-wrapped to fit the width set via SetTableMaxWidth.
+// Inside WithCSVTables the same headers and rows are written as CSV instead.
+// Inside WithTableColumns only the selected columns are rendered.
 func RenderTable(headers []string, rows [][]string) {
+	if csvTables.Load() {
+		RenderCSV(headers, rows)
+		return
+	}
+	headers, rows, ok := selectTableColumns(headers, rows)
+	if !ok {
+		return
+	}
+	// States are classified before wrapping, and the icon width is reserved
+	// so colored rows still fit.
+	var tones [][]stateTone
+	if tableColor.Load() {
+		tones = classifyStateRows(headers, rows)
+	}
+	if width := tableMaxWidth.Load(); width > 0 {
+		rows = fitTableRows(headers, rows, int(width), stateIconReserve(len(headers), tones)...)
+	}
+	if tones != nil {
+		rows = colorizeStateRows(rows, tones)
+	}
 	table := tablewriter.NewTable(os.Stdout,
 		tablewriter.WithConfig(tablewriter.Config{
 			Header: tw.CellConfig{
@@ -30,6 +56,15 @@ func RenderTable(headers []string, rows [][]string) {
 	_ = table.Render()
 }
 
+// tableMaxWidth caps RenderTable output width; 0 leaves cells unwrapped. The
+// CLI decides the width (terminal size, --no-truncate) and sets it before rendering.
+var tableMaxWidth atomic.Int64
+
+// SetTableMaxWidth sets the maximum RenderTable width in columns (0 = unlimited).
+func SetTableMaxWidth(width int) {
+	tableMaxWidth.Store(int64(max(width, 0)))
+}
+
 // RenderMarkdown writes a Markdown-formatted table to stdout.
 // Headers preserve their original casing. Data rows are left-aligned.
 // Pipe characters in cell values are escaped automatically by the renderer.
@@ -52,3 +87,27 @@ func RenderMarkdown(headers []string, rows [][]string) {
 	_ = table.Bulk(rows)
 	_ = table.Render()
 }
+
+// csvTables redirects RenderTable to RenderCSV while a WithCSVTables call is active.
+var csvTables atomic.Bool
+
+// WithCSVTables runs fn with RenderTable writing CSV, so existing table
+// renderers can serve --output csv without bespoke code.
+func WithCSVTables(fn func() error) error {
+	csvTables.Store(true)
+	defer csvTables.Store(false)
+	return fn()
+}
+
+// RenderCSV writes headers and rows to stdout as RFC 4180 CSV.
+// Fields containing commas, quotes, or newlines are quoted and escaped.
+// Inside WithTableColumns only the selected columns are written.
+func RenderCSV(headers []string, rows [][]string) {
+	headers, rows, ok := selectTableColumns(headers, rows)
+	if !ok {
+		return
+	}
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write(headers)
+	_ = w.WriteAll(rows)
+}
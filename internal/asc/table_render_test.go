@@ -0,0 +1,226 @@
+package asc
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestRenderCSV_EscapesFields(t *testing.T) {
+	output := captureStdout(t, func() error {
+		RenderCSV(
+			[]string{"ID", "Name", "Notes"},
+			[][]string{
+				{"1", "Demo, Inc.", `say "hi"`},
+				{"2", "Plain", "line one\nline two"},
+			},
+		)
+		return nil
+	})
+
+	want := "ID,Name,Notes\n" +
+		"1,\"Demo, Inc.\",\"say \"\"hi\"\"\"\n" +
+		"2,Plain,\"line one\nline two\"\n"
+	if output != want {
+		t.Fatalf("expected %q, got %q", want, output)
+	}
+}
+
+func TestWithCSVTables_RedirectsRenderTable(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return WithCSVTables(func() error {
+			RenderTable([]string{"ID", "State"}, [][]string{{"1", "READY"}})
+			return nil
+		})
+	})
+
+	if output != "ID,State\n1,READY\n" {
+		t.Fatalf("expected CSV output, got %q", output)
+	}
+	if csvTables.Load() {
+		t.Fatal("expected CSV table mode to be reset after WithCSVTables")
+	}
+}
+
+func TestPrintCSV_UsesTableColumns(t *testing.T) {
+	resp := &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{ID: "123", Attributes: AppAttributes{Name: "Demo, Pro", BundleID: "com.example.demo", SKU: "DEMO"}},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintCSV(resp)
+	})
+
+	want := "ID,Name,Bundle ID,SKU\n123,\"Demo, Pro\",com.example.demo,DEMO\n"
+	if output != want {
+		t.Fatalf("expected %q, got %q", want, output)
+	}
+}
+
+func TestClassifyState(t *testing.T) {
+	tests := []struct {
+		value string
+		want  stateTone
+	}{
+		{value: "REJECTED", want: stateToneBad},
+		{value: "METADATA_REJECTED", want: stateToneBad},
+		{value: "error", want: stateToneBad},
+		{value: "READY_FOR_SALE", want: stateToneGood},
+		{value: "READY", want: stateToneGood},
+		{value: "VALID", want: stateToneGood},
+		{value: "IN_REVIEW", want: stateTonePending},
+		{value: "WAITING_FOR_REVIEW", want: stateTonePending},
+		{value: "Processing", want: stateTonePending},
+		{value: "warning", want: stateTonePending},
+		{value: "IN_APP_PURCHASE", want: stateToneNone},
+		{value: "Ready to submit once screenshots are uploaded", want: stateToneNone},
+		{value: "The build was rejected by App Review", want: stateToneNone},
+		{value: "PENDING_SOMETHING_NEW", want: stateToneNone},
+		{value: "1.2.3", want: stateToneNone},
+		{value: "", want: stateToneNone},
+	}
+
+	for _, tc := range tests {
+		if got := classifyState(tc.value); got != tc.want {
+			t.Errorf("classifyState(%q) = %v, want %v", tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestRenderTable_ColorsStatesWhenEnabled(t *testing.T) {
+	headers := []string{"Version", "State"}
+	rows := [][]string{{"1.0", "READY_FOR_SALE"}, {"1.1", "REJECTED"}}
+
+	plain := captureStdout(t, func() error {
+		RenderTable(headers, rows)
+		return nil
+	})
+	if strings.Contains(plain, "\033[") {
+		t.Fatalf("expected no ANSI codes by default, got %q", plain)
+	}
+
+	SetTableColor(true)
+	t.Cleanup(func() { SetTableColor(false) })
+	colored := captureStdout(t, func() error {
+		RenderTable(headers, rows)
+		return nil
+	})
+	if !strings.Contains(colored, ansiGreen+"✓ READY_FOR_SALE"+ansiReset) {
+		t.Fatalf("expected green READY_FOR_SALE, got %q", colored)
+	}
+	if !strings.Contains(colored, ansiRed+"✗ REJECTED"+ansiReset) {
+		t.Fatalf("expected red REJECTED, got %q", colored)
+	}
+	if rows[1][1] != "REJECTED" {
+		t.Fatalf("expected caller rows to be left untouched, got %q", rows[1][1])
+	}
+
+	csvOutput := captureStdout(t, func() error {
+		return WithCSVTables(func() error {
+			RenderTable(headers, rows)
+			return nil
+		})
+	})
+	if strings.Contains(csvOutput, "\033[") {
+		t.Fatalf("expected CSV output without ANSI codes, got %q", csvOutput)
+	}
+}
+
+func TestRenderTable_ColorsOnlyStateColumns(t *testing.T) {
+	SetTableColor(true)
+	t.Cleanup(func() { SetTableColor(false) })
+
+	output := captureStdout(t, func() error {
+		RenderTable([]string{"Name", "Review Status", "Status ID"}, [][]string{{"READY", "REJECTED", "VALID"}})
+		return nil
+	})
+	if !strings.Contains(output, ansiRed+"✗ REJECTED"+ansiReset) {
+		t.Fatalf("expected red REJECTED in the status column, got %q", output)
+	}
+	if strings.Contains(output, "✓") {
+		t.Fatalf("expected non-state columns uncolored, got %q", output)
+	}
+
+	fields := captureStdout(t, func() error {
+		RenderTable([]string{"Field", "Value"}, [][]string{{"Name", "Ready"}, {"State", "READY_FOR_SALE"}})
+		return nil
+	})
+	if !strings.Contains(fields, ansiGreen+"✓ READY_FOR_SALE"+ansiReset) || strings.Contains(fields, "✓ Ready") {
+		t.Fatalf("expected only the State field colored, got %q", fields)
+	}
+}
+
+func TestRenderTable_ColoredRowsFitMaxWidth(t *testing.T) {
+	SetTableColor(true)
+	SetTableMaxWidth(80)
+	t.Cleanup(func() {
+		SetTableColor(false)
+		SetTableMaxWidth(0)
+	})
+
+	output := captureStdout(t, func() error {
+		RenderTable([]string{"State", "Message"}, [][]string{{"WAITING_FOR_EXPORT_COMPLIANCE", strings.Repeat("word ", 30)}})
+		return nil
+	})
+	ansi := regexp.MustCompile("\x1b\\[[0-9;]*m")
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		if width := len([]rune(ansi.ReplaceAllString(line, ""))); width > 80 {
+			t.Fatalf("expected colored table lines within 80 columns, got %d: %q", width, line)
+		}
+	}
+}
+
+func TestFitTableRows_WrapsLongColumnWithinWidth(t *testing.T) {
+	message := "description is required for this localization because the App Store needs it before submission can proceed"
+	rows := fitTableRows(
+		[]string{"Severity", "ID", "Message"},
+		[][]string{{"error", "metadata.required.description", message}},
+		80,
+	)
+
+	if rows[0][0] != "error" || rows[0][1] != "metadata.required.description" {
+		t.Fatalf("expected short columns untouched, got %q", rows[0])
+	}
+	lines := strings.Split(rows[0][2], "\n")
+	if len(lines) < 2 {
+		t.Fatalf("expected message to wrap, got %q", rows[0][2])
+	}
+	// 80 - borders/padding (10) - severity (8) - id (29) leaves 33 columns.
+	for _, line := range lines {
+		if len(line) > 33 {
+			t.Fatalf("expected wrapped lines within 33 columns, got %q", line)
+		}
+	}
+	if strings.Join(strings.Fields(rows[0][2]), " ") != message {
+		t.Fatalf("expected wrapping to preserve words, got %q", rows[0][2])
+	}
+}
+
+func TestFitTableRows_LeavesShortColumnsAlone(t *testing.T) {
+	rows := [][]string{{"build-1", "2026-03-01T00:00:00Z", "PREPARE_FOR_SUBMISSION", "WAITING_FOR_EXPORT_COMPLIANCE"}}
+	fitted := fitTableRows([]string{"ID", "Uploaded", "State", "Compliance"}, rows, 40)
+
+	for i := range rows[0] {
+		if fitted[0][i] != rows[0][i] {
+			t.Fatalf("expected column %d untouched, got %q", i, fitted[0][i])
+		}
+	}
+}
+
+func TestRenderTable_FitsMaxWidth(t *testing.T) {
+	SetTableMaxWidth(60)
+	t.Cleanup(func() { SetTableMaxWidth(0) })
+
+	output := captureStdout(t, func() error {
+		RenderTable([]string{"ID", "Message"}, [][]string{{"1", strings.Repeat("word ", 30)}})
+		return nil
+	})
+
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		if width := len([]rune(line)); width > 60 {
+			t.Fatalf("expected table lines within 60 columns, got %d: %q", width, line)
+		}
+	}
+}
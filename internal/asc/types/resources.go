@@ -272,6 +272,8 @@ const (
 	PlatformMacOS    Platform = "MAC_OS"
 	PlatformTVOS     Platform = "TV_OS"
 	PlatformVisionOS Platform = "VISION_OS"
+	// PlatformUniversal is only valid for bundle IDs shared across platforms.
+	PlatformUniversal Platform = "UNIVERSAL"
 )
 
 // ChecksumAlgorithm represents the algorithm used for checksums.
@@ -0,0 +1,154 @@
+package asc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// VerboseRequests logs each request line and the response status/timing.
+	VerboseRequests = 1
+	// VerboseBodies additionally logs request headers and response bodies.
+	VerboseBodies = 2
+
+	// verboseBodyLimit caps how much of a response body is echoed to stderr.
+	verboseBodyLimit = 64 * 1024
+)
+
+var verboseOverride struct {
+	mu    sync.RWMutex
+	level int
+}
+
+// SetVerboseLevel sets the HTTP verbosity used by clients created afterwards.
+// 0 disables verbose logging.
+func SetVerboseLevel(level int) {
+	verboseOverride.mu.Lock()
+	defer verboseOverride.mu.Unlock()
+	verboseOverride.level = level
+}
+
+// ResolveVerboseLevel returns the current HTTP verbosity level.
+func ResolveVerboseLevel() int {
+	verboseOverride.mu.RLock()
+	defer verboseOverride.mu.RUnlock()
+	return verboseOverride.level
+}
+
+// verboseTransport logs every round trip to stderr so all commands share the
+// same request tracing regardless of which client method they call.
+type verboseTransport struct {
+	base  http.RoundTripper
+	level int
+	out   io.Writer
+}
+
+// withVerboseTransport returns httpClient wrapped for the current verbosity
+// level. The caller's client is never modified.
+func withVerboseTransport(httpClient *http.Client) *http.Client {
+	level := ResolveVerboseLevel()
+	if level <= 0 || httpClient == nil {
+		return httpClient
+	}
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped := *httpClient
+	wrapped.Transport = &verboseTransport{base: base, level: level, out: os.Stderr}
+	return &wrapped
+}
+
+func (t *verboseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target := sanitizeURLForLog(req.URL.String())
+	fmt.Fprintf(t.out, "→ %s %s\n", req.Method, target)
+	if t.level >= VerboseBodies {
+		writeVerboseHeaders(t.out, req.Header)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Fprintf(t.out, "← %s %s failed after %s: %v\n", req.Method, target, elapsed, err)
+		return nil, err
+	}
+
+	fmt.Fprintf(t.out, "← %s %s %s (%s)\n", statusText(resp), req.Method, target, elapsed)
+	if t.level >= VerboseBodies && resp.Body != nil {
+		head, readErr := io.ReadAll(io.LimitReader(resp.Body, verboseBodyLimit+1))
+		truncated := len(head) > verboseBodyLimit
+		printed := head
+		if truncated {
+			printed = head[:verboseBodyLimit]
+		}
+		if len(printed) > 0 {
+			fmt.Fprintf(t.out, "%s\n", bytes.TrimRight(printed, "\n"))
+		}
+		if truncated {
+			fmt.Fprintf(t.out, "… (body truncated at %d bytes)\n", verboseBodyLimit)
+		}
+		resp.Body = &replayedBody{
+			Reader: io.MultiReader(bytes.NewReader(head), errorReader(readErr, resp.Body)),
+			closer: resp.Body,
+		}
+	}
+	return resp, nil
+}
+
+func statusText(resp *http.Response) string {
+	if resp.Status != "" {
+		return resp.Status
+	}
+	return fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+}
+
+func writeVerboseHeaders(out io.Writer, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := strings.Join(header.Values(name), ", ")
+		if strings.EqualFold(name, "Authorization") {
+			value = sanitizeAuthHeader(value)
+		}
+		fmt.Fprintf(out, "  %s: %s\n", name, value)
+	}
+}
+
+// replayedBody re-serves the bytes already read for logging, then the rest of
+// the original body.
+type replayedBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (b *replayedBody) Close() error {
+	return b.closer.Close()
+}
+
+// errorReader surfaces a read error hit while logging, or continues reading
+// the original body when there was none.
+func errorReader(err error, rest io.Reader) io.Reader {
+	if err != nil {
+		return &failingReader{err: err}
+	}
+	return rest
+}
+
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
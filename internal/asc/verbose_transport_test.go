@@ -0,0 +1,128 @@
+package asc
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type verboseRoundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn verboseRoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func newVerboseTestRequest(t *testing.T) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, "https://api.appstoreconnect.apple.com/v1/apps?limit=1", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-token")
+	return req
+}
+
+func verboseTestBase(body string) http.RoundTripper {
+	return verboseRoundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func TestVerboseTransport_LogsRequestLineAndStatus(t *testing.T) {
+	var out bytes.Buffer
+	transport := &verboseTransport{base: verboseTestBase(`{"data":[]}`), level: VerboseRequests, out: &out}
+
+	resp, err := transport.RoundTrip(newVerboseTestRequest(t))
+	if err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	logged := out.String()
+	if !strings.Contains(logged, "→ GET https://api.appstoreconnect.apple.com/v1/apps?limit=1") {
+		t.Fatalf("expected request line, got %q", logged)
+	}
+	if !strings.Contains(logged, "← 200 OK GET") {
+		t.Fatalf("expected status line, got %q", logged)
+	}
+	if strings.Contains(logged, "Authorization") || strings.Contains(logged, `"data"`) {
+		t.Fatalf("expected level 1 to omit headers and bodies, got %q", logged)
+	}
+}
+
+func TestVerboseTransport_LevelTwoDumpsBodyAndRedactsAuthorization(t *testing.T) {
+	var out bytes.Buffer
+	transport := &verboseTransport{base: verboseTestBase(`{"data":[{"id":"app-1"}]}`), level: VerboseBodies, out: &out}
+
+	resp, err := transport.RoundTrip(newVerboseTestRequest(t))
+	if err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if string(body) != `{"data":[{"id":"app-1"}]}` {
+		t.Fatalf("expected body to remain readable, got %q", body)
+	}
+	logged := out.String()
+	if !strings.Contains(logged, "Authorization: Bearer [REDACTED]") {
+		t.Fatalf("expected redacted Authorization header, got %q", logged)
+	}
+	if strings.Contains(logged, "secret-token") {
+		t.Fatalf("expected token to be redacted, got %q", logged)
+	}
+	if !strings.Contains(logged, `{"data":[{"id":"app-1"}]}`) {
+		t.Fatalf("expected response body in log, got %q", logged)
+	}
+}
+
+func TestVerboseTransport_TruncatesLargeBodies(t *testing.T) {
+	large := strings.Repeat("x", verboseBodyLimit+10)
+	var out bytes.Buffer
+	transport := &verboseTransport{base: verboseTestBase(large), level: VerboseBodies, out: &out}
+
+	resp, err := transport.RoundTrip(newVerboseTestRequest(t))
+	if err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if len(body) != len(large) {
+		t.Fatalf("expected full body of %d bytes, got %d", len(large), len(body))
+	}
+	if !strings.Contains(out.String(), "body truncated") {
+		t.Fatalf("expected truncation note, got %d bytes of log", out.Len())
+	}
+}
+
+func TestWithVerboseTransport_DoesNotModifyCallerClient(t *testing.T) {
+	SetVerboseLevel(VerboseRequests)
+	t.Cleanup(func() { SetVerboseLevel(0) })
+
+	original := &http.Client{}
+	wrapped := withVerboseTransport(original)
+	if original.Transport != nil {
+		t.Fatal("expected caller client transport to be untouched")
+	}
+	if _, ok := wrapped.Transport.(*verboseTransport); !ok {
+		t.Fatalf("expected verbose transport, got %T", wrapped.Transport)
+	}
+
+	SetVerboseLevel(0)
+	if withVerboseTransport(original) != original {
+		t.Fatal("expected client to be returned unchanged when verbose is off")
+	}
+}
@@ -58,6 +58,13 @@ func DoctorWithMigrationResolver(options DoctorOptions, resolver MigrationSugges
 	return doctor(options, resolver)
 }
 
+// AddSection appends a section of additional checks and refreshes the summary
+// and recommendations to include them.
+func (r *DoctorReport) AddSection(section DoctorSection) {
+	r.Sections = append(r.Sections, section)
+	r.Summary, r.Recommendations = summarizeDoctorReport(r.Sections)
+}
+
 func doctor(options DoctorOptions, resolver MigrationSuggestionResolver) DoctorReport {
 	migrationSection, migrationHints := inspectMigrationHints(resolver)
 	sections := []DoctorSection{
@@ -368,6 +375,7 @@ func inspectEnvironment() DoctorSection {
 		"ASC_PRIVATE_KEY_PATH",
 		"ASC_PRIVATE_KEY",
 		"ASC_PRIVATE_KEY_B64",
+		"ASC_PRIVATE_KEY_BASE64",
 		"ASC_PROFILE",
 		"ASC_BYPASS_KEYCHAIN",
 		"ASC_STRICT_AUTH",
@@ -389,7 +397,8 @@ func inspectEnvironment() DoctorSection {
 	issuerID := strings.TrimSpace(os.Getenv("ASC_ISSUER_ID"))
 	hasKeyPath := strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_PATH")) != "" ||
 		strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY")) != "" ||
-		strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_B64")) != ""
+		strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_B64")) != "" ||
+		strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_BASE64")) != ""
 	envProvided := keyID != "" || issuerID != "" || hasKeyPath
 	envComplete := keyID != "" && issuerID != "" && hasKeyPath
 	if envProvided && !envComplete {
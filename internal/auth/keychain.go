@@ -240,6 +240,47 @@ func StoreCredentials(name, keyID, issuerID, keyPath string) error {
 	return storeInConfig(name, payload)
 }
 
+// ErrKeychainUnavailable is returned by StoreCredentialsKeychain when no
+// system keychain can be used, including when ASC_BYPASS_KEYCHAIN is set.
+var ErrKeychainUnavailable = errors.New("system keychain unavailable")
+
+// StoreCredentialsKeychain stores credentials, including the private key
+// contents, in the system keychain only. Unlike StoreCredentials it never falls
+// back to the config file. It returns the keychain item key.
+func StoreCredentialsKeychain(name, keyID, issuerID, keyPath string) (string, error) {
+	if shouldBypassKeychain() {
+		return "", fmt.Errorf("%w: %s is set", ErrKeychainUnavailable, bypassKeychainEnv)
+	}
+	privateKeyPEM, err := loadPrivateKeyPEMForStorage(keyPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read private key: %w", err)
+	}
+	if strings.TrimSpace(privateKeyPEM) == "" {
+		return "", fmt.Errorf("private key file is empty")
+	}
+
+	payload := credentialPayload{
+		KeyID:          keyID,
+		IssuerID:       issuerID,
+		PrivateKeyPath: keyPath,
+		PrivateKeyPEM:  privateKeyPEM,
+	}
+	if err := storeInKeychain(name, payload); err != nil {
+		if isKeyringUnavailable(err) {
+			return "", ErrKeychainUnavailable
+		}
+		return "", err
+	}
+	if err := removeFromConfigIfPresent(name); err != nil && !errors.Is(err, config.ErrNotFound) {
+		// Keychain is the authoritative storage; a stale config entry is harmless.
+		_ = err
+	}
+	if err := saveDefaultName(name); err != nil {
+		return "", err
+	}
+	return keyringKey(name), nil
+}
+
 func loadPrivateKeyPEMForStorage(path string) (string, error) {
 	path = strings.TrimSpace(path)
 	if path == "" {
@@ -774,6 +774,65 @@ func TestStoreCredentials_PersistsPrivateKeyPEMInKeychain(t *testing.T) {
 	}
 }
 
+func TestStoreCredentialsKeychain_StoresPEMUnderItemKey(t *testing.T) {
+	newKr, _ := withSeparateKeyrings(t)
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath, 0o600, true)
+
+	item, err := StoreCredentialsKeychain("ci", "KEY123", "ISS456", keyPath)
+	if err != nil {
+		t.Fatalf("StoreCredentialsKeychain() error: %v", err)
+	}
+	if item != "asc:credential:ci" {
+		t.Fatalf("expected item key asc:credential:ci, got %q", item)
+	}
+
+	stored, err := newKr.Get(item)
+	if err != nil {
+		t.Fatalf("Get(keyring item) error: %v", err)
+	}
+	var payload credentialPayload
+	if err := json.Unmarshal(stored.Data, &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+	if payload.KeyID != "KEY123" || strings.TrimSpace(payload.PrivateKeyPEM) == "" {
+		t.Fatalf("unexpected keychain payload %+v", payload)
+	}
+}
+
+func TestStoreCredentialsKeychain_DoesNotFallBackToConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath, 0o600, true)
+
+	t.Run("bypass env", func(t *testing.T) {
+		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+		if _, err := StoreCredentialsKeychain("ci", "KEY123", "ISS456", keyPath); !errors.Is(err, ErrKeychainUnavailable) {
+			t.Fatalf("expected ErrKeychainUnavailable, got %v", err)
+		}
+	})
+
+	t.Run("no keychain backend", func(t *testing.T) {
+		t.Setenv("ASC_BYPASS_KEYCHAIN", "0")
+		previous := keyringOpener
+		keyringOpener = func() (keyring.Keyring, error) {
+			return nil, keyring.ErrNoAvailImpl
+		}
+		t.Cleanup(func() {
+			keyringOpener = previous
+		})
+		if _, err := StoreCredentialsKeychain("ci", "KEY123", "ISS456", keyPath); !errors.Is(err, ErrKeychainUnavailable) {
+			t.Fatalf("expected ErrKeychainUnavailable, got %v", err)
+		}
+	})
+
+	if _, err := os.Stat(configPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected no config file to be written, got %v", err)
+	}
+}
+
 func TestGetCredentialsWithSource_KeychainEntrySurvivesOriginalKeyDeletion(t *testing.T) {
 	withArrayKeyring(t)
 
@@ -125,7 +125,7 @@ Examples:
 
 				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAccessibilityDeclarations(ctx, resolvedAppID, asc.WithAccessibilityDeclarationsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("accessibility list: %w", err)
 				}
@@ -104,7 +104,7 @@ Examples:
 
 				actors, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetActors(ctx, asc.WithActorsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("actors list: %w", err)
 				}
@@ -127,7 +127,7 @@ Examples:
 
 				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetEndUserLicenseAgreementTerritories(ctx, idValue, asc.WithEndUserLicenseAgreementTerritoriesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("agreements territories list: %w", err)
 				}
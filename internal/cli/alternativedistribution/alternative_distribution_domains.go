@@ -93,7 +93,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionDomains(ctx, asc.WithAlternativeDistributionDomainsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("alternative-distribution domains list: %w", err)
 				}
@@ -95,7 +95,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionKeys(ctx, asc.WithAlternativeDistributionKeysNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("alternative-distribution keys list: %w", err)
 				}
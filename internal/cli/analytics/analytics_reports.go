@@ -142,6 +142,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAnalyticsReportInstancesRelationships(ctx, id, asc.WithLinkagesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("analytics reports relationships: %w", err)
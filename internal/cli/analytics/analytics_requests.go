@@ -170,6 +170,7 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return client.GetAnalyticsReportRequests(ctx, resolvedAppID, asc.WithAnalyticsReportRequestsNextURL(nextURL))
 						},
+						shared.PaginateOptions()...,
 					)
 					if err != nil {
 						return fmt.Errorf("analytics requests: %w", err)
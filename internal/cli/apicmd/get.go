@@ -0,0 +1,160 @@
+package apicmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// GetCommand returns the generic GET passthrough command.
+func GetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	var query queryFlag
+	fs.Var(&query, "query", "Query parameter as key=value (repeatable)")
+	paginate := fs.Bool("paginate", false, "Follow links.next and aggregate data across pages")
+	output := shared.BindOutputFlagsWith(fs, "output", "json", "Output format: json (default), yaml")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc get <path-or-url> [flags]",
+		ShortHelp:  "Send an authenticated GET to any App Store Connect endpoint.",
+		LongHelp: `Send an authenticated GET to any App Store Connect endpoint.
+
+Accepts an API path (/v1/apps) or a full https://api.appstoreconnect.apple.com
+URL and prints the response JSON unchanged. Use it for endpoints the CLI does
+not wrap yet.
+
+Examples:
+  asc get /v1/apps
+  asc get /v1/apps --query "filter[bundleId]=com.example.app" --query limit=5
+  asc get "https://api.appstoreconnect.apple.com/v1/apps/APP_ID/builds" --paginate
+  asc get /v1/apps/APP_ID --select data.attributes.name`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			rest, err := parseTrailingFlags(fs, args)
+			if err != nil {
+				return err
+			}
+			if len(rest) != 1 {
+				return shared.UsageError("get requires exactly one path or URL")
+			}
+
+			format, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "json", "yaml")
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			target, err := resolveRequestURL(rest[0], query)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if !*paginate {
+				resp, err := client.GetRaw(requestCtx, target)
+				if err != nil {
+					return fmt.Errorf("get: %w", err)
+				}
+				return shared.PrintOutput(resp, format, *output.Pretty)
+			}
+
+			firstPage, err := client.GetRawList(requestCtx, target)
+			if err != nil {
+				return fmt.Errorf("get: %w", err)
+			}
+			resp, err := shared.PaginateWithSpinner(requestCtx,
+				func(ctx context.Context) (asc.PaginatedResponse, error) {
+					return firstPage, nil
+				},
+				func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetRawList(ctx, nextURL)
+				},
+				shared.PaginateOptions()...,
+			)
+			if err != nil {
+				return fmt.Errorf("get: %w", err)
+			}
+			return shared.PrintOutput(resp, format, *output.Pretty)
+		},
+	}
+}
+
+// queryFlag collects repeated --query key=value pairs.
+type queryFlag []string
+
+func (q *queryFlag) String() string {
+	return strings.Join(*q, "&")
+}
+
+func (q *queryFlag) Set(value string) error {
+	key, _, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	*q = append(*q, value)
+	return nil
+}
+
+// parseTrailingFlags parses flags that follow the positional argument, since
+// the standard flag package stops at the first non-flag token.
+func parseTrailingFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	if len(args) <= 1 {
+		return args, nil
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		return nil, shared.UsageError(err.Error())
+	}
+	return append([]string{args[0]}, fs.Args()...), nil
+}
+
+// resolveRequestURL turns a path or URL plus --query pairs into an absolute
+// App Store Connect URL, rejecting any other host.
+func resolveRequestURL(raw string, query queryFlag) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", shared.UsageError("path or URL is required")
+	}
+	if !strings.HasPrefix(raw, "https://") && !strings.HasPrefix(raw, "http://") {
+		if !strings.HasPrefix(raw, "/") {
+			raw = "/" + raw
+		}
+		baseURL, err := asc.ResolveBaseURL()
+		if err != nil {
+			return "", shared.UsageError(err.Error())
+		}
+		raw = baseURL + raw
+	}
+	if err := shared.ValidateASCURL("URL", raw); err != nil {
+		return "", shared.UsageError(err.Error())
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", shared.UsageErrorf("URL must be a valid URL: %v", err)
+	}
+	if len(query) > 0 {
+		values := parsed.Query()
+		for _, pair := range query {
+			key, value, _ := strings.Cut(pair, "=")
+			values.Add(strings.TrimSpace(key), value)
+		}
+		parsed.RawQuery = values.Encode()
+	}
+	return parsed.String(), nil
+}
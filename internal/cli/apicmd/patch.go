@@ -0,0 +1,111 @@
+package apicmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// PatchCommand returns the generic PATCH passthrough command.
+func PatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("patch", flag.ExitOnError)
+
+	data := fs.String("data", "", "JSON:API request body, inline or @path/to/file.json (required)")
+	confirm := fs.Bool("confirm", false, "Confirm the write (required)")
+	output := shared.BindOutputFlagsWith(fs, "output", "json", "Output format: json (default), yaml")
+
+	return &ffcli.Command{
+		Name:       "patch",
+		ShortUsage: "asc patch <path-or-url> --data <json|@file> --confirm [flags]",
+		ShortHelp:  "Send an authenticated PATCH to any App Store Connect endpoint.",
+		LongHelp: `Send an authenticated PATCH to any App Store Connect endpoint.
+
+The body is checked to be valid JSON before anything is sent, and the
+response JSON is printed unchanged. Because this bypasses the CLI's own
+validation, --confirm is required.
+
+Examples:
+  asc patch /v1/apps/APP_ID --data @app.json --confirm
+  asc patch /v1/appStoreVersions/VERSION_ID --data '{"data":{"type":"appStoreVersions","id":"VERSION_ID","attributes":{"copyright":"2026 Example"}}}' --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			rest, err := parseTrailingFlags(fs, args)
+			if err != nil {
+				return err
+			}
+			if len(rest) != 1 {
+				return shared.UsageError("patch requires exactly one path or URL")
+			}
+			if strings.TrimSpace(*data) == "" {
+				return shared.UsageError("--data is required")
+			}
+			if !*confirm {
+				return shared.UsageError("--confirm is required")
+			}
+
+			format, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "json", "yaml")
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			target, err := resolveRequestURL(rest[0], nil)
+			if err != nil {
+				return err
+			}
+
+			body, err := readRequestBody(*data)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("patch: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.PatchRaw(requestCtx, target, body)
+			if err != nil {
+				return fmt.Errorf("patch: %w", err)
+			}
+			if len(resp) == 0 {
+				resp = json.RawMessage(`{}`)
+			}
+
+			return shared.PrintOutput(resp, format, *output.Pretty)
+		},
+	}
+}
+
+// readRequestBody resolves --data as inline JSON or an @file reference and
+// verifies it parses before any request is made.
+func readRequestBody(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	body := []byte(value)
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		contents, err := os.ReadFile(strings.TrimSpace(path))
+		if err != nil {
+			return nil, fmt.Errorf("patch: read --data file: %w", err)
+		}
+		body = contents
+	}
+
+	var parsed any
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, shared.UsageErrorf("--data must be valid JSON: %v", err)
+	}
+	if _, ok := parsed.(map[string]any); !ok {
+		return nil, shared.UsageError("--data must be a JSON object")
+	}
+	return body, nil
+}
@@ -93,7 +93,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEventScreenshots(ctx, id, asc.WithAppEventScreenshotsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("app-events localizations screenshots list: %w", err)
 				}
@@ -191,7 +191,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEventVideoClips(ctx, id, asc.WithAppEventVideoClipsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("app-events localizations video-clips list: %w", err)
 				}
@@ -266,7 +266,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEventScreenshotsRelationships(ctx, id, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("app-events localizations screenshots-relationships: %w", err)
 				}
@@ -341,7 +341,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEventVideoClipsRelationships(ctx, id, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("app-events localizations video-clips-relationships: %w", err)
 				}
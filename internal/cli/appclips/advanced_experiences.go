@@ -114,7 +114,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppClipAdvancedExperiences(ctx, appClipValue, asc.WithAppClipAdvancedExperiencesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("app-clips advanced-experiences list: %w", err)
 				}
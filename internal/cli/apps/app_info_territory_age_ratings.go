@@ -118,7 +118,7 @@ Examples:
 				}
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppInfoTerritoryAgeRatings(ctx, idValue, asc.WithTerritoryAgeRatingsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("app-info territory-age-ratings list: %w", err)
 				}
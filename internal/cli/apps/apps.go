@@ -491,6 +491,7 @@ func AppsUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("apps update", flag.ExitOnError)
 
 	id := fs.String("id", "", "App Store Connect app ID")
+	appID := fs.String("app", "", "App Store Connect app ID (alias for --id; or ASC_APP_ID env)")
 	bundleID := fs.String("bundle-id", "", "Update bundle ID")
 	primaryLocale := fs.String("primary-locale", "", "Update primary locale (e.g., en-US)")
 	contentRights := fs.String("content-rights", "", "Content rights declaration: DOES_NOT_USE_THIRD_PARTY_CONTENT or USES_THIRD_PARTY_CONTENT")
@@ -498,20 +499,30 @@ func AppsUpdateCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "update",
-		ShortUsage: "asc apps update --id APP_ID [--bundle-id BUNDLE_ID] [--primary-locale LOCALE] [--content-rights DECLARATION]",
+		ShortUsage: "asc apps update [--id APP_ID | --app APP_ID] [--bundle-id BUNDLE_ID] [--primary-locale LOCALE] [--content-rights DECLARATION]",
 		ShortHelp:  "Update an app's bundle ID, primary locale, or content rights declaration.",
 		LongHelp: `Update an app's bundle ID, primary locale, or content rights declaration.
 
+--primary-locale must be a supported App Store locale; it is matched
+case-insensitively and sent with Apple's spelling.
+
 Examples:
   asc apps update --id "APP_ID" --bundle-id "com.example.app"
-  asc apps update --id "APP_ID" --primary-locale "en-US"
+  asc apps update --app "APP_ID" --primary-locale "en-US"
   asc apps update --id "APP_ID" --content-rights "DOES_NOT_USE_THIRD_PARTY_CONTENT"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
+			appValue := strings.TrimSpace(*appID)
+			if idValue != "" && appValue != "" && idValue != appValue {
+				return shared.UsageError("--id and --app are mutually exclusive")
+			}
 			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				idValue = shared.ResolveAppID(appValue)
+			}
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required (or --app / ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
@@ -520,7 +531,11 @@ Examples:
 				attrs.BundleID = &bundleValue
 			}
 			if localeValue := strings.TrimSpace(*primaryLocale); localeValue != "" {
-				attrs.PrimaryLocale = &localeValue
+				canonicalLocale, ok := shared.CanonicalAppStoreLocale(localeValue)
+				if !ok {
+					return shared.UsageErrorf("unsupported --primary-locale %q (supported: %s)", localeValue, strings.Join(shared.SupportedAppStoreLocales(), ", "))
+				}
+				attrs.PrimaryLocale = &canonicalLocale
 			}
 			if rightsValue := strings.TrimSpace(*contentRights); rightsValue != "" {
 				normalizedRights := asc.ContentRightsDeclaration(strings.ToUpper(rightsValue))
@@ -595,6 +610,7 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("apps: %w", err)
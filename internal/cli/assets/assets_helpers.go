@@ -16,6 +16,7 @@ import (
 const (
 	assetUploadDefaultTimeout = 10 * time.Minute
 	assetPollInterval         = 2 * time.Second
+	assetPollMaxInterval      = 15 * time.Second
 )
 
 func contextWithAssetUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
@@ -74,9 +75,11 @@ func collectAssetFiles(path string) ([]string, error) {
 	return []string{path}, nil
 }
 
+// waitForAssetDeliveryState polls fetch with backoff until the asset reaches
+// COMPLETE, and returns an error carrying Apple's details if it ends in FAILED.
 func waitForAssetDeliveryState(ctx context.Context, assetID string, fetch func(context.Context) (*asc.AssetDeliveryState, error)) (string, error) {
 	var lastState string
-	_, err := asc.PollUntil(ctx, assetPollInterval, func(ctx context.Context) (struct{}, bool, error) {
+	_, err := asc.PollUntilWithBackoff(ctx, assetPollInterval, assetPollMaxInterval, func(ctx context.Context) (struct{}, bool, error) {
 		state, err := fetch(ctx)
 		if err != nil {
 			return struct{}{}, false, err
@@ -0,0 +1,36 @@
+package assets
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestWaitForAssetDeliveryState_Complete(t *testing.T) {
+	state, err := waitForAssetDeliveryState(context.Background(), "asset-1", func(context.Context) (*asc.AssetDeliveryState, error) {
+		return &asc.AssetDeliveryState{State: "COMPLETE"}, nil
+	})
+	if err != nil {
+		t.Fatalf("waitForAssetDeliveryState() error: %v", err)
+	}
+	if state != "COMPLETE" {
+		t.Fatalf("expected COMPLETE, got %q", state)
+	}
+}
+
+func TestWaitForAssetDeliveryState_FailedReturnsAssetErrors(t *testing.T) {
+	_, err := waitForAssetDeliveryState(context.Background(), "asset-1", func(context.Context) (*asc.AssetDeliveryState, error) {
+		return &asc.AssetDeliveryState{
+			State:  "FAILED",
+			Errors: []asc.ErrorDetail{{Code: "IMAGE_TOOL_FAILURE", Message: "checksum mismatch"}},
+		}, nil
+	})
+	if err == nil {
+		t.Fatal("expected error for FAILED delivery state")
+	}
+	if !strings.Contains(err.Error(), "asset asset-1 delivery failed: IMAGE_TOOL_FAILURE: checksum mismatch") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
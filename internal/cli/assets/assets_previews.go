@@ -225,7 +225,7 @@ func AssetsPreviewsDownloadCommand() *ffcli.Command {
 	outputPath := fs.String("output", "", "Output file path (required with --id)")
 	outputDir := fs.String("output-dir", "", "Output directory (required with --version-localization)")
 	overwrite := fs.Bool("overwrite", false, "Overwrite existing files")
-	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown")
+	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown, yaml, csv")
 
 	return &ffcli.Command{
 		Name:       "download",
@@ -188,34 +188,47 @@ func AssetsScreenshotsUploadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("upload", flag.ExitOnError)
 
 	localizationID := fs.String("version-localization", "", "App Store version localization ID")
+	localizationIDAlias := fs.String("localization-id", "", "Alias for --version-localization")
 	path := fs.String("path", "", "Path to screenshot file or directory")
 	deviceType := fs.String("device-type", "", "Device type (e.g., IPHONE_65 or IPAD_PRO_3GEN_129)")
+	displayTypeAlias := fs.String("display-type", "", "Alias for --device-type")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "upload",
-		ShortUsage: "asc screenshots upload --version-localization \"LOC_ID\" --path \"./screenshots\" --device-type \"IPHONE_65\"",
+		ShortUsage: "asc screenshots upload --version-localization \"LOC_ID\" --device-type \"IPHONE_65\" (--path \"./screenshots\" | FILE...)",
 		ShortHelp:  "Upload screenshots for a localization.",
 		LongHelp: `Upload screenshots for a localization.
 
+Screenshots are passed with --path (a file or directory) and/or as trailing
+file arguments. Each file is reserved, uploaded, and committed with its MD5
+checksum; the created screenshot IDs are printed in the result.
+
 Examples:
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65"
   asc screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPAD_PRO_3GEN_129"
-  asc screenshots upload --version-localization "LOC_ID" --path "./screenshots/en-US.png" --device-type "IPHONE_65"`,
+  asc screenshots upload --version-localization "LOC_ID" --path "./screenshots/en-US.png" --device-type "IPHONE_65"
+  asc screenshots upload --localization-id "LOC_ID" --display-type APP_IPHONE_67 home.png settings.png`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			locID := strings.TrimSpace(*localizationID)
+			locID, err := resolveAliasedFlag("--version-localization", *localizationID, "--localization-id", *localizationIDAlias)
+			if err != nil {
+				return err
+			}
 			if locID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --version-localization is required")
 				return flag.ErrHelp
 			}
 			pathValue := strings.TrimSpace(*path)
-			if pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --path is required")
+			if pathValue == "" && len(args) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --path is required (or pass screenshot files as arguments)")
 				return flag.ErrHelp
 			}
-			deviceValue := strings.TrimSpace(*deviceType)
+			deviceValue, err := resolveAliasedFlag("--device-type", *deviceType, "--display-type", *displayTypeAlias)
+			if err != nil {
+				return err
+			}
 			if deviceValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --device-type is required")
 				return flag.ErrHelp
@@ -227,9 +240,19 @@ Examples:
 			}
 			apiDisplayType := asc.CanonicalScreenshotDisplayTypeForAPI(displayType)
 
-			files, err := collectAssetFiles(pathValue)
-			if err != nil {
-				return fmt.Errorf("screenshots upload: %w", err)
+			inputs := make([]string, 0, len(args)+1)
+			if pathValue != "" {
+				inputs = append(inputs, pathValue)
+			}
+			inputs = append(inputs, args...)
+
+			files := make([]string, 0, len(inputs))
+			for _, input := range inputs {
+				collected, err := collectAssetFiles(strings.TrimSpace(input))
+				if err != nil {
+					return fmt.Errorf("screenshots upload: %w", err)
+				}
+				files = append(files, collected...)
 			}
 
 			if err := validateScreenshotDimensions(files, apiDisplayType); err != nil {
@@ -311,7 +334,7 @@ func AssetsScreenshotsDownloadCommand() *ffcli.Command {
 	outputPath := fs.String("output", "", "Output file path (required with --id)")
 	outputDir := fs.String("output-dir", "", "Output directory (required with --version-localization)")
 	overwrite := fs.Bool("overwrite", false, "Overwrite existing files")
-	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown")
+	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown, yaml, csv")
 
 	return &ffcli.Command{
 		Name:       "download",
@@ -655,6 +678,20 @@ Examples:
 	}
 }
 
+// resolveAliasedFlag returns the trimmed value of a flag or its alias,
+// rejecting conflicting values when both are set.
+func resolveAliasedFlag(name, value, aliasName, aliasValue string) (string, error) {
+	value = strings.TrimSpace(value)
+	aliasValue = strings.TrimSpace(aliasValue)
+	if value != "" && aliasValue != "" && value != aliasValue {
+		return "", shared.UsageErrorf("%s and %s conflict; set only one", name, aliasName)
+	}
+	if value != "" {
+		return value, nil
+	}
+	return aliasValue, nil
+}
+
 func normalizeScreenshotDisplayType(input string) (string, error) {
 	value := strings.ToUpper(strings.TrimSpace(input))
 	if value == "" {
@@ -39,6 +39,7 @@ Authentication is handled via App Store Connect API keys. Generate keys at:
 https://appstoreconnect.apple.com/access/integrations/api
 
 Credentials are stored in the system keychain when available, with a config fallback.
+Use auth store-key to require keychain storage (no config fallback).
 A repo-local ./.asc/config.json (if present) takes precedence.
 
 Credential resolution order:
@@ -52,10 +53,14 @@ Set ASC_BYPASS_KEYCHAIN to 1/true/yes/on to bypass keychain.`,
 		Subcommands: []*ffcli.Command{
 			AuthInitCommand(),
 			AuthLoginCommand(),
+			AuthStoreKeyCommand(),
+			AuthImportCommand(),
 			AuthSwitchCommand(),
 			AuthLogoutCommand(),
 			AuthDoctorCommand(),
 			AuthStatusCommand(),
+			AuthTokenCommand(),
+			AuthWhoamiCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			if len(args) == 0 {
@@ -117,7 +122,7 @@ Examples:
 			}
 
 			if *open {
-				if err := openURL(authKeysURL); err != nil {
+				if err := shared.OpenURL(authKeysURL); err != nil {
 					return fmt.Errorf("auth init: %w", err)
 				}
 			}
@@ -189,7 +194,11 @@ Examples:
 }
 
 func printDoctorReport(report authsvc.DoctorReport) {
-	fmt.Println("Auth Doctor")
+	printDoctorReportTitled("Auth Doctor", report)
+}
+
+func printDoctorReportTitled(title string, report authsvc.DoctorReport) {
+	fmt.Println(title)
 	for _, section := range report.Sections {
 		if len(section.Checks) == 0 {
 			continue
@@ -738,7 +747,8 @@ Examples:
 			envIssuerID := strings.TrimSpace(os.Getenv("ASC_ISSUER_ID"))
 			hasKeyEnv := strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_PATH")) != "" ||
 				strings.TrimSpace(os.Getenv(shared.PrivateKeyEnvVar)) != "" ||
-				strings.TrimSpace(os.Getenv(shared.PrivateKeyBase64EnvVar)) != ""
+				strings.TrimSpace(os.Getenv(shared.PrivateKeyBase64EnvVar)) != "" ||
+				strings.TrimSpace(os.Getenv(shared.PrivateKeyBase64MemEnvVar)) != ""
 			envProvided := envKeyID != "" || envIssuerID != "" || hasKeyEnv
 			envComplete := envKeyID != "" && envIssuerID != "" && hasKeyEnv
 
@@ -747,7 +757,7 @@ Examples:
 			} else if bypassKeychain && envComplete {
 				fmt.Println("Environment credentials detected (ASC_KEY_ID present). With ASC_BYPASS_KEYCHAIN set to 1/true/yes/on, they will be used when no profile is selected.")
 			} else if bypassKeychain && envProvided && !envComplete {
-				fmt.Println("Environment credentials are incomplete. Set ASC_KEY_ID, ASC_ISSUER_ID, and one of ASC_PRIVATE_KEY_PATH/ASC_PRIVATE_KEY/ASC_PRIVATE_KEY_B64.")
+				fmt.Println("Environment credentials are incomplete. Set ASC_KEY_ID, ASC_ISSUER_ID, and one of ASC_PRIVATE_KEY_PATH/ASC_PRIVATE_KEY/ASC_PRIVATE_KEY_B64/ASC_PRIVATE_KEY_BASE64.")
 			}
 			if *validate && validationFailures > 0 {
 				return shared.NewReportedError(fmt.Errorf("auth status: validation failed for %d credential(s)", validationFailures))
@@ -424,6 +424,107 @@ func TestAuthLoginCommand(t *testing.T) {
 	})
 }
 
+func TestAuthStoreKeyCommand(t *testing.T) {
+	t.Run("missing name", func(t *testing.T) {
+		cmd := AuthStoreKeyCommand()
+		if err := cmd.FlagSet.Parse([]string{"--key-id", "KEY", "--issuer-id", "ISS", "--private-key", "/tmp/AuthKey.p8"}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		_, stderr := captureAuthOutput(t, func() {
+			if err := cmd.Exec(context.Background(), []string{}); !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+		})
+		if !strings.Contains(stderr, "--name is required") {
+			t.Fatalf("expected missing name error, got %q", stderr)
+		}
+	})
+
+	t.Run("bypass keychain fails without config fallback", func(t *testing.T) {
+		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+		configPath := filepath.Join(t.TempDir(), "config.json")
+		t.Setenv("ASC_CONFIG_PATH", configPath)
+
+		cmd := AuthStoreKeyCommand()
+		if err := cmd.FlagSet.Parse([]string{
+			"--name", "ci",
+			"--key-id", "KEY",
+			"--issuer-id", "ISS",
+			"--private-key", writeTempECDSAKeyFile(t),
+		}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		err := cmd.Exec(context.Background(), []string{})
+		if !errors.Is(err, authsvc.ErrKeychainUnavailable) {
+			t.Fatalf("expected ErrKeychainUnavailable, got %v", err)
+		}
+		if _, statErr := os.Stat(configPath); !errors.Is(statErr, os.ErrNotExist) {
+			t.Fatalf("expected no config file to be written, got %v", statErr)
+		}
+	})
+}
+
+func TestAuthImportCommand(t *testing.T) {
+	runImport := func(t *testing.T, validate func(context.Context, string, string, string) error) (string, string, error) {
+		t.Helper()
+		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		configPath := filepath.Join(home, ".asc", "config.json")
+		t.Setenv("ASC_CONFIG_PATH", configPath)
+		prevNetwork := loginNetworkValidate
+		loginNetworkValidate = validate
+		t.Cleanup(func() {
+			loginNetworkValidate = prevNetwork
+		})
+
+		cmd := AuthImportCommand()
+		if err := cmd.FlagSet.Parse([]string{
+			"--key-id", "KEY12345",
+			"--issuer-id", "ISS",
+			"--key-file", writeTempECDSAKeyFile(t),
+		}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		var runErr error
+		stdout, _ := captureAuthOutput(t, func() {
+			runErr = cmd.Exec(context.Background(), []string{})
+		})
+		return configPath, stdout, runErr
+	}
+
+	t.Run("stores verified credentials", func(t *testing.T) {
+		configPath, stdout, err := runImport(t, func(context.Context, string, string, string) error {
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Exec() error: %v", err)
+		}
+		cfg, err := config.LoadAt(configPath)
+		if err != nil {
+			t.Fatalf("LoadAt() error: %v", err)
+		}
+		if cfg.DefaultKeyName != "KEY12345" {
+			t.Fatalf("DefaultKeyName = %q, want KEY12345", cfg.DefaultKeyName)
+		}
+		if !strings.Contains(stdout, "Key ID: ****2345") || !strings.Contains(stdout, "Credentials: valid") {
+			t.Fatalf("expected whoami summary, got %q", stdout)
+		}
+	})
+
+	t.Run("rejected credentials are not stored", func(t *testing.T) {
+		configPath, _, err := runImport(t, func(context.Context, string, string, string) error {
+			return errors.New("401 unauthorized")
+		})
+		if err == nil || !strings.Contains(err.Error(), "network validation failed") {
+			t.Fatalf("expected network validation error, got %v", err)
+		}
+		if _, statErr := os.Stat(configPath); !errors.Is(statErr, os.ErrNotExist) {
+			t.Fatalf("expected no config file to be written, got %v", statErr)
+		}
+	})
+}
+
 func TestAuthSwitchCommand(t *testing.T) {
 	t.Run("missing name", func(t *testing.T) {
 		cmd := AuthSwitchCommand()
@@ -786,3 +887,16 @@ func captureAuthOutput(t *testing.T, fn func()) (string, string) {
 
 	return <-outC, <-errC
 }
+
+func TestMaskKeyID(t *testing.T) {
+	tests := map[string]string{
+		"ABC123DEFG": "******DEFG",
+		"ABCD":       "****",
+		"":           "",
+	}
+	for input, want := range tests {
+		if got := maskKeyID(input); got != want {
+			t.Fatalf("maskKeyID(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	// JWTs are signed with the local clock, so App Store Connect rejects them
+	// once the clock drifts too far from its own.
+	clockSkewWarnThreshold = asc.ClockSkewTolerance
+	clockSkewFailThreshold = 5 * time.Minute
+)
+
+var doctorLookPath = exec.LookPath
+
+// DoctorCommand returns the top-level doctor command. It runs the auth doctor
+// checks plus setup checks for connectivity, clock skew, and tooling.
+func DoctorCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+
+	output := shared.BindOutputFlagsWith(fs, "output", "text", "Output format: text (default), json")
+	fix := fs.Bool("fix", false, "Attempt to fix auth storage issues where possible")
+	confirm := fs.Bool("confirm", false, "Confirm applying fixes")
+
+	return &ffcli.Command{
+		Name:       "doctor",
+		ShortUsage: "asc doctor [flags]",
+		ShortHelp:  "Diagnose common setup problems.",
+		LongHelp: `Diagnose common setup problems.
+
+Runs every auth doctor check (keychain, config files, profiles, private keys,
+environment variables), then checks that:
+  - the config file can be read
+  - the App Store Connect API host is reachable
+  - the system clock agrees with Apple's servers (API tokens are time-sensitive)
+  - the credentials load and authenticate (like asc auth whoami)
+  - npx, pnpm, or bunx is available for asc install-skills
+
+Failed checks include a remediation hint. Exits non-zero when any check fails.
+
+Examples:
+  asc doctor
+  asc doctor --output json
+  asc doctor --fix --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("doctor does not accept positional arguments")
+			}
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "text", "json")
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if *fix && !*confirm {
+				return shared.UsageError("--fix requires --confirm")
+			}
+
+			report := authsvc.DoctorWithMigrationResolver(
+				authsvc.DoctorOptions{Fix: *fix && *confirm},
+				doctorMigrationSuggestionResolver(),
+			)
+			report.AddSection(inspectSetup(ctx))
+
+			if normalizedOutput == "json" {
+				if err := shared.PrintOutput(report, "json", *output.Pretty); err != nil {
+					return err
+				}
+			} else {
+				printDoctorReportTitled("Doctor", report)
+			}
+
+			if report.Summary.Errors > 0 {
+				return shared.NewReportedError(fmt.Errorf("doctor: found %d error(s)", report.Summary.Errors))
+			}
+			return nil
+		},
+	}
+}
+
+func inspectSetup(ctx context.Context) authsvc.DoctorSection {
+	checks := []authsvc.DoctorCheck{}
+	if check, ok := checkConfigReadable(); ok {
+		checks = append(checks, check)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	networkCheck, serverTime, reachable := checkAPIReachable(requestCtx)
+	checks = append(checks, networkCheck)
+	checks = append(checks, checkClockSkew(serverTime, reachable, time.Now()))
+	checks = append(checks, checkCredentials(requestCtx))
+	checks = append(checks, checkSkillsRunner())
+
+	return authsvc.DoctorSection{Title: "Setup", Checks: checks}
+}
+
+// checkConfigReadable reports whether an existing config file parses. It
+// returns false when there is no config file to check.
+func checkConfigReadable() (authsvc.DoctorCheck, bool) {
+	path, err := config.Path()
+	if err != nil {
+		return authsvc.DoctorCheck{}, false
+	}
+	if _, err := config.LoadAt(path); err != nil {
+		if errors.Is(err, config.ErrNotFound) {
+			return authsvc.DoctorCheck{}, false
+		}
+		return authsvc.DoctorCheck{
+			Status:         authsvc.DoctorFail,
+			Message:        fmt.Sprintf("Config file cannot be read: %v", err),
+			Recommendation: fmt.Sprintf("Fix or remove %s, then run `asc auth login` to recreate it", path),
+		}, true
+	}
+	return authsvc.DoctorCheck{
+		Status:  authsvc.DoctorOK,
+		Message: fmt.Sprintf("Config file is readable (%s)", path),
+	}, true
+}
+
+// checkAPIReachable sends an unauthenticated request to the API host. Any
+// HTTP response counts as reachable; its Date header feeds the clock check.
+func checkAPIReachable(ctx context.Context) (authsvc.DoctorCheck, time.Time, bool) {
+	baseURL, err := asc.ResolveBaseURL()
+	if err != nil {
+		return authsvc.DoctorCheck{
+			Status:         authsvc.DoctorFail,
+			Message:        fmt.Sprintf("Invalid API base URL: %v", err),
+			Recommendation: "Fix or unset ASC_BASE_URL",
+		}, time.Time{}, false
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/v1", nil)
+	if err != nil {
+		return authsvc.DoctorCheck{Status: authsvc.DoctorFail, Message: fmt.Sprintf("Failed to build API request: %v", err)}, time.Time{}, false
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return authsvc.DoctorCheck{
+			Status:         authsvc.DoctorFail,
+			Message:        fmt.Sprintf("Cannot reach %s: %v", baseURL, err),
+			Recommendation: "Check your network connection, proxy settings (HTTPS_PROXY), and firewall rules for api.appstoreconnect.apple.com",
+		}, time.Time{}, false
+	}
+	_ = resp.Body.Close()
+
+	serverTime, _ := http.ParseTime(resp.Header.Get("Date"))
+	return authsvc.DoctorCheck{
+		Status:  authsvc.DoctorOK,
+		Message: fmt.Sprintf("Reached %s (HTTP %d)", baseURL, resp.StatusCode),
+	}, serverTime, true
+}
+
+func checkClockSkew(serverTime time.Time, reachable bool, now time.Time) authsvc.DoctorCheck {
+	if !reachable || serverTime.IsZero() {
+		return authsvc.DoctorCheck{
+			Status:  authsvc.DoctorInfo,
+			Message: "Clock skew not checked: no server time available",
+		}
+	}
+
+	skew := now.Sub(serverTime)
+	if skew < 0 {
+		skew = -skew
+	}
+	// The Date header has one-second resolution.
+	skew = skew.Truncate(time.Second)
+	recommendation := "Sync the system clock (enable automatic date and time); API tokens are rejected when the clock is off"
+	switch {
+	case skew > clockSkewFailThreshold:
+		return authsvc.DoctorCheck{
+			Status:         authsvc.DoctorFail,
+			Message:        fmt.Sprintf("System clock is off by %s from Apple's servers", skew),
+			Recommendation: recommendation,
+		}
+	case skew > clockSkewWarnThreshold:
+		return authsvc.DoctorCheck{
+			Status:         authsvc.DoctorWarn,
+			Message:        fmt.Sprintf("System clock is off by %s from Apple's servers", skew),
+			Recommendation: recommendation,
+		}
+	default:
+		return authsvc.DoctorCheck{
+			Status:  authsvc.DoctorOK,
+			Message: "System clock matches Apple's servers",
+		}
+	}
+}
+
+// checkCredentials loads the active credentials and makes the same request as
+// asc auth whoami.
+func checkCredentials(ctx context.Context) authsvc.DoctorCheck {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return authsvc.DoctorCheck{
+			Status:         authsvc.DoctorFail,
+			Message:        fmt.Sprintf("Credentials could not be loaded: %v", err),
+			Recommendation: "Run `asc auth login` (or set ASC_KEY_ID, ASC_ISSUER_ID, and ASC_PRIVATE_KEY_PATH)",
+		}
+	}
+	if _, err := client.GetApps(ctx, asc.WithAppsLimit(1)); err != nil {
+		if isCredentialRejection(err) {
+			return authsvc.DoctorCheck{
+				Status:         authsvc.DoctorFail,
+				Message:        fmt.Sprintf("App Store Connect rejected key %s: %v", maskKeyID(client.KeyID()), err),
+				Recommendation: "Check that the API key is active and not revoked in App Store Connect > Users and Access > Integrations",
+			}
+		}
+		return authsvc.DoctorCheck{
+			Status:         authsvc.DoctorFail,
+			Message:        fmt.Sprintf("Could not verify key %s: %v", maskKeyID(client.KeyID()), err),
+			Recommendation: "Retry later; the credentials were not rejected, but App Store Connect could not be reached or returned an error",
+		}
+	}
+	return authsvc.DoctorCheck{
+		Status:  authsvc.DoctorOK,
+		Message: fmt.Sprintf("Authenticated with key %s", maskKeyID(client.KeyID())),
+	}
+}
+
+// skillsRunners are the package runners asc install-skills can use, in its
+// order of preference.
+var skillsRunners = []string{"npx", "pnpm", "bunx"}
+
+func checkSkillsRunner() authsvc.DoctorCheck {
+	for _, name := range skillsRunners {
+		if path, err := doctorLookPath(name); err == nil {
+			return authsvc.DoctorCheck{
+				Status:  authsvc.DoctorOK,
+				Message: fmt.Sprintf("%s found at %s", name, path),
+			}
+		}
+	}
+	return authsvc.DoctorCheck{
+		Status:         authsvc.DoctorWarn,
+		Message:        "npx, pnpm, and bunx not found on PATH; asc install-skills needs one of them",
+		Recommendation: "Install Node.js (which provides npx), pnpm, or Bun to use asc install-skills",
+	}
+}
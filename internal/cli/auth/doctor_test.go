@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+)
+
+func TestCheckClockSkewThresholds(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		serverTime time.Time
+		reachable  bool
+		want       authsvc.DoctorStatus
+	}{
+		{name: "in sync", serverTime: now.Add(-2 * time.Second), reachable: true, want: authsvc.DoctorOK},
+		{name: "drifting", serverTime: now.Add(2 * time.Minute), reachable: true, want: authsvc.DoctorWarn},
+		{name: "skewed", serverTime: now.Add(-6 * time.Minute), reachable: true, want: authsvc.DoctorFail},
+		{name: "unreachable", serverTime: now, reachable: false, want: authsvc.DoctorInfo},
+		{name: "no date header", reachable: true, want: authsvc.DoctorInfo},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			check := checkClockSkew(test.serverTime, test.reachable, now)
+			if check.Status != test.want {
+				t.Fatalf("status = %q, want %q (%s)", check.Status, test.want, check.Message)
+			}
+			if test.want == authsvc.DoctorFail && check.Recommendation == "" {
+				t.Fatal("expected a recommendation for a failed clock check")
+			}
+		})
+	}
+}
+
+func TestCheckSkillsRunner(t *testing.T) {
+	previous := doctorLookPath
+	t.Cleanup(func() { doctorLookPath = previous })
+
+	doctorLookPath = func(name string) (string, error) {
+		if name == "bunx" {
+			return "/usr/local/bin/bunx", nil
+		}
+		return "", errors.New("not found")
+	}
+	if check := checkSkillsRunner(); check.Status != authsvc.DoctorOK || !strings.Contains(check.Message, "bunx") {
+		t.Fatalf("expected bunx to satisfy the runner check, got %+v", check)
+	}
+
+	doctorLookPath = func(string) (string, error) { return "", errors.New("not found") }
+	check := checkSkillsRunner()
+	if check.Status != authsvc.DoctorWarn || check.Recommendation == "" {
+		t.Fatalf("expected runner warning with recommendation, got %+v", check)
+	}
+}
+
+type doctorRoundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn doctorRoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestCheckAPIReachableUsesResolvedBaseURL(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "https://asc-mock.example.com/")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = originalTransport })
+	http.DefaultTransport = doctorRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://asc-mock.example.com/v1" {
+			t.Fatalf("unexpected request to %s", req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Header:     http.Header{},
+		}, nil
+	})
+
+	check, _, reachable := checkAPIReachable(context.Background())
+	if !reachable || check.Status != authsvc.DoctorOK || !strings.Contains(check.Message, "asc-mock.example.com") {
+		t.Fatalf("expected mock host to be reachable, got %+v", check)
+	}
+
+	t.Setenv("ASC_BASE_URL", "http://asc-mock.example.com")
+	check, _, reachable = checkAPIReachable(context.Background())
+	if reachable || check.Status != authsvc.DoctorFail || !strings.Contains(check.Message, "ASC_BASE_URL") {
+		t.Fatalf("expected invalid ASC_BASE_URL to fail, got %+v", check)
+	}
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AuthImport command factory
+func AuthImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("auth import", flag.ExitOnError)
+
+	keyID := fs.String("key-id", "", "App Store Connect API Key ID")
+	issuerID := fs.String("issuer-id", "", "App Store Connect Issuer ID")
+	keyFile := fs.String("key-file", "", "Path to private key (.p8) file")
+	name := fs.String("name", "", "Profile name for this key (default: the key ID)")
+	skipVerify := fs.Bool("skip-verify", false, "Skip the API request that confirms the credentials work")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc auth import --key-id KEY_ID --issuer-id ISSUER_ID --key-file PATH [flags]",
+		ShortHelp:  "Validate, store, and verify an API key in one step.",
+		LongHelp: `Validate, store, and verify an API key in one step.
+
+Checks that the .p8 key parses and signs a token, confirms the credentials
+against the API (the same check as auth whoami), and only then stores them as
+the default profile: in the system keychain when available, otherwise in
+~/.asc/config.json (always config.json when ASC_BYPASS_KEYCHAIN is set).
+
+Examples:
+  asc auth import --key-id "ABC123" --issuer-id "DEF456" --key-file ./AuthKey_ABC123.p8
+  asc auth import --key-id "ABC123" --issuer-id "DEF456" --key-file ./AuthKey_ABC123.p8 --name "CI"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("auth import does not accept positional arguments")
+			}
+			for _, required := range []struct {
+				flag  string
+				value string
+			}{
+				{"--key-id", *keyID},
+				{"--issuer-id", *issuerID},
+				{"--key-file", *keyFile},
+			} {
+				if strings.TrimSpace(required.value) == "" {
+					fmt.Fprintf(os.Stderr, "Error: %s is required\n", required.flag)
+					return flag.ErrHelp
+				}
+			}
+			profileName := strings.TrimSpace(*name)
+			if profileName == "" {
+				profileName = strings.TrimSpace(*keyID)
+			}
+
+			if err := authsvc.ValidateKeyFile(*keyFile); err != nil {
+				return fmt.Errorf("auth import: invalid private key: %w", err)
+			}
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+			if err := validateLoginCredentials(requestCtx, *keyID, *issuerID, *keyFile, !*skipVerify); err != nil {
+				return fmt.Errorf("auth import: %w", err)
+			}
+
+			bypassKeychain := authsvc.ShouldBypassKeychain()
+			storageMessage, err := loginStorageMessage(bypassKeychain, false)
+			if err != nil {
+				return fmt.Errorf("auth import: %w", err)
+			}
+			fmt.Println(storageMessage)
+			if bypassKeychain {
+				err = authsvc.StoreCredentialsConfig(profileName, *keyID, *issuerID, *keyFile)
+			} else {
+				err = authsvc.StoreCredentials(profileName, *keyID, *issuerID, *keyFile)
+			}
+			if err != nil {
+				return fmt.Errorf("auth import: failed to store credentials: %w", err)
+			}
+
+			fmt.Printf("Imported API key '%s'\n", profileName)
+			fmt.Printf("Issuer ID: %s\n", strings.TrimSpace(*issuerID))
+			fmt.Printf("Key ID: %s\n", maskKeyID(*keyID))
+			fmt.Println("Private key: loaded")
+			if *skipVerify {
+				fmt.Println("Credentials: not verified (--skip-verify)")
+			} else {
+				fmt.Println("Credentials: valid")
+			}
+			return nil
+		},
+	}
+}
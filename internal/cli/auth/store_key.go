@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	authsvc "github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AuthStoreKey command factory
+func AuthStoreKeyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("auth store-key", flag.ExitOnError)
+
+	name := fs.String("name", "", "Profile name for this key")
+	keyID := fs.String("key-id", "", "App Store Connect API Key ID")
+	issuerID := fs.String("issuer-id", "", "App Store Connect Issuer ID")
+	keyPath := fs.String("private-key", "", "Path to private key (.p8) file")
+	skipValidation := fs.Bool("skip-validation", false, "Skip JWT validation of the private key")
+
+	return &ffcli.Command{
+		Name:       "store-key",
+		ShortUsage: "asc auth store-key --name NAME --key-id KEY_ID --issuer-id ISSUER_ID --private-key PATH",
+		ShortHelp:  "Store an API key and its .p8 contents in the system keychain.",
+		LongHelp: `Store an API key and its .p8 contents in the system keychain.
+
+Like auth login, but keychain-only: the command fails instead of falling back
+to config.json when no keychain is available or ASC_BYPASS_KEYCHAIN is set.
+The private key contents are stored with the key ID and issuer ID, so the .p8
+file can be deleted afterwards. Commands read the key from the keychain
+automatically when this profile is selected (or is the default).
+
+Keychain item naming:
+  service  asc
+  account  asc:credential:<name>
+  label    ASC API Key (<name>)
+
+Examples:
+  asc auth store-key --name "CI" --key-id "ABC123" --issuer-id "DEF456" --private-key ./AuthKey_ABC123.p8
+  security find-generic-password -s asc -a "asc:credential:CI"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("auth store-key does not accept positional arguments")
+			}
+			for _, required := range []struct {
+				flag  string
+				value string
+			}{
+				{"--name", *name},
+				{"--key-id", *keyID},
+				{"--issuer-id", *issuerID},
+				{"--private-key", *keyPath},
+			} {
+				if strings.TrimSpace(required.value) == "" {
+					fmt.Fprintf(os.Stderr, "Error: %s is required\n", required.flag)
+					return flag.ErrHelp
+				}
+			}
+
+			if err := authsvc.ValidateKeyFile(*keyPath); err != nil {
+				return fmt.Errorf("auth store-key: invalid private key: %w", err)
+			}
+			if !*skipValidation {
+				if err := validateLoginCredentials(ctx, *keyID, *issuerID, *keyPath, false); err != nil {
+					return fmt.Errorf("auth store-key: %w", err)
+				}
+			}
+
+			item, err := authsvc.StoreCredentialsKeychain(*name, *keyID, *issuerID, *keyPath)
+			if err != nil {
+				if errors.Is(err, authsvc.ErrKeychainUnavailable) {
+					return fmt.Errorf("auth store-key: %w (use auth login to store credentials in config.json)", err)
+				}
+				return fmt.Errorf("auth store-key: failed to store credentials: %w", err)
+			}
+
+			fmt.Printf("Stored API key '%s' in the system keychain (item %q)\n", *name, item)
+			return nil
+		},
+	}
+}
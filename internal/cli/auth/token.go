@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type authTokenOutput struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// AuthToken command factory
+func AuthTokenCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("auth token", flag.ExitOnError)
+
+	ttl := fs.Duration("ttl", 10*time.Minute, "Token lifetime (max 20m)")
+	output := shared.BindOutputFlagsWith(fs, "output", "text", "Output format: text (default), json")
+
+	return &ffcli.Command{
+		Name:       "token",
+		ShortUsage: "asc auth token [flags]",
+		ShortHelp:  "Print a signed App Store Connect JWT.",
+		LongHelp: `Print a signed App Store Connect JWT.
+
+The token is signed with the active credentials (same resolution as other
+commands) and can be used as a Bearer token by tools that make their own
+HTTP requests. App Store Connect rejects tokens that live longer than 20
+minutes.
+
+Examples:
+  asc auth token
+  asc auth token --ttl 5m
+  asc auth token --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("auth token does not accept positional arguments")
+			}
+			if *ttl < time.Second || *ttl > asc.MaxTokenLifetime {
+				return shared.UsageErrorf("--ttl must be between 1s and %s", asc.MaxTokenLifetime)
+			}
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "text", "json")
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("auth token: %w", err)
+			}
+
+			token, expiresAt, err := client.SignToken(*ttl)
+			if err != nil {
+				return fmt.Errorf("auth token: %w", err)
+			}
+
+			if normalizedOutput == "json" {
+				return shared.PrintOutput(authTokenOutput{
+					Token:     token,
+					ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+				}, "json", *output.Pretty)
+			}
+			fmt.Println(token)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type authWhoamiOutput struct {
+	IssuerID  string `json:"issuerId"`
+	KeyID     string `json:"keyId"`
+	KeyLoaded bool   `json:"keyLoaded"`
+	Valid     bool   `json:"valid"`
+}
+
+// AuthWhoami command factory
+func AuthWhoamiCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("auth whoami", flag.ExitOnError)
+
+	output := shared.BindOutputFlagsWith(fs, "output", "text", "Output format: text (default), json")
+
+	return &ffcli.Command{
+		Name:       "whoami",
+		ShortUsage: "asc auth whoami [flags]",
+		ShortHelp:  "Verify the active credentials against the API.",
+		LongHelp: `Verify the active credentials against the API.
+
+Resolves credentials the same way as other commands, loads the private key,
+and makes one lightweight request (list apps, limit 1). Exits non-zero when
+the key cannot be loaded or App Store Connect rejects the credentials, so CI
+can check auth before running real commands.
+
+Examples:
+  asc auth whoami
+  asc auth whoami --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("auth whoami does not accept positional arguments")
+			}
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "text", "json")
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("auth whoami: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if _, err := client.GetApps(requestCtx, asc.WithAppsLimit(1)); err != nil {
+				if isCredentialRejection(err) {
+					return fmt.Errorf("auth whoami: credentials for key %s were rejected: %w", maskKeyID(client.KeyID()), err)
+				}
+				return fmt.Errorf("auth whoami: %w", err)
+			}
+
+			result := authWhoamiOutput{
+				IssuerID:  client.IssuerID(),
+				KeyID:     maskKeyID(client.KeyID()),
+				KeyLoaded: true,
+				Valid:     true,
+			}
+			if normalizedOutput == "json" {
+				return shared.PrintOutput(result, "json", *output.Pretty)
+			}
+			fmt.Printf("Issuer ID: %s\n", result.IssuerID)
+			fmt.Printf("Key ID: %s\n", result.KeyID)
+			fmt.Println("Private key: loaded")
+			fmt.Println("Credentials: valid")
+			return nil
+		},
+	}
+}
+
+// isCredentialRejection reports whether err is a 401/403 from App Store
+// Connect, as opposed to a network, rate-limit, or server failure.
+func isCredentialRejection(err error) bool {
+	if errors.Is(err, asc.ErrUnauthorized) || errors.Is(err, asc.ErrForbidden) {
+		return true
+	}
+	if apiErr, ok := errors.AsType[*asc.APIError](err); ok {
+		return apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+// maskKeyID hides all but the last four characters of an API key ID.
+func maskKeyID(keyID string) string {
+	keyID = strings.TrimSpace(keyID)
+	if len(keyID) <= 4 {
+		return strings.Repeat("*", len(keyID))
+	}
+	return strings.Repeat("*", len(keyID)-4) + keyID[len(keyID)-4:]
+}
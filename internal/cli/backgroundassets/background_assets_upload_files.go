@@ -99,7 +99,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBackgroundAssetUploadFiles(ctx, versionIDValue, asc.WithBackgroundAssetUploadFilesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("background-assets upload-files list: %w", err)
 				}
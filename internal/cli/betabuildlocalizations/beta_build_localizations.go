@@ -126,7 +126,7 @@ Examples:
 
 					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.ListBetaBuildLocalizations(ctx, asc.WithBetaBuildLocalizationsNextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("beta-build-localizations list: %w", err)
 					}
@@ -150,7 +150,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBetaBuildLocalizations(ctx, buildValue, asc.WithBetaBuildLocalizationsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("beta-build-localizations list: %w", err)
 				}
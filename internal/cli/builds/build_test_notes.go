@@ -111,6 +111,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBetaBuildLocalizations(ctx, build, asc.WithBetaBuildLocalizationsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("builds test-notes list: %w", err)
@@ -435,10 +435,11 @@ func BuildsListCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID, bundle ID, or exact app name (or ASC_APP_ID env)")
 	output := shared.BindOutputFlags(fs)
-	sort := fs.String("sort", "", "Sort by uploadedDate or -uploadedDate")
+	sort := fs.String("sort", "-uploadedDate", "Sort by uploadedDate or -uploadedDate (newest first by default)")
 	version := fs.String("version", "", "Filter by marketing version string (CFBundleShortVersionString)")
 	buildNumber := fs.String("build-number", "", "Filter by build number (CFBundleVersion)")
 	processingState := fs.String("processing-state", "", "Filter by processing state: VALID, PROCESSING, FAILED, INVALID, or all")
+	since := fs.String("since", "", "Only builds uploaded since a date (2024-01-01, RFC3339) or duration ago (168h, 30d)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -452,6 +453,9 @@ func BuildsListCommand() *ffcli.Command {
 This command fetches builds uploaded to App Store Connect,
 including processing status and expiration dates.
 
+--since filters by uploadedDate after fetching, so it applies per page; combine
+it with --paginate to cover the whole window.
+
 Examples:
   asc builds list --app "123456789"
   asc builds list --app "123456789" --version "1.2.3"
@@ -459,6 +463,8 @@ Examples:
   asc builds list --app "123456789" --processing-state "PROCESSING"
   asc builds list --app "123456789" --processing-state "all"
   asc builds list --app "123456789" --version "1.2.3" --build-number "123"
+  asc builds list --app "123456789" --since 2024-01-01 --paginate
+  asc builds list --app "123456789" --since 168h
   asc builds list --app "123456789" --limit 10
   asc builds list --app "123456789" --paginate`,
 		FlagSet:   fs,
@@ -481,6 +487,16 @@ Examples:
 			if err != nil {
 				return err
 			}
+			var sinceThreshold time.Time
+			if strings.TrimSpace(*since) != "" {
+				if shared.RawOutput() {
+					return shared.UsageError("--raw cannot be combined with --since")
+				}
+				sinceThreshold, err = parseSinceThreshold(*since, time.Now())
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+			}
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			if resolvedAppID == "" && nextValue == "" {
@@ -540,10 +556,14 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBuilds(ctx, resolvedAppID, asc.WithBuildsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("builds: %w", err)
 				}
+				if typed, ok := builds.(*asc.BuildsResponse); ok && !sinceThreshold.IsZero() {
+					filterBuildsUploadedSince(typed, sinceThreshold)
+				}
 
 				format := *output.Output
 				return shared.PrintOutput(builds, format, *output.Pretty)
@@ -553,6 +573,9 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("builds: failed to fetch: %w", err)
 			}
+			if !sinceThreshold.IsZero() {
+				filterBuildsUploadedSince(builds, sinceThreshold)
+			}
 
 			format := *output.Output
 
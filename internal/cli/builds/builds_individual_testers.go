@@ -102,6 +102,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBuildIndividualTesters(ctx, buildValue, asc.WithBuildIndividualTestersNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("builds individual-testers list: %w", err)
@@ -248,6 +248,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBuildIcons(ctx, buildValue, asc.WithBuildIconsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("builds icons list: %w", err)
@@ -140,6 +140,7 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return getBuildRelationshipList(ctx, client, relationshipType, buildValue, asc.WithLinkagesNextURL(nextURL))
 						},
+						shared.PaginateOptions()...,
 					)
 					if err != nil {
 						return fmt.Errorf("builds relationships get: %w", err)
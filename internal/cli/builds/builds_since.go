@@ -0,0 +1,56 @@
+package builds
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// parseSinceThreshold parses --since as an absolute date (YYYY-MM-DD or
+// RFC3339) or as a duration before now: a Go duration such as 168h, or a
+// whole number of days or weeks such as 30d or 2w.
+func parseSinceThreshold(value string, now time.Time) (time.Time, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return time.Time{}, fmt.Errorf("--since must not be empty")
+	}
+	if parsed, err := time.Parse("2006-01-02", trimmed); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, trimmed); err == nil {
+		return parsed, nil
+	}
+	if duration, err := time.ParseDuration(trimmed); err == nil && duration > 0 {
+		return now.Add(-duration), nil
+	}
+
+	lower := strings.ToLower(trimmed)
+	unit := lower[len(lower)-1]
+	count, err := strconv.Atoi(lower[:len(lower)-1])
+	if err == nil && count > 0 {
+		switch unit {
+		case 'd':
+			return now.Add(-time.Duration(count) * 24 * time.Hour), nil
+		case 'w':
+			return now.Add(-time.Duration(count) * 7 * 24 * time.Hour), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("--since must be a date (2024-01-01), an RFC3339 time, or a duration like 168h or 30d")
+}
+
+// filterBuildsUploadedSince drops builds uploaded before since. Builds without
+// a parseable uploadedDate are dropped as well.
+func filterBuildsUploadedSince(resp *asc.BuildsResponse, since time.Time) {
+	filtered := make([]asc.Resource[asc.BuildAttributes], 0, len(resp.Data))
+	for _, build := range resp.Data {
+		uploaded, err := parseBuildTimestamp(build.Attributes.UploadedDate)
+		if err != nil || uploaded.Before(since) {
+			continue
+		}
+		filtered = append(filtered, build)
+	}
+	resp.Data = filtered
+}
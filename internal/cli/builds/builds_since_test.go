@@ -0,0 +1,88 @@
+package builds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseSinceThreshold(t *testing.T) {
+	now := time.Date(2026, time.February, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "date only",
+			input: "2026-01-01",
+			want:  time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "rfc3339",
+			input: "2026-01-01T08:30:00+02:00",
+			want:  time.Date(2026, time.January, 1, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "go duration",
+			input: "168h",
+			want:  now.Add(-168 * time.Hour),
+		},
+		{
+			name:  "days",
+			input: "30d",
+			want:  now.Add(-30 * 24 * time.Hour),
+		},
+		{
+			name:  "weeks",
+			input: "2w",
+			want:  now.Add(-14 * 24 * time.Hour),
+		},
+		{
+			name:    "negative duration",
+			input:   "-1h",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			input:   "yesterday",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseSinceThreshold(test.input, now)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(test.want) {
+				t.Fatalf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterBuildsUploadedSince(t *testing.T) {
+	resp := &asc.BuildsResponse{Data: []asc.Resource[asc.BuildAttributes]{
+		{ID: "new", Attributes: asc.BuildAttributes{UploadedDate: "2026-02-01T00:00:00Z"}},
+		{ID: "boundary", Attributes: asc.BuildAttributes{UploadedDate: "2026-01-01T00:00:00Z"}},
+		{ID: "old", Attributes: asc.BuildAttributes{UploadedDate: "2025-12-31T23:59:59Z"}},
+		{ID: "undated"},
+	}}
+
+	filterBuildsUploadedSince(resp, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	if len(resp.Data) != 2 || resp.Data[0].ID != "new" || resp.Data[1].ID != "boundary" {
+		t.Fatalf("expected new and boundary builds, got %+v", resp.Data)
+	}
+}
@@ -18,6 +18,7 @@ import (
 const (
 	buildsWaitDefaultTimeout      = 15 * time.Minute
 	buildsWaitDefaultPollInterval = 30 * time.Second
+	buildsWaitDefaultMaxInterval  = 2 * time.Minute
 )
 
 // BuildsWaitCommand waits for build processing to reach a terminal state.
@@ -25,11 +26,13 @@ func BuildsWaitCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("wait", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID to wait for")
+	buildIDAlias := fs.String("build-id", "", "Alias for --build")
 	appID := fs.String("app", "", "App Store Connect app ID, bundle ID, or exact app name (required with --build-number)")
 	buildNumber := fs.String("build-number", "", "Build number (CFBundleVersion) to resolve and wait for (requires --app)")
 	platform := fs.String("platform", "IOS", "Platform filter for --app/--build-number: IOS, MAC_OS, TV_OS, VISION_OS")
 	timeout := fs.Duration("timeout", buildsWaitDefaultTimeout, "Maximum time to wait for build processing")
-	pollInterval := fs.Duration("poll-interval", buildsWaitDefaultPollInterval, "Polling interval for build status checks")
+	pollInterval := fs.Duration("poll-interval", buildsWaitDefaultPollInterval, "Initial polling interval for build status checks")
+	maxPollInterval := fs.Duration("max-poll-interval", buildsWaitDefaultMaxInterval, "Longest wait between checks; the interval doubles after each check up to this cap")
 	failOnInvalid := fs.Bool("fail-on-invalid", false, "Exit non-zero if build reaches INVALID")
 	output := shared.BindOutputFlags(fs)
 
@@ -44,12 +47,16 @@ This command polls build processing state until a terminal condition:
   - FAILED  -> exits non-zero
   - INVALID -> exits non-zero only with --fail-on-invalid
 
+The wait between checks starts at --poll-interval and doubles after each
+check, up to --max-poll-interval. Hitting --timeout exits non-zero.
+
 Build selector modes (mutually exclusive):
   - --build BUILD_ID
   - --app APP_ID --build-number NUMBER [--platform IOS]
 
 Examples:
   asc builds wait --build "BUILD_ID"
+  asc builds wait --build-id "BUILD_ID" --timeout 30m --fail-on-invalid
   asc builds wait --build "BUILD_ID" --timeout 20m --poll-interval 15s
   asc builds wait --app "123456789" --build-number "42"
   asc builds wait --app "123456789" --build-number "42" --platform MAC_OS --fail-on-invalid`,
@@ -57,6 +64,13 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			buildValue := strings.TrimSpace(*buildID)
+			alias := strings.TrimSpace(*buildIDAlias)
+			if buildValue != "" && alias != "" && buildValue != alias {
+				return shared.UsageError("--build and --build-id conflict; set only one")
+			}
+			if buildValue == "" {
+				buildValue = alias
+			}
 			buildNumberValue := strings.TrimSpace(*buildNumber)
 			appInputProvided := strings.TrimSpace(*appID) != ""
 			buildNumberProvided := buildNumberValue != ""
@@ -64,6 +78,9 @@ Examples:
 			if *pollInterval <= 0 {
 				return shared.UsageError("--poll-interval must be greater than 0")
 			}
+			if *maxPollInterval <= 0 {
+				return shared.UsageError("--max-poll-interval must be greater than 0")
+			}
 			if *timeout <= 0 {
 				return shared.UsageError("--timeout must be greater than 0")
 			}
@@ -101,7 +118,7 @@ Examples:
 			}
 
 			waitBuildID := buildResp.Data.ID
-			buildResp, err = waitForBuildProcessingState(requestCtx, client, buildResp.Data.ID, *pollInterval, *failOnInvalid)
+			buildResp, err = waitForBuildProcessingState(requestCtx, client, buildResp.Data.ID, *pollInterval, *maxPollInterval, *failOnInvalid)
 			if err != nil {
 				if errors.Is(err, context.DeadlineExceeded) {
 					return fmt.Errorf("builds wait: timed out waiting for build %s after %s", waitBuildID, (*timeout).Round(time.Second))
@@ -172,14 +189,15 @@ func waitForBuildProcessingState(
 	client *asc.Client,
 	buildID string,
 	pollInterval time.Duration,
+	maxPollInterval time.Duration,
 	failOnInvalid bool,
 ) (*asc.BuildResponse, error) {
 	started := time.Now()
 
-	for {
+	return asc.PollUntilWithBackoff(ctx, pollInterval, maxPollInterval, func(ctx context.Context) (*asc.BuildResponse, bool, error) {
 		buildResp, err := client.GetBuild(ctx, buildID)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		state := strings.ToUpper(strings.TrimSpace(buildResp.Data.Attributes.ProcessingState))
@@ -196,20 +214,15 @@ func waitForBuildProcessingState(
 
 		switch state {
 		case asc.BuildProcessingStateValid:
-			return buildResp, nil
+			return buildResp, true, nil
 		case asc.BuildProcessingStateFailed:
-			return nil, fmt.Errorf("build processing failed with state %s", state)
+			return nil, false, fmt.Errorf("build processing failed with state %s", state)
 		case asc.BuildProcessingStateInvalid:
 			if failOnInvalid {
-				return nil, fmt.Errorf("build processing failed with state %s", state)
+				return nil, false, fmt.Errorf("build processing failed with state %s", state)
 			}
-			return buildResp, nil
+			return buildResp, true, nil
 		}
-
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(pollInterval):
-		}
-	}
+		return nil, false, nil
+	})
 }
@@ -101,7 +101,7 @@ Examples:
 
 				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBundleIDs(ctx, asc.WithBundleIDsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("bundle-ids list: %w", err)
 				}
@@ -166,7 +166,7 @@ func BundleIDsCreateCommand() *ffcli.Command {
 
 	identifier := fs.String("identifier", "", "Bundle ID identifier (e.g., com.example.app)")
 	name := fs.String("name", "", "Bundle ID name")
-	platform := fs.String("platform", "IOS", "Platform: "+strings.Join(shared.PlatformList(), ", "))
+	platform := fs.String("platform", "IOS", "Platform: "+bundleIDPlatformList())
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -175,8 +175,14 @@ func BundleIDsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a bundle ID.",
 		LongHelp: `Create a bundle ID.
 
+The identifier must be reverse-DNS (letters, digits, hyphens, and periods);
+end it with .* to create a wildcard bundle ID. Use UNIVERSAL for a bundle ID
+shared by iOS and macOS. The output includes the new bundle ID's resource ID.
+
 Examples:
-  asc bundle-ids create --identifier "com.example.app" --name "Example" --platform IOS`,
+  asc bundle-ids create --identifier "com.example.app" --name "Example" --platform IOS
+  asc bundle-ids create --identifier "com.example.*" --name "Example Wildcard"
+  asc bundle-ids create --identifier "com.example.app" --name "Example" --select data.id`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -190,9 +196,12 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --name is required")
 				return flag.ErrHelp
 			}
-			platformValue, err := shared.NormalizePlatform(*platform)
+			if err := validateBundleIdentifier(identifierValue); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			platformValue, err := normalizeBundleIDPlatform(*platform)
 			if err != nil {
-				return fmt.Errorf("bundle-ids create: %w", err)
+				return shared.UsageError(err.Error())
 			}
 
 			client, err := shared.GetASCClient()
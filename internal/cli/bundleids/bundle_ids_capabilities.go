@@ -26,9 +26,13 @@ func BundleIDsCapabilitiesCommand() *ffcli.Command {
 
 Examples:
   asc bundle-ids capabilities list --bundle "BUNDLE_ID"
-  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD
-  asc bundle-ids capabilities update --id "CAPABILITY_ID" --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_13","enabled":true}]}]'
-  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm`,
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability PUSH_NOTIFICATIONS
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD --setting ICLOUD_VERSION=XCODE_6
+  asc bundle-ids capabilities update --id "CAPABILITY_ID" --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_6","enabled":true}]}]'
+  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm
+  asc bundle-ids capabilities remove --bundle "BUNDLE_ID" --capability PUSH_NOTIFICATIONS --confirm
+  asc bundle-ids capabilities enable --bundle-id "BUNDLE_ID" --capability PUSH_NOTIFICATIONS
+  asc bundle-ids capabilities disable --bundle-id "BUNDLE_ID" --capability PUSH_NOTIFICATIONS --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -36,6 +40,8 @@ Examples:
 			BundleIDsCapabilitiesAddCommand(),
 			BundleIDsCapabilitiesUpdateCommand(),
 			BundleIDsCapabilitiesRemoveCommand(),
+			BundleIDsCapabilitiesEnableCommand(),
+			BundleIDsCapabilitiesDisableCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -93,7 +99,7 @@ Examples:
 
 				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBundleIDCapabilities(ctx, bundleValue, asc.WithBundleIDCapabilitiesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("bundle-ids capabilities list: %w", err)
 				}
@@ -113,44 +119,85 @@ Examples:
 
 // BundleIDsCapabilitiesAddCommand returns the bundle IDs capabilities add subcommand.
 func BundleIDsCapabilitiesAddCommand() *ffcli.Command {
-	fs := flag.NewFlagSet("add", flag.ExitOnError)
+	return newBundleIDsCapabilitiesAddCommand("add", "Add a capability to a bundle ID.", `Add a capability to a bundle ID.
+
+Capability types App Store Connect is not known to support produce a warning
+but are still sent. Settings are optional; a capability that takes one
+accepts it with --setting or as raw --settings JSON:
+  ICLOUD           ICLOUD_VERSION=XCODE_5|XCODE_6
+  DATA_PROTECTION  DATA_PROTECTION_PERMISSION_LEVEL=COMPLETE_PROTECTION|PROTECTED_UNLESS_OPEN|PROTECTED_UNTIL_FIRST_USER_AUTH
+  APPLE_ID_AUTH    APPLE_ID_AUTH_APP_CONSENT=PRIMARY_APP_CONSENT
+
+Examples:
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD --setting ICLOUD_VERSION=XCODE_6
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_6","enabled":true}]}]'`)
+}
+
+// BundleIDsCapabilitiesEnableCommand returns the bundle IDs capabilities
+// enable subcommand, an alias for add.
+func BundleIDsCapabilitiesEnableCommand() *ffcli.Command {
+	return newBundleIDsCapabilitiesAddCommand("enable", "Enable a capability for a bundle ID (alias for add).", `Enable a capability for a bundle ID.
+
+Alias for asc bundle-ids capabilities add; --bundle-id and --bundle are
+interchangeable.
+
+Examples:
+  asc bundle-ids capabilities enable --bundle-id "BUNDLE_ID" --capability PUSH_NOTIFICATIONS
+  asc bundle-ids capabilities enable --bundle-id "BUNDLE_ID" --capability ICLOUD --setting ICLOUD_VERSION=XCODE_6`)
+}
+
+func newBundleIDsCapabilitiesAddCommand(name, shortHelp, longHelp string) *ffcli.Command {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
 
 	bundleID := fs.String("bundle", "", "Bundle ID")
+	bundleIDAlias := fs.String("bundle-id", "", "Alias for --bundle")
 	capability := fs.String("capability", "", "Capability type (e.g., ICLOUD, IN_APP_PURCHASE)")
 	settings := fs.String("settings", "", "Capability settings as JSON array (optional)")
+	var setting capabilitySettingFlag
+	fs.Var(&setting, "setting", "Capability setting as KEY=VALUE (repeatable, e.g., ICLOUD_VERSION=XCODE_6)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
-		Name:       "add",
-		ShortUsage: "asc bundle-ids capabilities add --bundle \"BUNDLE_ID\" --capability CAPABILITY_TYPE [flags]",
-		ShortHelp:  "Add a capability to a bundle ID.",
-		LongHelp: `Add a capability to a bundle ID.
-
-Examples:
-  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD
-  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_13","enabled":true}]}]'`,
-		FlagSet:   fs,
-		UsageFunc: shared.DefaultUsageFunc,
+		Name:       name,
+		ShortUsage: fmt.Sprintf("asc bundle-ids capabilities %s --bundle \"BUNDLE_ID\" --capability CAPABILITY_TYPE [flags]", name),
+		ShortHelp:  shortHelp,
+		LongHelp:   longHelp,
+		FlagSet:    fs,
+		UsageFunc:  shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			bundleValue := strings.TrimSpace(*bundleID)
+			bundleValue, err := resolveBundleFlag(*bundleID, *bundleIDAlias)
+			if err != nil {
+				return err
+			}
 			if bundleValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --bundle is required")
 				return flag.ErrHelp
 			}
-			capabilityValue := strings.ToUpper(strings.TrimSpace(*capability))
-			if capabilityValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --capability is required")
-				return flag.ErrHelp
+			capabilityValue, err := normalizeCapabilityType(*capability)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if strings.TrimSpace(*settings) != "" && len(setting) > 0 {
+				return shared.UsageError("--settings and --setting are mutually exclusive")
 			}
 
-			settingsValue, err := parseCapabilitySettings(*settings)
-			if err != nil {
-				return fmt.Errorf("bundle-ids capabilities add: %w", err)
+			var settingsValue []asc.CapabilitySetting
+			if strings.TrimSpace(*settings) != "" {
+				settingsValue, err = parseCapabilitySettings(*settings)
+				if err != nil {
+					return fmt.Errorf("bundle-ids capabilities %s: %w", name, err)
+				}
+			} else {
+				settingsValue, err = buildCapabilitySettings(capabilityValue, setting)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
 			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
-				return fmt.Errorf("bundle-ids capabilities add: %w", err)
+				return fmt.Errorf("bundle-ids capabilities %s: %w", name, err)
 			}
 
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
@@ -162,7 +209,7 @@ Examples:
 			}
 			resp, err := client.CreateBundleIDCapability(requestCtx, bundleValue, attrs)
 			if err != nil {
-				return fmt.Errorf("bundle-ids capabilities add: failed to create: %w", err)
+				return fmt.Errorf("bundle-ids capabilities %s: failed to create: %w", name, err)
 			}
 
 			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
@@ -186,7 +233,7 @@ func BundleIDsCapabilitiesUpdateCommand() *ffcli.Command {
 		LongHelp: `Update a bundle ID capability.
 
 Examples:
-  asc bundle-ids capabilities update --id "CAPABILITY_ID" --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_13","enabled":true}]}]'
+  asc bundle-ids capabilities update --id "CAPABILITY_ID" --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_6","enabled":true}]}]'
   asc bundle-ids capabilities update --id "CAPABILITY_ID" --capability PUSH_NOTIFICATIONS
   asc bundle-ids capabilities update --id "CAPABILITY_ID" --output table`,
 		FlagSet:   fs,
@@ -235,27 +282,67 @@ Examples:
 
 // BundleIDsCapabilitiesRemoveCommand returns the bundle IDs capabilities remove subcommand.
 func BundleIDsCapabilitiesRemoveCommand() *ffcli.Command {
-	fs := flag.NewFlagSet("remove", flag.ExitOnError)
+	return newBundleIDsCapabilitiesRemoveCommand("remove", "Remove a capability from a bundle ID.", `Remove a capability from a bundle ID.
+
+Pass the capability ID with --id, or --bundle and --capability to look up the
+bundle ID's capability of that type.
+
+Examples:
+  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm
+  asc bundle-ids capabilities remove --bundle "BUNDLE_ID" --capability PUSH_NOTIFICATIONS --confirm`)
+}
+
+// BundleIDsCapabilitiesDisableCommand returns the bundle IDs capabilities
+// disable subcommand, an alias for remove.
+func BundleIDsCapabilitiesDisableCommand() *ffcli.Command {
+	return newBundleIDsCapabilitiesRemoveCommand("disable", "Disable a capability for a bundle ID (alias for remove).", `Disable a capability for a bundle ID.
+
+Alias for asc bundle-ids capabilities remove; --bundle-id and --bundle are
+interchangeable.
+
+Examples:
+  asc bundle-ids capabilities disable --bundle-id "BUNDLE_ID" --capability PUSH_NOTIFICATIONS --confirm`)
+}
+
+func newBundleIDsCapabilitiesRemoveCommand(name, shortHelp, longHelp string) *ffcli.Command {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
 
 	id := fs.String("id", "", "Capability ID")
+	bundleID := fs.String("bundle", "", "Bundle ID (with --capability, instead of --id)")
+	bundleIDAlias := fs.String("bundle-id", "", "Alias for --bundle")
+	capability := fs.String("capability", "", "Capability type to remove from --bundle (e.g., PUSH_NOTIFICATIONS)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
-		Name:       "remove",
-		ShortUsage: "asc bundle-ids capabilities remove --id \"CAPABILITY_ID\" --confirm",
-		ShortHelp:  "Remove a capability from a bundle ID.",
-		LongHelp: `Remove a capability from a bundle ID.
-
-Examples:
-  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm`,
-		FlagSet:   fs,
-		UsageFunc: shared.DefaultUsageFunc,
+		Name:       name,
+		ShortUsage: fmt.Sprintf("asc bundle-ids capabilities %s (--id \"CAPABILITY_ID\" | --bundle \"BUNDLE_ID\" --capability CAPABILITY_TYPE) --confirm", name),
+		ShortHelp:  shortHelp,
+		LongHelp:   longHelp,
+		FlagSet:    fs,
+		UsageFunc:  shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			bundleValue, err := resolveBundleFlag(*bundleID, *bundleIDAlias)
+			if err != nil {
+				return err
+			}
+			capabilityInput := strings.TrimSpace(*capability)
+			var capabilityValue string
+			switch {
+			case idValue != "" && (bundleValue != "" || capabilityInput != ""):
+				return shared.UsageError("--id cannot be combined with --bundle or --capability")
+			case idValue == "" && bundleValue == "" && capabilityInput == "":
+				fmt.Fprintln(os.Stderr, "Error: --id is required (or --bundle with --capability)")
+				return flag.ErrHelp
+			case idValue == "" && bundleValue == "":
+				fmt.Fprintln(os.Stderr, "Error: --bundle is required with --capability")
 				return flag.ErrHelp
+			case idValue == "":
+				capabilityValue, err = normalizeCapabilityType(capabilityInput)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
 			}
 			if !*confirm {
 				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
@@ -264,14 +351,20 @@ Examples:
 
 			client, err := shared.GetASCClient()
 			if err != nil {
-				return fmt.Errorf("bundle-ids capabilities remove: %w", err)
+				return fmt.Errorf("bundle-ids capabilities %s: %w", name, err)
 			}
 
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if idValue == "" {
+				idValue, err = findBundleIDCapability(requestCtx, client, bundleValue, capabilityValue)
+				if err != nil {
+					return fmt.Errorf("bundle-ids capabilities %s: %w", name, err)
+				}
+			}
 			if err := client.DeleteBundleIDCapability(requestCtx, idValue); err != nil {
-				return fmt.Errorf("bundle-ids capabilities remove: failed to delete: %w", err)
+				return fmt.Errorf("bundle-ids capabilities %s: failed to delete: %w", name, err)
 			}
 
 			result := &asc.BundleIDCapabilityDeleteResult{
@@ -284,6 +377,20 @@ Examples:
 	}
 }
 
+// resolveBundleFlag returns the bundle ID given with --bundle or its
+// --bundle-id alias.
+func resolveBundleFlag(bundle, alias string) (string, error) {
+	bundle = strings.TrimSpace(bundle)
+	alias = strings.TrimSpace(alias)
+	if bundle != "" && alias != "" && bundle != alias {
+		return "", shared.UsageError("--bundle and --bundle-id conflict; set only one")
+	}
+	if bundle == "" {
+		bundle = alias
+	}
+	return bundle, nil
+}
+
 func parseCapabilitySettings(value string) ([]asc.CapabilitySetting, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
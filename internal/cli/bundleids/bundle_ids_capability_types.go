@@ -0,0 +1,174 @@
+package bundleids
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// capabilityTypes are the bundle ID capability types App Store Connect is
+// known to accept.
+var capabilityTypes = []string{
+	"ACCESS_WIFI_INFORMATION",
+	"APP_GROUPS",
+	"APPLE_ID_AUTH",
+	"APPLE_PAY",
+	"ASSOCIATED_DOMAINS",
+	"AUTOFILL_CREDENTIAL_PROVIDER",
+	"CLASSKIT",
+	"COREMEDIA_HLS_LOW_LATENCY",
+	"DATA_PROTECTION",
+	"GAME_CENTER",
+	"HEALTHKIT",
+	"HOMEKIT",
+	"HOT_SPOT",
+	"ICLOUD",
+	"IN_APP_PURCHASE",
+	"INTER_APP_AUDIO",
+	"MAPS",
+	"MULTIPATH",
+	"NETWORK_CUSTOM_PROTOCOL",
+	"NETWORK_EXTENSIONS",
+	"NFC_TAG_READING",
+	"PERSONAL_VPN",
+	"PUSH_NOTIFICATIONS",
+	"SIRIKIT",
+	"SYSTEM_EXTENSION_INSTALL",
+	"USER_MANAGEMENT",
+	"WALLET",
+	"WIRELESS_ACCESSORY_CONFIGURATION",
+}
+
+// capabilitySettingSpec describes a setting accepted by --setting: the
+// capability it belongs to and the option values it allows.
+type capabilitySettingSpec struct {
+	capability string
+	options    []string
+}
+
+var capabilitySettings = map[string]capabilitySettingSpec{
+	"ICLOUD_VERSION": {
+		capability: "ICLOUD",
+		options:    []string{"XCODE_5", "XCODE_6"},
+	},
+	"DATA_PROTECTION_PERMISSION_LEVEL": {
+		capability: "DATA_PROTECTION",
+		options:    []string{"COMPLETE_PROTECTION", "PROTECTED_UNLESS_OPEN", "PROTECTED_UNTIL_FIRST_USER_AUTH"},
+	},
+	"APPLE_ID_AUTH_APP_CONSENT": {
+		capability: "APPLE_ID_AUTH",
+		options:    []string{"PRIMARY_APP_CONSENT"},
+	},
+}
+
+// capabilitySettingFlag collects repeated --setting KEY=VALUE flags.
+type capabilitySettingFlag []capabilitySettingValue
+
+type capabilitySettingValue struct {
+	key   string
+	value string
+}
+
+func (f *capabilitySettingFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(*f))
+	for _, setting := range *f {
+		parts = append(parts, setting.key+"="+setting.value)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f *capabilitySettingFlag) Set(value string) error {
+	key, settingValue, ok := strings.Cut(value, "=")
+	key = strings.ToUpper(strings.TrimSpace(key))
+	settingValue = strings.ToUpper(strings.TrimSpace(settingValue))
+	if !ok || key == "" || settingValue == "" {
+		return fmt.Errorf("expected KEY=VALUE, got %q", value)
+	}
+	*f = append(*f, capabilitySettingValue{key: key, value: settingValue})
+	return nil
+}
+
+// findBundleIDCapability returns the ID of the bundle ID's capability of the
+// given type.
+func findBundleIDCapability(ctx context.Context, client *asc.Client, bundleID, capabilityType string) (string, error) {
+	firstPage, err := client.GetBundleIDCapabilities(ctx, bundleID)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch capabilities: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDCapabilities(ctx, bundleID, asc.WithBundleIDCapabilitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch capabilities: %w", err)
+	}
+	capabilities, ok := paginated.(*asc.BundleIDCapabilitiesResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected capabilities response type %T", paginated)
+	}
+	for _, item := range capabilities.Data {
+		if strings.EqualFold(item.Attributes.CapabilityType, capabilityType) {
+			return item.ID, nil
+		}
+	}
+	return "", fmt.Errorf("capability %s is not enabled for bundle ID %s", capabilityType, bundleID)
+}
+
+// normalizeCapabilityType upper-cases a --capability value. Types missing
+// from capabilityTypes only produce a warning, so capabilities Apple adds
+// later still reach the API.
+func normalizeCapabilityType(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if normalized == "" {
+		return "", fmt.Errorf("--capability is required")
+	}
+	if !slices.Contains(capabilityTypes, normalized) {
+		fmt.Fprintf(os.Stderr, "Warning: %s is not a known capability type; sending it to App Store Connect as-is\n", normalized)
+	}
+	return normalized, nil
+}
+
+// buildCapabilitySettings validates --setting values for capabilityType and
+// converts them to API settings, ordered by key. Settings are optional.
+func buildCapabilitySettings(capabilityType string, values capabilitySettingFlag) ([]asc.CapabilitySetting, error) {
+	given := make(map[string]string, len(values))
+	for _, setting := range values {
+		spec, ok := capabilitySettings[setting.key]
+		if !ok || spec.capability != capabilityType {
+			return nil, fmt.Errorf("--setting %s is not supported for %s", setting.key, capabilityType)
+		}
+		if !slices.Contains(spec.options, setting.value) {
+			return nil, fmt.Errorf("--setting %s must be one of: %s", setting.key, strings.Join(spec.options, ", "))
+		}
+		if _, dup := given[setting.key]; dup {
+			return nil, fmt.Errorf("--setting %s was given more than once", setting.key)
+		}
+		given[setting.key] = setting.value
+	}
+	if len(given) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(given))
+	for key := range given {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	enabled := true
+	settings := make([]asc.CapabilitySetting, 0, len(keys))
+	for _, key := range keys {
+		settings = append(settings, asc.CapabilitySetting{
+			Key:     key,
+			Options: []asc.CapabilityOption{{Key: given[key], Enabled: &enabled}},
+		})
+	}
+	return settings, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"strings"
 	"testing"
 )
 
@@ -182,3 +183,76 @@ func TestExtractBundleIDFromNextURL_RejectsMalformedHost(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBundleIdentifier(t *testing.T) {
+	for _, valid := range []string{"com.example.app", "com.example-co.app2", "com.example.*", "io.app"} {
+		if err := validateBundleIdentifier(valid); err != nil {
+			t.Fatalf("validateBundleIdentifier(%q) error: %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{"", "app", "com.example.", ".com.example", "com.exa_mple.app", "com.*.app", "*"} {
+		if err := validateBundleIdentifier(invalid); err == nil {
+			t.Fatalf("expected validateBundleIdentifier(%q) to fail", invalid)
+		}
+	}
+}
+
+func TestNormalizeBundleIDPlatform(t *testing.T) {
+	platform, err := normalizeBundleIDPlatform(" mac_os ")
+	if err != nil || platform != "MAC_OS" {
+		t.Fatalf("normalizeBundleIDPlatform() = %q, %v", platform, err)
+	}
+	if _, err := normalizeBundleIDPlatform("VISION_OS"); err == nil {
+		t.Fatal("expected VISION_OS to be rejected for bundle IDs")
+	}
+}
+
+func TestNormalizeCapabilityType(t *testing.T) {
+	capability, err := normalizeCapabilityType(" push_notifications ")
+	if err != nil || capability != "PUSH_NOTIFICATIONS" {
+		t.Fatalf("normalizeCapabilityType() = %q, %v", capability, err)
+	}
+	if _, err := normalizeCapabilityType(""); err == nil {
+		t.Fatal("expected empty capability type to be rejected")
+	}
+}
+
+func TestBuildCapabilitySettings(t *testing.T) {
+	settings, err := buildCapabilitySettings("PUSH_NOTIFICATIONS", nil)
+	if err != nil || settings != nil {
+		t.Fatalf("expected no settings for PUSH_NOTIFICATIONS, got %+v, %v", settings, err)
+	}
+
+	var values capabilitySettingFlag
+	if err := values.Set("icloud_version=xcode_6"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	settings, err = buildCapabilitySettings("ICLOUD", values)
+	if err != nil {
+		t.Fatalf("buildCapabilitySettings() error: %v", err)
+	}
+	if len(settings) != 1 || settings[0].Key != "ICLOUD_VERSION" || len(settings[0].Options) != 1 || settings[0].Options[0].Key != "XCODE_6" {
+		t.Fatalf("unexpected settings: %+v", settings)
+	}
+	if enabled := settings[0].Options[0].Enabled; enabled == nil || !*enabled {
+		t.Fatalf("expected option to be enabled, got %+v", settings[0].Options[0])
+	}
+
+	if settings, err := buildCapabilitySettings("ICLOUD", nil); err != nil || settings != nil {
+		t.Fatalf("expected ICLOUD settings to be optional, got %+v, %v", settings, err)
+	}
+	if _, err := buildCapabilitySettings("PUSH_NOTIFICATIONS", values); err == nil {
+		t.Fatal("expected ICLOUD_VERSION to be rejected for PUSH_NOTIFICATIONS")
+	}
+
+	var invalid capabilitySettingFlag
+	if err := invalid.Set("ICLOUD_VERSION=XCODE_99"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	if _, err := buildCapabilitySettings("ICLOUD", invalid); err == nil || !strings.Contains(err.Error(), "must be one of: XCODE_5, XCODE_6") {
+		t.Fatalf("expected invalid option error, got %v", err)
+	}
+	if err := invalid.Set("ICLOUD_VERSION"); err == nil {
+		t.Fatal("expected Set() to reject a value without =")
+	}
+}
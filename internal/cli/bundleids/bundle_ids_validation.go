@@ -0,0 +1,41 @@
+package bundleids
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// bundleIdentifierPattern matches reverse-DNS identifiers such as
+// com.example.app, optionally ending in .* for a wildcard bundle ID.
+var bundleIdentifierPattern = regexp.MustCompile(`^[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)+(\.\*)?$`)
+
+// bundleIDPlatforms are the platforms App Store Connect accepts for bundle IDs.
+var bundleIDPlatforms = []asc.Platform{asc.PlatformIOS, asc.PlatformMacOS, asc.PlatformUniversal}
+
+func validateBundleIdentifier(identifier string) error {
+	if !bundleIdentifierPattern.MatchString(identifier) {
+		return fmt.Errorf("--identifier must be reverse-DNS like com.example.app (letters, digits, hyphens, and periods; end with .* for a wildcard), got %q", identifier)
+	}
+	return nil
+}
+
+func normalizeBundleIDPlatform(value string) (asc.Platform, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	for _, platform := range bundleIDPlatforms {
+		if string(platform) == normalized {
+			return platform, nil
+		}
+	}
+	return "", fmt.Errorf("--platform must be one of: %s", bundleIDPlatformList())
+}
+
+func bundleIDPlatformList() string {
+	names := make([]string, 0, len(bundleIDPlatforms))
+	for _, platform := range bundleIDPlatforms {
+		names = append(names, string(platform))
+	}
+	return strings.Join(names, ", ")
+}
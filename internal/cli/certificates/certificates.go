@@ -34,6 +34,7 @@ Examples:
   asc certificates update --id "CERT_ID" --activated true
   asc certificates update --id "CERT_ID" --activated false
   asc certificates revoke --id "CERT_ID" --confirm
+  asc certificates download --id "CERT_ID" --output "./cert.cer"
   asc certificates relationships pass-type-id --id "CERT_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -44,6 +45,7 @@ Examples:
 			CertificatesCreateCommand(),
 			CertificatesUpdateCommand(),
 			CertificatesRevokeCommand(),
+			CertificatesDownloadCommand(),
 			CertificatesRelationshipsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -109,6 +111,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("certificates list: %w", err)
@@ -0,0 +1,118 @@
+package certificates
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	certificateFormatDER = "der"
+	certificateFormatPEM = "pem"
+)
+
+// CertificatesDownloadCommand returns the certificates download subcommand.
+func CertificatesDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	id := fs.String("id", "", "Certificate ID")
+	outputPath := fs.String("output", "", "Output certificate file path")
+	format := fs.String("format", certificateFormatDER, "Certificate encoding: der (default) or pem")
+	fileMode := fs.String("file-mode", "0600", "Permissions for the written certificate (octal, e.g. 0644)")
+	force := fs.Bool("force", false, "Overwrite an existing output file")
+	output := shared.BindMetadataOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc certificates download --id \"CERT_ID\" --output ./cert.cer [flags]",
+		ShortHelp:  "Download a signing certificate.",
+		LongHelp: `Download a signing certificate.
+
+The certificate is written atomically and never through a symlink. Use
+--format pem to convert the DER bytes App Store Connect returns into PEM.
+
+Examples:
+  asc certificates download --id "CERT_ID" --output "./cert.cer"
+  asc certificates download --id "CERT_ID" --output "./cert.pem" --format pem
+  asc certificates download --id "CERT_ID" --output "./cert.cer" --force`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*outputPath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output is required")
+				return flag.ErrHelp
+			}
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue != certificateFormatDER && formatValue != certificateFormatPEM {
+				return shared.UsageError("--format must be one of: der, pem")
+			}
+			perm, err := shared.ParseFileMode(*fileMode)
+			if err != nil {
+				return shared.UsageError("--file-mode: " + err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("certificates download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetCertificate(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("certificates download: failed to fetch: %w", err)
+			}
+
+			data, err := encodeCertificateContent(resp.Data.Attributes.CertificateContent, formatValue)
+			if err != nil {
+				return fmt.Errorf("certificates download: %w", err)
+			}
+
+			if err := writeFileBytesNoSymlink(pathValue, data, perm, *force); err != nil {
+				return fmt.Errorf("certificates download: %w", err)
+			}
+
+			result := &asc.CertificateDownloadResult{
+				ID:         idValue,
+				Name:       resp.Data.Attributes.Name,
+				Format:     formatValue,
+				OutputPath: pathValue,
+			}
+
+			return shared.PrintOutput(result, *output.OutputFormat, *output.Pretty)
+		},
+	}
+}
+
+// encodeCertificateContent decodes the base64 DER certificateContent and
+// re-encodes it in the requested format.
+func encodeCertificateContent(content, format string) ([]byte, error) {
+	normalized := strings.Join(strings.Fields(content), "")
+	if normalized == "" {
+		return nil, fmt.Errorf("certificate content is empty")
+	}
+	der, err := base64.StdEncoding.DecodeString(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("decode certificate: %w", err)
+	}
+	if format == certificateFormatPEM {
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+	}
+	return der, nil
+}
@@ -0,0 +1,143 @@
+package changelog
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type changelogResult struct {
+	VersionID      string           `json:"versionId"`
+	Entries        []changelogEntry `json:"entries"`
+	MissingLocales []string         `json:"missingLocales,omitempty"`
+}
+
+type changelogEntry struct {
+	Locale   string `json:"locale"`
+	WhatsNew string `json:"whatsNew"`
+}
+
+// ChangelogCommand returns the asc changelog command.
+func ChangelogCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("changelog", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	locale := fs.String("locale", "", "Comma-separated locales to include (default: all)")
+	output := shared.BindOutputFlagsWith(fs, "output", "markdown", "Output format: markdown (default), json")
+
+	return &ffcli.Command{
+		Name:       "changelog",
+		ShortUsage: "asc changelog --version-id VERSION_ID [flags]",
+		ShortHelp:  "Show a version's What's New text for every locale.",
+		LongHelp: `Show a version's What's New text for every locale.
+
+Fetches every localization of the App Store version and prints its What's New
+text grouped by locale, sorted by locale code. Markdown output is ready to
+paste into release notes or announcements. Locales without What's New text are
+listed as missing.
+
+Examples:
+  asc changelog --version-id "VERSION_ID"
+  asc changelog --version-id "VERSION_ID" --locale "en-US,de-DE"
+  asc changelog --version-id "VERSION_ID" --output json --pretty`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: changelog does not accept positional arguments")
+				return flag.ErrHelp
+			}
+			id := strings.TrimSpace(*versionID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "markdown", "json")
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("changelog: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			localizations, err := shared.FetchVersionLocalizations(requestCtx, client, id)
+			if err != nil {
+				return fmt.Errorf("changelog: failed to fetch version localizations: %w", err)
+			}
+
+			result := buildChangelog(id, localizations, shared.SplitCSV(*locale))
+			return shared.PrintOutputWithRenderers(
+				result,
+				normalizedOutput,
+				*output.Pretty,
+				nil,
+				func() error { renderMarkdown(result); return nil },
+			)
+		},
+	}
+}
+
+// buildChangelog collects non-empty What's New text by locale. When locales is
+// set, only those locales are considered.
+func buildChangelog(versionID string, localizations []asc.Resource[asc.AppStoreVersionLocalizationAttributes], locales []string) *changelogResult {
+	wanted := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		wanted[locale] = true
+	}
+
+	result := &changelogResult{VersionID: versionID, Entries: []changelogEntry{}}
+	seen := make(map[string]bool)
+	for _, localization := range localizations {
+		locale := strings.TrimSpace(localization.Attributes.Locale)
+		if len(wanted) > 0 && !wanted[locale] {
+			continue
+		}
+		seen[locale] = true
+		whatsNew := strings.TrimSpace(localization.Attributes.WhatsNew)
+		if whatsNew == "" {
+			result.MissingLocales = append(result.MissingLocales, locale)
+			continue
+		}
+		result.Entries = append(result.Entries, changelogEntry{Locale: locale, WhatsNew: whatsNew})
+	}
+	for _, locale := range locales {
+		if !seen[locale] {
+			seen[locale] = true
+			result.MissingLocales = append(result.MissingLocales, locale)
+		}
+	}
+
+	sort.Slice(result.Entries, func(i, j int) bool {
+		return result.Entries[i].Locale < result.Entries[j].Locale
+	})
+	sort.Strings(result.MissingLocales)
+	return result
+}
+
+func renderMarkdown(result *changelogResult) {
+	for i, entry := range result.Entries {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("## %s\n\n%s\n", entry.Locale, entry.WhatsNew)
+	}
+	if len(result.Entries) == 0 {
+		fmt.Fprintf(os.Stderr, "No What's New text found for version %s\n", result.VersionID)
+	}
+	if len(result.MissingLocales) > 0 {
+		fmt.Fprintf(os.Stderr, "Missing What's New: %s\n", strings.Join(result.MissingLocales, ", "))
+	}
+}
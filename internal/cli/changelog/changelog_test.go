@@ -0,0 +1,45 @@
+package changelog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestBuildChangelog_SortsEntriesAndReportsMissingLocales(t *testing.T) {
+	localizations := []asc.Resource[asc.AppStoreVersionLocalizationAttributes]{
+		{ID: "loc-fr", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "fr-FR", WhatsNew: " Corrections de bugs "}},
+		{ID: "loc-en", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "en-US", WhatsNew: "Bug fixes"}},
+		{ID: "loc-de", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "de-DE"}},
+	}
+
+	result := buildChangelog("ver-1", localizations, nil)
+
+	wantEntries := []changelogEntry{
+		{Locale: "en-US", WhatsNew: "Bug fixes"},
+		{Locale: "fr-FR", WhatsNew: "Corrections de bugs"},
+	}
+	if !reflect.DeepEqual(result.Entries, wantEntries) {
+		t.Fatalf("entries = %+v, want %+v", result.Entries, wantEntries)
+	}
+	if !reflect.DeepEqual(result.MissingLocales, []string{"de-DE"}) {
+		t.Fatalf("missing locales = %v, want [de-DE]", result.MissingLocales)
+	}
+}
+
+func TestBuildChangelog_FiltersLocales(t *testing.T) {
+	localizations := []asc.Resource[asc.AppStoreVersionLocalizationAttributes]{
+		{ID: "loc-fr", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "fr-FR", WhatsNew: "Corrections"}},
+		{ID: "loc-en", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "en-US", WhatsNew: "Bug fixes"}},
+	}
+
+	result := buildChangelog("ver-1", localizations, []string{"en-US", "ja"})
+
+	if len(result.Entries) != 1 || result.Entries[0].Locale != "en-US" {
+		t.Fatalf("expected only en-US entry, got %+v", result.Entries)
+	}
+	if !reflect.DeepEqual(result.MissingLocales, []string{"ja"}) {
+		t.Fatalf("missing locales = %v, want [ja]", result.MissingLocales)
+	}
+}
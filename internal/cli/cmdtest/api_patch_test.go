@@ -0,0 +1,122 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPatchPassthroughSendsBodyFromFile(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	bodyPath := filepath.Join(t.TempDir(), "body.json")
+	requestBody := `{"data":{"type":"apps","id":"app-1","attributes":{"contentRightsDeclaration":"DOES_NOT_USE_THIRD_PARTY_CONTENT"}}}`
+	if err := os.WriteFile(bodyPath, []byte(requestBody), 0o600); err != nil {
+		t.Fatalf("write body: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if req.Header.Get("Content-Type") != "application/json" {
+			t.Fatalf("expected JSON content type, got %q", req.Header.Get("Content-Type"))
+		}
+		body, _ := io.ReadAll(req.Body)
+		if string(body) != requestBody {
+			t.Fatalf("expected body %s, got %s", requestBody, body)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"contentRightsDeclaration":"DOES_NOT_USE_THIRD_PARTY_CONTENT"}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"patch", "/v1/apps/app-1", "--data", "@" + bodyPath, "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"contentRightsDeclaration":"DOES_NOT_USE_THIRD_PARTY_CONTENT"`) {
+		t.Fatalf("expected response body, got %q", stdout)
+	}
+}
+
+func TestPatchPassthroughValidatesBeforeSending(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing confirm",
+			args:    []string{"patch", "/v1/apps/app-1", "--data", `{"data":{}}`},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "missing data",
+			args:    []string{"patch", "/v1/apps/app-1", "--confirm"},
+			wantErr: "--data is required",
+		},
+		{
+			name:    "invalid JSON",
+			args:    []string{"patch", "/v1/apps/app-1", "--data", `{"data":`, "--confirm"},
+			wantErr: "--data must be valid JSON",
+		},
+		{
+			name:    "non-ASC host",
+			args:    []string{"patch", "https://example.com/v1/apps/app-1", "--data", `{"data":{}}`, "--confirm"},
+			wantErr: "URL must be an App Store Connect URL",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+				return nil, nil
+			})
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppsUpdatePrimaryLocaleUsesAppIDAndCanonicalLocale(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "app-env-1")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/apps/app-env-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		var payload struct {
+			Data struct {
+				ID         string         `json:"id"`
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if payload.Data.ID != "app-env-1" {
+			t.Fatalf("expected app id in body, got %q", payload.Data.ID)
+		}
+		if got := payload.Data.Attributes["primaryLocale"]; got != "en-US" {
+			t.Fatalf("expected canonical primaryLocale en-US, got %v", got)
+		}
+		if _, ok := payload.Data.Attributes["bundleId"]; ok {
+			t.Fatalf("expected only primaryLocale to be sent, got %v", payload.Data.Attributes)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"apps","id":"app-env-1","attributes":{"name":"Demo","primaryLocale":"en-US"}}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "update", "--primary-locale", "EN-us"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	if !strings.Contains(stdout, `"primaryLocale":"en-US"`) {
+		t.Fatalf("expected updated attributes in output, got %q", stdout)
+	}
+}
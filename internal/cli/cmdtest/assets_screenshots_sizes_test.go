@@ -616,3 +616,82 @@ func containsDimension(dimensions []asc.ScreenshotDimension, expected asc.Screen
 	}
 	return false
 }
+
+func TestAssetsScreenshotsUploadValidatesPositionalFilesBeforeNetwork(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	validPath := filepath.Join(dir, "valid-iphone69.png")
+	writePNG(t, validPath, 1320, 2868)
+	invalidPath := filepath.Join(dir, "invalid.png")
+	writePNG(t, invalidPath, 100, 100)
+
+	var calls int32
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, fmt.Errorf("unexpected network request: %s %s", req.Method, req.URL.Path)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"screenshots", "upload",
+			"--localization-id", "LOC_ID",
+			"--display-type", "APP_IPHONE_69",
+			validPath, invalidPath,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if runErr == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if !strings.Contains(runErr.Error(), "100x100") {
+		t.Fatalf("expected second file to be validated, got %q", runErr.Error())
+	}
+	if atomic.LoadInt32(&calls) != 0 {
+		t.Fatalf("expected no network calls, got %d", calls)
+	}
+}
+
+func TestAssetsScreenshotsUploadRejectsConflictingLocalizationAlias(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"screenshots", "upload",
+			"--version-localization", "LOC_1",
+			"--localization-id", "LOC_2",
+			"--device-type", "IPHONE_65",
+			"shot.png",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !strings.Contains(stderr, "--version-localization and --localization-id conflict") {
+		t.Fatalf("expected conflict error in stderr, got %q", stderr)
+	}
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp, got %v", runErr)
+	}
+}
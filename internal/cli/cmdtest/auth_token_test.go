@@ -0,0 +1,110 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestAuthTokenPrintsSignedJWTWithExpiry(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	before := time.Now()
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "token", "--ttl", "5m", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var payload struct {
+		Token     string `json:"token"`
+		ExpiresAt string `json:"expiresAt"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse JSON output: %v (%q)", err, stdout)
+	}
+
+	claims := jwt.RegisteredClaims{}
+	token, _, err := jwt.NewParser().ParseUnverified(payload.Token, &claims)
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	if token.Header["kid"] != "TEST_KEY" {
+		t.Fatalf("expected kid TEST_KEY, got %v", token.Header["kid"])
+	}
+	if claims.Issuer != "TEST_ISSUER" {
+		t.Fatalf("expected issuer TEST_ISSUER, got %q", claims.Issuer)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, payload.ExpiresAt)
+	if err != nil {
+		t.Fatalf("failed to parse expiresAt: %v", err)
+	}
+	if !expiresAt.Equal(claims.ExpiresAt.Time) {
+		t.Fatalf("expected expiresAt %v to match token exp %v", expiresAt, claims.ExpiresAt.Time)
+	}
+	if delta := expiresAt.Sub(before); delta < 4*time.Minute || delta > 6*time.Minute {
+		t.Fatalf("expected expiry about 5m from now, got %v", delta)
+	}
+}
+
+func TestAuthTokenTextOutputIsTokenOnly(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "token"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	token := strings.TrimSpace(stdout)
+	if strings.Count(token, ".") != 2 || strings.Contains(token, "\n") {
+		t.Fatalf("expected a single JWT, got %q", stdout)
+	}
+}
+
+func TestAuthTokenRejectsTTLOverMax(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "token", "--ttl", "30m"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--ttl must be between 1s and 20m0s") {
+		t.Fatalf("expected ttl error, got %q", stderr)
+	}
+}
@@ -0,0 +1,73 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBetaGroupsAddBuildsPostsBuildRelationships(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/betaGroups/group-1/relationships/builds" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var payload struct {
+			Data []struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(payload.Data) != 2 || payload.Data[0].ID != "build-1" || payload.Data[1].ID != "build-2" || payload.Data[0].Type != "builds" {
+			t.Fatalf("unexpected payload: %+v", payload.Data)
+		}
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Header:     http.Header{},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "beta-groups", "add-builds", "--group", "group-1", "--build", "build-1,build-2"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+	var result struct {
+		GroupID  string   `json:"groupId"`
+		BuildIDs []string `json:"buildIds"`
+		Action   string   `json:"action"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if result.GroupID != "group-1" || len(result.BuildIDs) != 2 || result.Action != "added" {
+		t.Fatalf("unexpected output: %+v", result)
+	}
+}
@@ -172,3 +172,30 @@ func TestBuildsListRejectsInvalidLimit(t *testing.T) {
 		t.Fatalf("expected limit range error, got %v", runErr)
 	}
 }
+
+func TestBuildsListRejectsInvalidSince(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"builds", "list",
+			"--app", "123456789",
+			"--since", "last-week",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "--since must be a date") {
+		t.Fatalf("expected --since error, got %q", stderr)
+	}
+}
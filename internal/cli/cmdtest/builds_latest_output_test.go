@@ -130,6 +130,7 @@ func TestBuildsLatestSelectsNewestAcrossPlatformPreReleaseVersions(t *testing.T)
 
 func TestBuildsLatestReturnsPreReleaseLookupFailure(t *testing.T) {
 	setupAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
 
 	originalTransport := http.DefaultTransport
@@ -289,8 +290,8 @@ func TestBuildsLatestOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"builds", "latest", "--app", "100000001", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"builds", "latest", "--app", "100000001", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with table",
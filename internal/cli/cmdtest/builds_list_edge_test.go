@@ -348,3 +348,94 @@ func TestBuildsListBuildNumberMapsToVersionFilter(t *testing.T) {
 		t.Fatalf("expected build id in output, got %q", stdout)
 	}
 }
+
+func TestBuildsListSortsNewestFirstByDefault(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var sorts []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/builds" {
+			t.Fatalf("expected path /v1/builds, got %s", req.URL.Path)
+		}
+		sorts = append(sorts, req.URL.Query().Get("sort"))
+		body := `{"data":[{"type":"builds","id":"build-1"}]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	for _, args := range [][]string{
+		{"builds", "list", "--app", "123456789"},
+		{"builds", "list", "--app", "123456789", "--sort", "uploadedDate"},
+	} {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+	}
+
+	if len(sorts) != 2 || sorts[0] != "-uploadedDate" || sorts[1] != "uploadedDate" {
+		t.Fatalf("expected default -uploadedDate then explicit uploadedDate, got %v", sorts)
+	}
+}
+
+func TestBuildsListSinceFiltersByUploadedDate(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/builds" {
+			t.Fatalf("expected path /v1/builds, got %s", req.URL.Path)
+		}
+		body := `{"data":[
+			{"type":"builds","id":"build-new","attributes":{"uploadedDate":"2024-03-01T00:00:00Z"}},
+			{"type":"builds","id":"build-old","attributes":{"uploadedDate":"2023-12-01T00:00:00Z"}}
+		]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "list", "--app", "123456789", "--since", "2024-01-01"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"build-new"`) {
+		t.Fatalf("expected build-new in output, got %q", stdout)
+	}
+	if strings.Contains(stdout, `"id":"build-old"`) {
+		t.Fatalf("expected build-old to be filtered out, got %q", stdout)
+	}
+}
@@ -41,9 +41,16 @@ func TestBuildsListResolvesAppByBundleID(t *testing.T) {
 				Header:     http.Header{"Content-Type": []string{"application/json"}},
 			}, nil
 		case 2:
-			if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/app-lookup/builds" {
+			if req.Method != http.MethodGet || req.URL.Path != "/v1/builds" {
 				t.Fatalf("unexpected second request: %s %s", req.Method, req.URL.String())
 			}
+			query := req.URL.Query()
+			if query.Get("filter[app]") != "app-lookup" {
+				t.Fatalf("expected filter[app]=app-lookup, got %q", query.Get("filter[app]"))
+			}
+			if query.Get("sort") != "-uploadedDate" {
+				t.Fatalf("expected default sort=-uploadedDate, got %q", query.Get("sort"))
+			}
 			body := `{"data":[{"type":"builds","id":"build-1"}]}`
 			return &http.Response{
 				StatusCode: http.StatusOK,
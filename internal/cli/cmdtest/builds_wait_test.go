@@ -205,3 +205,49 @@ func TestBuildsWaitFailOnInvalidReturnsError(t *testing.T) {
 		t.Fatalf("expected progress output on stderr, got %q", stderr)
 	}
 }
+
+func TestBuildsWaitBuildIDAliasTimesOutWhileProcessing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/builds/build-1" {
+			t.Fatalf("expected path /v1/builds/build-1, got %s", req.URL.Path)
+		}
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"PROCESSING","version":"42"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "wait", "--build-id", "build-1", "--poll-interval", "1ms", "--max-poll-interval", "5ms", "--timeout", "50ms"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil {
+		t.Fatal("expected timeout error")
+	}
+	if !strings.Contains(runErr.Error(), "timed out waiting for build build-1") {
+		t.Fatalf("expected timeout error, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout on timeout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "Waiting for build build-1... (PROCESSING") {
+		t.Fatalf("expected progress output on stderr, got %q", stderr)
+	}
+}
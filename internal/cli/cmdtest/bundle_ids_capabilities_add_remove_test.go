@@ -0,0 +1,308 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBundleIDCapabilitiesAddRemoveValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing bundle",
+			args:    []string{"bundle-ids", "capabilities", "add", "--capability", "PUSH_NOTIFICATIONS"},
+			wantErr: "--bundle is required",
+		},
+		{
+			name:    "missing capability",
+			args:    []string{"bundle-ids", "capabilities", "add", "--bundle", "bid1"},
+			wantErr: "--capability is required",
+		},
+		{
+			name:    "bundle and bundle-id conflict",
+			args:    []string{"bundle-ids", "capabilities", "enable", "--bundle", "bid1", "--bundle-id", "bid2", "--capability", "PUSH_NOTIFICATIONS"},
+			wantErr: "--bundle and --bundle-id conflict",
+		},
+		{
+			name:    "setting for another capability",
+			args:    []string{"bundle-ids", "capabilities", "add", "--bundle", "bid1", "--capability", "PUSH_NOTIFICATIONS", "--setting", "ICLOUD_VERSION=XCODE_6"},
+			wantErr: "--setting ICLOUD_VERSION is not supported for PUSH_NOTIFICATIONS",
+		},
+		{
+			name:    "settings and setting",
+			args:    []string{"bundle-ids", "capabilities", "add", "--bundle", "bid1", "--capability", "ICLOUD", "--settings", "[]", "--setting", "ICLOUD_VERSION=XCODE_6"},
+			wantErr: "--settings and --setting are mutually exclusive",
+		},
+		{
+			name:    "remove capability without bundle",
+			args:    []string{"bundle-ids", "capabilities", "remove", "--capability", "PUSH_NOTIFICATIONS", "--confirm"},
+			wantErr: "--bundle is required with --capability",
+		},
+		{
+			name:    "remove id with capability",
+			args:    []string{"bundle-ids", "capabilities", "remove", "--id", "cap1", "--capability", "PUSH_NOTIFICATIONS", "--confirm"},
+			wantErr: "--id cannot be combined with --bundle or --capability",
+		},
+		{
+			name:    "remove without confirm",
+			args:    []string{"bundle-ids", "capabilities", "remove", "--bundle", "bid1", "--capability", "PUSH_NOTIFICATIONS"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "disable without confirm",
+			args:    []string{"bundle-ids", "capabilities", "disable", "--bundle-id", "bid1", "--capability", "PUSH_NOTIFICATIONS"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestBundleIDCapabilitiesAddPostsCapabilityWithSetting(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/bundleIdCapabilities" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var payload struct {
+			Data struct {
+				Attributes struct {
+					CapabilityType string `json:"capabilityType"`
+					Settings       []struct {
+						Key     string `json:"key"`
+						Options []struct {
+							Key     string `json:"key"`
+							Enabled bool   `json:"enabled"`
+						} `json:"options"`
+					} `json:"settings"`
+				} `json:"attributes"`
+				Relationships struct {
+					BundleID struct {
+						Data struct {
+							ID string `json:"id"`
+						} `json:"data"`
+					} `json:"bundleId"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		attrs := payload.Data.Attributes
+		if attrs.CapabilityType != "ICLOUD" {
+			t.Fatalf("expected ICLOUD, got %q", attrs.CapabilityType)
+		}
+		if len(attrs.Settings) != 1 || attrs.Settings[0].Key != "ICLOUD_VERSION" ||
+			len(attrs.Settings[0].Options) != 1 || attrs.Settings[0].Options[0].Key != "XCODE_6" || !attrs.Settings[0].Options[0].Enabled {
+			t.Fatalf("unexpected settings: %+v", attrs.Settings)
+		}
+		if got := payload.Data.Relationships.BundleID.Data.ID; got != "bid1" {
+			t.Fatalf("expected bundle ID bid1, got %q", got)
+		}
+		return jsonResponse(http.StatusCreated, `{"data":{"type":"bundleIdCapabilities","id":"cap1","attributes":{"capabilityType":"ICLOUD"}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"bundle-ids", "capabilities", "add", "--bundle", "bid1", "--capability", "icloud", "--setting", "ICLOUD_VERSION=XCODE_6"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"cap1"`) {
+		t.Fatalf("expected created capability in output, got %q", stdout)
+	}
+}
+
+func TestBundleIDCapabilitiesRemoveByTypeDeletesMatchingCapability(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	deleted := ""
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bid1/bundleIdCapabilities":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"bundleIdCapabilities","id":"cap-iap","attributes":{"capabilityType":"IN_APP_PURCHASE"}},
+				{"type":"bundleIdCapabilities","id":"cap-push","attributes":{"capabilityType":"PUSH_NOTIFICATIONS"}}
+			],"links":{}}`)
+		case req.Method == http.MethodDelete && strings.HasPrefix(req.URL.Path, "/v1/bundleIdCapabilities/"):
+			deleted = strings.TrimPrefix(req.URL.Path, "/v1/bundleIdCapabilities/")
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"bundle-ids", "capabilities", "disable", "--bundle-id", "bid1", "--capability", "PUSH_NOTIFICATIONS", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if deleted != "cap-push" {
+		t.Fatalf("expected cap-push to be deleted, got %q", deleted)
+	}
+	if !strings.Contains(stdout, `"id":"cap-push"`) || !strings.Contains(stdout, `"deleted":true`) {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+}
+
+func TestBundleIDCapabilitiesRemoveByTypeReportsMissingCapability(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"bundle-ids", "capabilities", "remove", "--bundle", "bid1", "--capability", "WALLET", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "capability WALLET is not enabled for bundle ID bid1") {
+		t.Fatalf("expected not enabled error, got %v", runErr)
+	}
+}
+
+func TestBundleIDCapabilitiesAddSendsCapabilitiesWithoutSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		capability  string
+		wantWarning bool
+	}{
+		{
+			name:       "icloud without setting",
+			args:       []string{"bundle-ids", "capabilities", "add", "--bundle", "bid1", "--capability", "ICLOUD"},
+			capability: "ICLOUD",
+		},
+		{
+			name:        "unknown capability type",
+			args:        []string{"bundle-ids", "capabilities", "enable", "--bundle-id", "bid1", "--capability", "future_capability"},
+			capability:  "FUTURE_CAPABILITY",
+			wantWarning: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				if req.Method != http.MethodPost || req.URL.Path != "/v1/bundleIdCapabilities" {
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+				}
+				var payload struct {
+					Data struct {
+						Attributes map[string]json.RawMessage `json:"attributes"`
+					} `json:"data"`
+				}
+				if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if got := string(payload.Data.Attributes["capabilityType"]); got != `"`+test.capability+`"` {
+					t.Fatalf("expected capabilityType %s, got %s", test.capability, got)
+				}
+				if settings, ok := payload.Data.Attributes["settings"]; ok && string(settings) != "null" {
+					t.Fatalf("expected no settings, got %s", settings)
+				}
+				return jsonResponse(http.StatusCreated, `{"data":{"type":"bundleIdCapabilities","id":"cap1","attributes":{"capabilityType":"`+test.capability+`"}}}`)
+			})
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); err != nil {
+					t.Fatalf("run error: %v", err)
+				}
+			})
+
+			if !strings.Contains(stdout, `"id":"cap1"`) {
+				t.Fatalf("expected created capability in output, got %q", stdout)
+			}
+			if got := strings.Contains(stderr, "is not a known capability type"); got != test.wantWarning {
+				t.Fatalf("expected warning=%v, got stderr %q", test.wantWarning, stderr)
+			}
+		})
+	}
+}
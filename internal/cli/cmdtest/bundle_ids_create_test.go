@@ -0,0 +1,115 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBundleIDsCreateValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "single segment identifier",
+			args:    []string{"bundle-ids", "create", "--identifier", "example", "--name", "Example"},
+			wantErr: "--identifier must be reverse-DNS",
+		},
+		{
+			name:    "identifier with spaces",
+			args:    []string{"bundle-ids", "create", "--identifier", "com.example.my app", "--name", "Example"},
+			wantErr: "--identifier must be reverse-DNS",
+		},
+		{
+			name:    "empty segment",
+			args:    []string{"bundle-ids", "create", "--identifier", "com..example", "--name", "Example"},
+			wantErr: "--identifier must be reverse-DNS",
+		},
+		{
+			name:    "unsupported platform",
+			args:    []string{"bundle-ids", "create", "--identifier", "com.example.app", "--name", "Example", "--platform", "TV_OS"},
+			wantErr: "--platform must be one of: IOS, MAC_OS, UNIVERSAL",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestBundleIDsCreatePostsBundleID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/bundleIds" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var payload struct {
+			Data struct {
+				Type       string `json:"type"`
+				Attributes struct {
+					Name       string `json:"name"`
+					Identifier string `json:"identifier"`
+					Platform   string `json:"platform"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		attrs := payload.Data.Attributes
+		if payload.Data.Type != "bundleIds" || attrs.Identifier != "com.example.*" || attrs.Name != "Example" || attrs.Platform != "UNIVERSAL" {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		return jsonResponse(http.StatusCreated, `{"data":{"type":"bundleIds","id":"BUNDLE_1","attributes":{"name":"Example","identifier":"com.example.*","platform":"UNIVERSAL"}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"bundle-ids", "create", "--identifier", "com.example.*", "--name", "Example", "--platform", "universal", "--select", "data.id"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if strings.TrimSpace(stdout) != "BUNDLE_1" {
+		t.Fatalf("expected created bundle ID, got %q", stdout)
+	}
+}
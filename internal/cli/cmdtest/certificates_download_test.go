@@ -0,0 +1,147 @@
+package cmdtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setupCertificateDownloadTransport(t *testing.T, der []byte) {
+	t.Helper()
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	b64 := base64.StdEncoding.EncodeToString(der)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/certificates/cert-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":{"type":"certificates","id":"cert-1","attributes":{"name":"Apple Distribution","certificateType":"DISTRIBUTION","certificateContent":"` + b64 + `"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func runCertificatesDownload(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"certificates", "download"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestCertificatesDownloadWritesDER(t *testing.T) {
+	setupAuth(t)
+	der := []byte{0x30, 0x82, 0x01, 0x0a, 0x02}
+	setupCertificateDownloadTransport(t, der)
+
+	outPath := filepath.Join(t.TempDir(), "cert.cer")
+	stdout, _, err := runCertificatesDownload(t, "--id", "cert-1", "--output", outPath)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if !bytes.Equal(data, der) {
+		t.Fatalf("expected DER bytes to be written unchanged, got %v", data)
+	}
+	if !strings.Contains(stdout, `"format":"der"`) {
+		t.Fatalf("expected format in output, got %q", stdout)
+	}
+}
+
+func TestCertificatesDownloadConvertsToPEM(t *testing.T) {
+	setupAuth(t)
+	der := []byte{0x30, 0x82, 0x01, 0x0a, 0x02}
+	setupCertificateDownloadTransport(t, der)
+
+	outPath := filepath.Join(t.TempDir(), "cert.pem")
+	if _, _, err := runCertificatesDownload(t, "--id", "cert-1", "--output", outPath, "--format", "pem"); err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	block, rest := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" || len(bytes.TrimSpace(rest)) != 0 {
+		t.Fatalf("expected a single CERTIFICATE PEM block, got %q", string(data))
+	}
+	if !bytes.Equal(block.Bytes, der) {
+		t.Fatalf("expected PEM to wrap the DER bytes, got %v", block.Bytes)
+	}
+}
+
+func TestCertificatesDownloadRefusesExistingFileWithoutForce(t *testing.T) {
+	setupAuth(t)
+	setupCertificateDownloadTransport(t, []byte("new"))
+
+	outPath := filepath.Join(t.TempDir(), "cert.cer")
+	if err := os.WriteFile(outPath, []byte("old"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	if _, _, err := runCertificatesDownload(t, "--id", "cert-1", "--output", outPath); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected already exists error, got %v", err)
+	}
+	if _, _, err := runCertificatesDownload(t, "--id", "cert-1", "--output", outPath, "--force"); err != nil {
+		t.Fatalf("run with --force error: %v", err)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if string(data) != "new" {
+		t.Fatalf("expected overwritten content, got %q", string(data))
+	}
+}
+
+func TestCertificatesDownloadValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing id", args: []string{"--output", "cert.cer"}, wantErr: "--id is required"},
+		{name: "missing output", args: []string{"--id", "cert-1"}, wantErr: "--output is required"},
+		{name: "bad format", args: []string{"--id", "cert-1", "--output", "cert.cer", "--format", "p12"}, wantErr: "--format must be one of: der, pem"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, stderr, err := runCertificatesDownload(t, test.args...)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", err)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func changelogTransport(t *testing.T) http.RoundTripper {
+	t.Helper()
+
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/appStoreVersions/ver-1/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body := `{"data":[
+			{"type":"appStoreVersionLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR","whatsNew":"Corrections de bugs"}},
+			{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US","whatsNew":"Bug fixes"}},
+			{"type":"appStoreVersionLocalizations","id":"loc-de","attributes":{"locale":"de-DE"}}
+		],"links":{"next":""}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func TestChangelogRendersMarkdownGroupedByLocale(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = changelogTransport(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"changelog", "--version-id", "ver-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := "## en-US\n\nBug fixes\n\n## fr-FR\n\nCorrections de bugs\n"
+	if stdout != want {
+		t.Fatalf("unexpected markdown:\n got %q\nwant %q", stdout, want)
+	}
+	if !strings.Contains(stderr, "Missing What's New: de-DE") {
+		t.Fatalf("expected missing locale note on stderr, got %q", stderr)
+	}
+}
+
+func TestChangelogJSONFiltersLocales(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = changelogTransport(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"changelog", "--version-id", "ver-1", "--locale", "fr-FR", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		VersionID string `json:"versionId"`
+		Entries   []struct {
+			Locale   string `json:"locale"`
+			WhatsNew string `json:"whatsNew"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%s", err, stdout)
+	}
+	if payload.VersionID != "ver-1" || len(payload.Entries) != 1 || payload.Entries[0].Locale != "fr-FR" {
+		t.Fatalf("expected only fr-FR entry, got %+v", payload)
+	}
+}
+
+func TestChangelogRequiresVersionID(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"changelog"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "Error: --version-id is required") {
+		t.Fatalf("expected --version-id error, got %q", stderr)
+	}
+}
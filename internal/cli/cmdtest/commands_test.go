@@ -7,6 +7,7 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"flag"
@@ -14,6 +15,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -111,8 +113,11 @@ func TestVersionSubcommandPrintsVersion(t *testing.T) {
 		}
 	})
 
-	if stdout != "1.2.3\n" {
-		t.Fatalf("expected stdout to be version, got %q", stdout)
+	if !strings.HasPrefix(stdout, "1.2.3\n") {
+		t.Fatalf("expected stdout to start with version, got %q", stdout)
+	}
+	if !strings.Contains(stdout, "go: "+runtime.Version()+"\n") {
+		t.Fatalf("expected Go runtime version in stdout, got %q", stdout)
 	}
 	if stderr != "" {
 		t.Fatalf("expected empty stderr, got %q", stderr)
@@ -159,6 +164,83 @@ func TestCompletionZshPrintsScriptToStdout(t *testing.T) {
 	}
 }
 
+func TestHelpSearchFindsNestedCommands(t *testing.T) {
+	root := RootCommand("1.2.3")
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"help", "search", "phased", "release"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	var results []struct {
+		Command   string `json:"command"`
+		ShortHelp string `json:"shortHelp"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &results); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%s", err, stdout)
+	}
+	found := false
+	for _, result := range results {
+		if result.Command == "asc versions phased-release get" {
+			found = result.ShortHelp != ""
+		}
+	}
+	if !found {
+		t.Fatalf("expected asc versions phased-release get in results, got %+v", results)
+	}
+}
+
+func TestOpenPrintsSectionLink(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	root := RootCommand("1.2.3")
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"open", "--app", "123456789", "--section", "testflight"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if stdout != "https://appstoreconnect.apple.com/apps/123456789/testflight/ios\n" {
+		t.Fatalf("unexpected stdout %q", stdout)
+	}
+}
+
+func TestOpenRejectsUnknownSection(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"open", "--app", "123456789", "--section", "pricing"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, `--section: unknown section "pricing"`) {
+		t.Fatalf("expected unknown section error, got %q", stderr)
+	}
+}
+
 func TestCompletionInvalidShellErrorsToStderr(t *testing.T) {
 	root := RootCommand("1.2.3")
 	root.FlagSet.SetOutput(io.Discard)
@@ -478,6 +560,16 @@ func TestBuildsWaitValidationErrors(t *testing.T) {
 			args:    []string{"builds", "wait", "--build", "BUILD_123", "--timeout", "0s"},
 			wantErr: "--timeout must be greater than 0",
 		},
+		{
+			name:    "builds wait invalid max poll interval",
+			args:    []string{"builds", "wait", "--build", "BUILD_123", "--max-poll-interval", "0s"},
+			wantErr: "--max-poll-interval must be greater than 0",
+		},
+		{
+			name:    "builds wait conflicting build id alias",
+			args:    []string{"builds", "wait", "--build", "BUILD_123", "--build-id", "BUILD_456"},
+			wantErr: "--build and --build-id conflict; set only one",
+		},
 	}
 
 	for _, test := range tests {
@@ -879,6 +971,16 @@ func TestBetaManagementValidationErrors(t *testing.T) {
 			args:    []string{"testflight", "beta-groups", "remove-testers", "--group", "GROUP_ID", "--tester", "TESTER_ID"},
 			wantErr: "Error: --confirm is required",
 		},
+		{
+			name:    "beta-groups add-builds missing group",
+			args:    []string{"testflight", "beta-groups", "add-builds", "--build", "BUILD_ID"},
+			wantErr: "--group is required",
+		},
+		{
+			name:    "beta-groups add-builds missing build",
+			args:    []string{"testflight", "beta-groups", "add-builds", "--group", "GROUP_ID"},
+			wantErr: "--build is required",
+		},
 		{
 			name:    "beta-groups delete missing id",
 			args:    []string{"testflight", "beta-groups", "delete"},
@@ -2390,6 +2492,18 @@ func TestReviewSubmissionsValidationErrors(t *testing.T) {
 			wantErr:  "--id is required",
 			wantHelp: true,
 		},
+		{
+			name:     "review submissions-wait missing id",
+			args:     []string{"review", "submissions-wait"},
+			wantErr:  "--id is required",
+			wantHelp: true,
+		},
+		{
+			name:     "review submissions-wait invalid timeout",
+			args:     []string{"review", "submissions-wait", "--id", "SUBMISSION_ID", "--timeout", "0s"},
+			wantErr:  "--timeout must be greater than 0",
+			wantHelp: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -3926,6 +4040,9 @@ func TestAppInfosValidationErrors(t *testing.T) {
 }
 
 func TestAppsUpdateValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
 	tests := []struct {
 		name    string
 		args    []string
@@ -3946,6 +4063,16 @@ func TestAppsUpdateValidationErrors(t *testing.T) {
 			args:    []string{"apps", "update", "--id", "APP_ID", "--content-rights", "INVALID"},
 			wantErr: "Error: --content-rights must be DOES_NOT_USE_THIRD_PARTY_CONTENT or USES_THIRD_PARTY_CONTENT",
 		},
+		{
+			name:    "apps update invalid primary locale",
+			args:    []string{"apps", "update", "--app", "APP_ID", "--primary-locale", "xx-YY"},
+			wantErr: `Error: unsupported --primary-locale "xx-YY"`,
+		},
+		{
+			name:    "apps update conflicting id and app",
+			args:    []string{"apps", "update", "--id", "APP_1", "--app", "APP_2", "--primary-locale", "en-US"},
+			wantErr: "Error: --id and --app are mutually exclusive",
+		},
 	}
 
 	for _, test := range tests {
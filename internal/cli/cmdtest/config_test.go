@@ -0,0 +1,93 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func runConfigCommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestConfigSetGetListRoundTrip(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+	t.Setenv("ASC_APP_ID", "")
+
+	if _, stderr, err := runConfigCommand(t, "config", "set", "app", "123456789"); err != nil {
+		t.Fatalf("config set error: %v (%s)", err, stderr)
+	}
+
+	stdout, _, err := runConfigCommand(t, "config", "get", "app")
+	if err != nil {
+		t.Fatalf("config get error: %v", err)
+	}
+	if strings.TrimSpace(stdout) != "123456789" {
+		t.Fatalf("expected stored app ID, got %q", stdout)
+	}
+
+	stdout, _, err = runConfigCommand(t, "config", "list", "--output", "json")
+	if err != nil {
+		t.Fatalf("config list error: %v", err)
+	}
+	if !strings.Contains(stdout, `"app_id":"123456789"`) {
+		t.Fatalf("expected app_id in list output, got %q", stdout)
+	}
+}
+
+func TestConfigAppIDIsLowestPrecedence(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+
+	if _, stderr, err := runConfigCommand(t, "config", "set", "app_id", "from-config"); err != nil {
+		t.Fatalf("config set error: %v (%s)", err, stderr)
+	}
+
+	t.Setenv("ASC_APP_ID", "")
+	if err := os.Unsetenv("ASC_APP_ID"); err != nil {
+		t.Fatalf("unset ASC_APP_ID: %v", err)
+	}
+	if got := shared.ResolveAppID(""); got != "from-config" {
+		t.Fatalf("expected config fallback, got %q", got)
+	}
+
+	t.Setenv("ASC_APP_ID", "from-env")
+	if got := shared.ResolveAppID("from-flag"); got != "from-flag" {
+		t.Fatalf("expected flag to win, got %q", got)
+	}
+	if got := shared.ResolveAppID(""); got != "from-env" {
+		t.Fatalf("expected env to beat config, got %q", got)
+	}
+}
+
+func TestConfigRejectsUnknownKey(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+
+	_, stderr, err := runConfigCommand(t, "config", "set", "key_id", "ABC")
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	if !strings.Contains(stderr, `unknown config key "key_id"`) {
+		t.Fatalf("expected unknown key error, got %q", stderr)
+	}
+}
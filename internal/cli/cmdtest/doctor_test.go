@@ -3,39 +3,167 @@ package cmdtest
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"net/http"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
-func TestRootDoctorCommandJSON(t *testing.T) {
-	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
-	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+type rootDoctorReport struct {
+	Sections []struct {
+		Title  string `json:"title"`
+		Checks []struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"checks"`
+	} `json:"sections"`
+	Summary struct {
+		Errors int `json:"errors"`
+	} `json:"summary"`
+}
+
+func (r rootDoctorReport) setupChecks() map[string]string {
+	statuses := map[string]string{}
+	for _, section := range r.Sections {
+		if section.Title != "Setup" {
+			continue
+		}
+		for _, check := range section.Checks {
+			statuses[check.Message] = check.Status
+		}
+	}
+	return statuses
+}
+
+// stubDoctorTransport answers the unauthenticated reachability probe with a
+// 401 dated serverTime and the authenticated apps request with appsStatus.
+func stubDoctorTransport(t *testing.T, serverTime time.Time, appsStatus int) {
+	t.Helper()
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
 
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{
+			"Content-Type": []string{"application/json"},
+			"Date":         []string{serverTime.UTC().Format(http.TimeFormat)},
+		}
+		switch req.URL.Path {
+		case "/v1":
+			return &http.Response{StatusCode: http.StatusUnauthorized, Header: header, Body: io.NopCloser(strings.NewReader(`{"errors":[{"status":"401","code":"NOT_AUTHORIZED"}]}`))}, nil
+		case "/v1/apps":
+			if appsStatus != http.StatusOK {
+				return &http.Response{StatusCode: appsStatus, Header: header, Body: io.NopCloser(strings.NewReader(`{"errors":[{"status":"401","code":"NOT_AUTHORIZED","title":"Authentication credentials are missing or invalid."}]}`))}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(`{"data":[],"links":{}}`))}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+}
+
+func runRootDoctorJSON(t *testing.T) (rootDoctorReport, error) {
+	t.Helper()
 	root := RootCommand("1.2.3")
 	root.FlagSet.SetOutput(io.Discard)
 
-	stdout, stderr := captureOutput(t, func() {
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
 		if err := root.Parse([]string{"doctor", "--output", "json"}); err != nil {
 			t.Fatalf("parse error: %v", err)
 		}
-		if err := root.Run(context.Background()); err != nil {
-			t.Fatalf("run error: %v", err)
-		}
+		runErr = root.Run(context.Background())
 	})
 
-	if stderr != "" {
-		t.Fatalf("expected empty stderr, got %q", stderr)
+	var report rootDoctorReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%s", err, stdout)
 	}
+	return report, runErr
+}
 
-	var payload struct {
-		Sections any `json:"sections"`
-		Summary  any `json:"summary"`
+func TestRootDoctorCommandJSON(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_PRIVATE_KEY", "")
+	t.Setenv("ASC_PRIVATE_KEY_B64", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	stubDoctorTransport(t, time.Now(), http.StatusOK)
+
+	report, err := runRootDoctorJSON(t)
+	if _, ok := errors.AsType[shared.ReportedError](err); !ok {
+		t.Fatalf("expected reported error for missing credentials, got %v", err)
 	}
-	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
-		t.Fatalf("unmarshal output: %v\nstdout=%s", err, stdout)
+	if len(report.Sections) == 0 || report.Summary.Errors == 0 {
+		t.Fatalf("expected sections and a failing summary, got %#v", report)
+	}
+
+	found := false
+	for message, status := range report.setupChecks() {
+		if strings.HasPrefix(message, "Credentials could not be loaded") && status == "fail" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected failed credentials check, got %#v", report.setupChecks())
+	}
+}
+
+func TestRootDoctorPassesWithWorkingSetup(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	stubDoctorTransport(t, time.Now(), http.StatusOK)
+
+	report, _ := runRootDoctorJSON(t)
+	checks := report.setupChecks()
+	if checks["Reached https://api.appstoreconnect.apple.com (HTTP 401)"] != "ok" {
+		t.Fatalf("expected reachability check to pass, got %#v", checks)
+	}
+	if checks["System clock matches Apple's servers"] != "ok" {
+		t.Fatalf("expected clock check to pass, got %#v", checks)
+	}
+	authenticated := false
+	for message, status := range checks {
+		if strings.HasPrefix(message, "Authenticated with key") && status == "ok" {
+			authenticated = true
+		}
+	}
+	if !authenticated {
+		t.Fatalf("expected credentials check to pass, got %#v", checks)
+	}
+}
+
+func TestRootDoctorFailsOnClockSkewAndRejectedKey(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_MAX_RETRIES", "0")
+	stubDoctorTransport(t, time.Now().Add(-10*time.Minute), http.StatusUnauthorized)
+
+	report, err := runRootDoctorJSON(t)
+	if _, ok := errors.AsType[shared.ReportedError](err); !ok {
+		t.Fatalf("expected reported error, got %v", err)
+	}
+
+	var skewed, rejected bool
+	for message, status := range report.setupChecks() {
+		if strings.HasPrefix(message, "System clock is off by 10m") && status == "fail" {
+			skewed = true
+		}
+		if strings.HasPrefix(message, "App Store Connect rejected key") && status == "fail" {
+			rejected = true
+		}
 	}
-	if payload.Sections == nil || payload.Summary == nil {
-		t.Fatalf("expected sections and summary in output, got %#v", payload)
+	if !skewed || !rejected {
+		t.Fatalf("expected clock skew and rejected key failures, got %#v", report.setupChecks())
 	}
 }
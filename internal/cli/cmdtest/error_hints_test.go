@@ -1,8 +1,12 @@
 package cmdtest
 
 import (
+	"io"
+	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
 )
@@ -34,3 +38,37 @@ func TestRunPrintsHintForMissingAuth(t *testing.T) {
 		t.Fatalf("expected auth hint, got %q", stderr)
 	}
 }
+
+func TestRunReportsClockSkewOnUnauthorized(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Date":         []string{time.Now().Add(-3 * time.Minute).UTC().Format(http.TimeFormat)},
+			},
+			Body: io.NopCloser(strings.NewReader(`{"errors":[{"status":"401","code":"NOT_AUTHORIZED","title":"Authentication credentials are missing or invalid."}]}`)),
+		}, nil
+	})
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"apps", "list"}, "1.2.3")
+		if code != cmd.ExitHTTPUnauthorized {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitHTTPUnauthorized, code)
+		}
+	})
+
+	if !strings.Contains(stderr, "your system clock is off by") || !strings.Contains(stderr, "ahead of App Store Connect") {
+		t.Fatalf("expected clock skew error, got %q", stderr)
+	}
+	if !strings.Contains(stderr, "Hint:") || !strings.Contains(stderr, "automatic date and time") {
+		t.Fatalf("expected clock hint, got %q", stderr)
+	}
+}
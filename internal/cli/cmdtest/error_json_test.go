@@ -0,0 +1,149 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+type jsonErrorEnvelope struct {
+	Error struct {
+		Code      string `json:"code"`
+		Message   string `json:"message"`
+		Detail    string `json:"detail"`
+		Status    int    `json:"status"`
+		RequestID string `json:"requestId"`
+		Hint      string `json:"hint"`
+	} `json:"error"`
+}
+
+func stubNotFoundApp(t *testing.T) {
+	t.Helper()
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		resp, err := jsonResponse(http.StatusNotFound, `{"errors":[{"id":"error-9f3d","status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'apps' with id 'app-1'"}]}`)
+		if resp != nil {
+			resp.Header.Set("X-Request-Id", "b2c1-77ae")
+		}
+		return resp, err
+	})
+}
+
+func TestRunOutputJSONWritesStructuredAPIError(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	stubNotFoundApp(t)
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"apps", "get", "--id", "app-1", "--output", "json"}, "1.2.3")
+		if code != cmd.ExitNotFound {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitNotFound, code)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	var envelope jsonErrorEnvelope
+	if err := json.Unmarshal([]byte(stderr), &envelope); err != nil {
+		t.Fatalf("expected JSON error on stderr, got %q: %v", stderr, err)
+	}
+	if envelope.Error.Code != "NOT_FOUND" {
+		t.Fatalf("expected code NOT_FOUND, got %q", envelope.Error.Code)
+	}
+	if envelope.Error.RequestID != "b2c1-77ae" {
+		t.Fatalf("expected requestId b2c1-77ae, got %q", envelope.Error.RequestID)
+	}
+	if envelope.Error.Status != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", envelope.Error.Status)
+	}
+	if !strings.Contains(envelope.Error.Detail, "no resource of type 'apps'") {
+		t.Fatalf("expected API detail, got %q", envelope.Error.Detail)
+	}
+	if !strings.Contains(envelope.Error.Message, "The specified resource does not exist") {
+		t.Fatalf("expected API message, got %q", envelope.Error.Message)
+	}
+}
+
+func TestRunWithoutExplicitOutputKeepsTextErrors(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	stubNotFoundApp(t)
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"apps", "get", "--id", "app-1"}, "1.2.3")
+		if code != cmd.ExitNotFound {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitNotFound, code)
+		}
+	})
+
+	if !strings.HasPrefix(stderr, "Error: ") {
+		t.Fatalf("expected plain text error, got %q", stderr)
+	}
+}
+
+func TestRunOutputJSONWritesStructuredMissingAuthError(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_PRIVATE_KEY", "")
+	t.Setenv("ASC_PRIVATE_KEY_B64", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"apps", "get", "--id", "app-1", "--output=json"}, "1.2.3")
+		if code != cmd.ExitAuth {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitAuth, code)
+		}
+	})
+
+	var envelope jsonErrorEnvelope
+	if err := json.Unmarshal([]byte(stderr), &envelope); err != nil {
+		t.Fatalf("expected JSON error on stderr, got %q: %v", stderr, err)
+	}
+	if envelope.Error.Code != "MISSING_AUTH" {
+		t.Fatalf("expected code MISSING_AUTH, got %q", envelope.Error.Code)
+	}
+	if !strings.Contains(envelope.Error.Hint, "asc auth login") {
+		t.Fatalf("expected auth hint, got %q", envelope.Error.Hint)
+	}
+}
+
+func TestRunOutputJSONWritesStructuredUsageError(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--max-items", "-1", "apps", "list", "--output", "json"}, "1.2.3")
+		if code != cmd.ExitUsage {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitUsage, code)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	var envelope jsonErrorEnvelope
+	if err := json.Unmarshal([]byte(stderr), &envelope); err != nil {
+		t.Fatalf("expected JSON error on stderr, got %q: %v", stderr, err)
+	}
+	if envelope.Error.Code != "USAGE" {
+		t.Fatalf("expected code USAGE, got %q", envelope.Error.Code)
+	}
+	if envelope.Error.Message != "--max-items must be >= 0" {
+		t.Fatalf("expected usage message, got %q", envelope.Error.Message)
+	}
+}
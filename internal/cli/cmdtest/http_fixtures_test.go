@@ -0,0 +1,69 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runCertificatesListForFixtures(t *testing.T) (string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "list"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, runErr
+}
+
+func TestHTTPRecordThenReplayCertificatesList(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	fixturesDir := t.TempDir()
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"certificates","id":"cert-recorded-1"}],"links":{}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	t.Setenv("ASC_HTTP_REPLAY", "")
+	t.Setenv("ASC_HTTP_RECORD", fixturesDir)
+	recorded, err := runCertificatesListForFixtures(t)
+	if err != nil {
+		t.Fatalf("record run error: %v", err)
+	}
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("network access during replay")
+	})
+	t.Setenv("ASC_HTTP_RECORD", "")
+	t.Setenv("ASC_HTTP_REPLAY", fixturesDir)
+	replayed, err := runCertificatesListForFixtures(t)
+	if err != nil {
+		t.Fatalf("replay run error: %v", err)
+	}
+
+	if !strings.Contains(replayed, "cert-recorded-1") {
+		t.Fatalf("expected replayed output to include recorded certificate, got %q", replayed)
+	}
+	if replayed != recorded {
+		t.Fatalf("expected replayed output to match recorded output\nrecorded: %q\nreplayed: %q", recorded, replayed)
+	}
+}
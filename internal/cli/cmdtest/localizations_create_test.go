@@ -0,0 +1,184 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLocalizationsCreateRejectsUnsupportedLocale(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"localizations", "create", "--version", "ver-1", "--locale", "xx-YY"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, `unsupported locale "xx-YY"`) || !strings.Contains(stderr, "fr-FR") {
+		t.Fatalf("expected unsupported locale error listing supported locales, got: %q", stderr)
+	}
+}
+
+func TestLocalizationsCreateVersionRequiresVersion(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"localizations", "create", "--locale", "fr-FR"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--version is required") {
+		t.Fatalf("expected version required error, got: %q", stderr)
+	}
+}
+
+func TestLocalizationsCreateRejectsOtherTypeFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "app-info flag on version localization",
+			args: []string{"localizations", "create", "--version", "ver-1", "--locale", "fr-FR", "--description", "Desc", "--name", "Mon App"},
+			want: "--name only apply to --type app-info localizations",
+		},
+		{
+			name: "version flags on app-info localization",
+			args: []string{"localizations", "create", "--app", "app-1", "--type", "app-info", "--locale", "fr-FR", "--name", "Mon App", "--keywords", "one", "--whats-new", "New"},
+			want: "--keywords, --whats-new only apply to --type version localizations",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if !strings.Contains(stderr, test.want) {
+				t.Fatalf("expected %q, got %q", test.want, stderr)
+			}
+		})
+	}
+}
+
+func TestLocalizationsCreateVersionLocalization(t *testing.T) {
+	setupLocUpdateAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = originalTransport })
+
+	var postBody string
+	http.DefaultTransport = locUpdateRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/ver-1/appStoreVersionLocalizations":
+			return locUpdateJSONResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}],"links":{}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersionLocalizations":
+			body, _ := io.ReadAll(req.Body)
+			postBody = string(body)
+			return locUpdateJSONResponse(http.StatusCreated, `{"data":{"type":"appStoreVersionLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR","description":"Description"}}}`)
+		default:
+			return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"localizations", "create",
+			"--version-id", "ver-1",
+			"--locale", "fr-fr",
+			"--description", "Description",
+			"--keywords", "un,deux",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(postBody, `"locale":"fr-FR"`) || !strings.Contains(postBody, `"keywords":"un,deux"`) {
+		t.Fatalf("expected canonical locale and keywords in POST body, got: %s", postBody)
+	}
+
+	var result struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse JSON output: %v (stdout=%q)", err, stdout)
+	}
+	if result.Data.ID != "loc-fr" {
+		t.Fatalf("expected created ID loc-fr, got %q", result.Data.ID)
+	}
+}
+
+func TestLocalizationsCreateRejectsExistingLocale(t *testing.T) {
+	setupLocUpdateAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = originalTransport })
+
+	http.DefaultTransport = locUpdateRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/appInfos":
+			return locUpdateJSONResponse(http.StatusOK, `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/appinfo-1/appInfoLocalizations":
+			return locUpdateJSONResponse(http.StatusOK, `{"data":[{"type":"appInfoLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR"}}],"links":{}}`)
+		default:
+			return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"localizations", "create",
+			"--type", "app-info",
+			"--app", "app-1",
+			"--locale", "fr-FR",
+			"--name", "Mon App",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), `localization for locale "fr-FR" already exists (id loc-fr)`) {
+		t.Fatalf("expected existing locale error, got %v", runErr)
+	}
+}
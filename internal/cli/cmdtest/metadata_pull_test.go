@@ -30,7 +30,17 @@ func TestMetadataPullValidationErrors(t *testing.T) {
 		{
 			name:    "missing version",
 			args:    []string{"metadata", "pull", "--app", "app-1", "--dir", "./metadata"},
-			wantErr: "Error: --version is required",
+			wantErr: "Error: --version or --version-id is required",
+		},
+		{
+			name:    "version and version id",
+			args:    []string{"metadata", "pull", "--app", "app-1", "--version", "1.2.3", "--version-id", "version-1", "--dir", "./metadata"},
+			wantErr: "Error: --version and --version-id are mutually exclusive",
+		},
+		{
+			name:    "platform with version id",
+			args:    []string{"metadata", "pull", "--app", "app-1", "--version-id", "version-1", "--platform", "IOS", "--dir", "./metadata"},
+			wantErr: "Error: --platform cannot be used with --version-id",
 		},
 		{
 			name:    "missing dir",
@@ -623,3 +633,73 @@ func TestMetadataPullSupportsTableAndMarkdownOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataPullResolvesVersionStringFromVersionID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	outputDir := filepath.Join(t.TempDir(), "metadata")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "/v1/appStoreVersions/version-1":
+			body = `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"2.0.0","platform":"IOS"}}}`
+		case "/v1/appInfos/appinfo-1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"appinfo-loc-1","attributes":{"locale":"en-US","name":"App Name"}}],"links":{"next":""}}`
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"version-loc-1","attributes":{"locale":"en-US","description":"English description"}}],"links":{"next":""}}`
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+			return nil, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "pull",
+			"--app", "app-1",
+			"--version-id", "version-1",
+			"--dir", outputDir,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "version", "2.0.0", "en-US.json")); err != nil {
+		t.Fatalf("expected version file under resolved version string: %v", err)
+	}
+
+	var payload struct {
+		Version   string `json:"version"`
+		VersionID string `json:"versionId"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if payload.Version != "2.0.0" || payload.VersionID != "version-1" {
+		t.Fatalf("expected version 2.0.0 (version-1), got %+v", payload)
+	}
+}
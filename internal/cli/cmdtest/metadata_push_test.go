@@ -1313,3 +1313,133 @@ func TestMetadataPushSupportsTableAndMarkdownOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataPushDryRunScopesPlanToLocalesWithVersionID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "app-info"), 0o755); err != nil {
+		t.Fatalf("mkdir app-info: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "version", "1.2.3"), 0o755); err != nil {
+		t.Fatalf("mkdir version: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app-info", "en-US.json"), []byte(`{"name":"App Name","subtitle":"Local subtitle"}`), 0o644); err != nil {
+		t.Fatalf("write app-info file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "version", "1.2.3", "en-US.json"), []byte(`{"description":"Local description"}`), 0o644); err != nil {
+		t.Fatalf("write version en-US file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "version", "1.2.3", "ja.json"), []byte(`{"description":"日本語説明"}`), 0o644); err != nil {
+		t.Fatalf("write version ja file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected dry-run to use GET only, got %s %s", req.Method, req.URL.Path)
+		}
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "/v1/appStoreVersions/version-1":
+			body = `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"}}}`
+		case "/v1/appInfos/appinfo-1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"loc-app-1","attributes":{"locale":"en-US","name":"App Name","subtitle":"Remote subtitle"}}],"links":{"next":""}}`
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"loc-ver-1","attributes":{"locale":"en-US","description":"Remote description"}}],"links":{"next":""}}`
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+			return nil, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "push",
+			"--app", "app-1",
+			"--version-id", "version-1",
+			"--dir", dir,
+			"--locales", "ja",
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var payload struct {
+		Locales []string `json:"locales"`
+		Adds    []struct {
+			Locale string `json:"locale"`
+		} `json:"adds"`
+		Updates []struct {
+			Key string `json:"key"`
+		} `json:"updates"`
+		Deletes []struct {
+			Key string `json:"key"`
+		} `json:"deletes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+
+	if len(payload.Locales) != 1 || payload.Locales[0] != "ja" {
+		t.Fatalf("expected locales [ja], got %v", payload.Locales)
+	}
+	if len(payload.Adds) != 1 || payload.Adds[0].Locale != "ja" {
+		t.Fatalf("expected a single ja add, got %+v", payload.Adds)
+	}
+	if len(payload.Updates) != 0 || len(payload.Deletes) != 0 {
+		t.Fatalf("expected en-US changes to be out of scope, got updates=%+v deletes=%+v", payload.Updates, payload.Deletes)
+	}
+}
+
+func TestMetadataPushRejectsUnsupportedLocaleFilter(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "push",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", t.TempDir(),
+			"--locales", "en-US,xx-YY",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if !strings.Contains(stderr, `--locales: unsupported locale "xx-YY"`) {
+		t.Fatalf("expected unsupported locale error, got %q", stderr)
+	}
+}
@@ -0,0 +1,141 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func setupCertificatesTwoPageTransport(t *testing.T) {
+	t.Helper()
+
+	const secondURL = "https://api.appstoreconnect.apple.com/v1/certificates?cursor=BQ&limit=200"
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":[{"type":"certificates","id":"cert-count-1"},{"type":"certificates","id":"cert-count-2"}],"links":{"next":"` + secondURL + `"}}`
+		if req.URL.String() == secondURL {
+			body = `{"data":[{"type":"certificates","id":"cert-count-3"}],"links":{}}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func runCountCommand(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, runErr
+}
+
+func TestCertificatesListCountSummaryJSON(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setupCertificatesTwoPageTransport(t)
+
+	stdout, err := runCountCommand(t, "certificates", "list", "--paginate", "--count")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(stdout, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected items line plus summary line, got %q", stdout)
+	}
+	if !strings.Contains(lines[0], `"id":"cert-count-3"`) {
+		t.Fatalf("expected items before summary, got %q", lines[0])
+	}
+	if lines[1] != `{"count":3,"pages":2}` {
+		t.Fatalf("expected count summary, got %q", lines[1])
+	}
+}
+
+func TestCertificatesListCountSummaryTableFooter(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setupCertificatesTwoPageTransport(t)
+
+	stdout, err := runCountCommand(t, "certificates", "list", "--count", "--output", "table")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.HasSuffix(stdout, "\ncount: 2, pages: 1\n") {
+		t.Fatalf("expected table footer, got %q", stdout)
+	}
+}
+
+func TestCertificatesListWithoutCountIsUnchanged(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setupCertificatesTwoPageTransport(t)
+
+	stdout, err := runCountCommand(t, "certificates", "list")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if strings.Contains(stdout, `"count"`) || strings.Count(strings.TrimRight(stdout, "\n"), "\n") != 0 {
+		t.Fatalf("expected a single JSON document without summary, got %q", stdout)
+	}
+}
+
+func TestListOutputFlagsRejectedBeforeRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"count with yaml", []string{"certificates", "list", "--count", "--output", "yaml"}, "--count is only valid with json, table, or markdown output"},
+		{"count on get", []string{"certificates", "get", "--id", "cert-1", "--count"}, "--count is only valid for list commands"},
+		{"filter on get", []string{"certificates", "get", "--id", "cert-1", "--filter", "id=cert-1"}, "--filter is only valid for list commands"},
+		{"sort-by on get", []string{"certificates", "get", "--id", "cert-1", "--sort-by", "id"}, "--sort-by is only valid for list commands"},
+		{"sort-desc without sort-by", []string{"certificates", "list", "--sort-desc"}, "--sort-desc requires --sort-by"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+				return nil, nil
+			})
+
+			stdout, stderr := captureOutput(t, func() {
+				if code := cmd.Run(test.args, "1.2.3"); code != cmd.ExitUsage {
+					t.Fatalf("expected exit code %d, got %d", cmd.ExitUsage, code)
+				}
+			})
+			if !strings.Contains(stderr, "Error: "+test.want) {
+				t.Fatalf("expected %q, got %q", test.want, stderr)
+			}
+			if stdout != "" {
+				t.Fatalf("expected no output, got %q", stdout)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package cmdtest
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setupCertificatesFilterTransport(t *testing.T) {
+	t.Helper()
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":[` +
+			`{"type":"certificates","id":"cert-dev","attributes":{"name":"Apple Development: Jane","certificateType":"DEVELOPMENT"}},` +
+			`{"type":"certificates","id":"cert-dist","attributes":{"name":"Apple Distribution: Team","certificateType":"DISTRIBUTION"}},` +
+			`{"type":"certificates","id":"cert-dev-2","attributes":{"name":"Apple Development: Sam","certificateType":"DEVELOPMENT"}}` +
+			`],"links":{}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func TestCertificatesListFilterAppliesAllPredicates(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setupCertificatesFilterTransport(t)
+
+	stdout, err := runCountCommand(t, "certificates", "list",
+		"--filter", "attributes.certificateType=DEVELOPMENT",
+		"--filter", "attributes.name!=Apple Development: Sam",
+		"--count",
+	)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	if !strings.Contains(stdout, `"id":"cert-dev"`) {
+		t.Fatalf("expected matching certificate, got %q", stdout)
+	}
+	if strings.Contains(stdout, `"id":"cert-dist"`) || strings.Contains(stdout, `"id":"cert-dev-2"`) {
+		t.Fatalf("expected filtered certificates to be dropped, got %q", stdout)
+	}
+	if !strings.HasSuffix(stdout, "{\"count\":1,\"pages\":1}\n") {
+		t.Fatalf("expected count to reflect filtered items, got %q", stdout)
+	}
+}
+
+func TestCertificatesListFilterContainsTable(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	setupCertificatesFilterTransport(t)
+
+	stdout, err := runCountCommand(t, "certificates", "list", "--filter", "attributes.name~=Distribution", "--output", "table")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, "cert-dist") || strings.Contains(stdout, "cert-dev") {
+		t.Fatalf("expected only the distribution certificate row, got %q", stdout)
+	}
+}
@@ -0,0 +1,246 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppsListOutputsYAML(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo","bundleId":"com.example.demo","sku":"DEMO"}}],"links":{"next":""}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "list", "--output", "yaml", "--pretty"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	for _, want := range []string{"data:\n", "  - type: apps\n", "    id: app-1\n", "      bundleId: com.example.demo\n"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in YAML output, got:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestAppsListOutputsCSV(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo, \"Pro\"","bundleId":"com.example.demo","sku":"DEMO"}}],"links":{"next":""}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "list", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	want := "ID,Name,Bundle ID,SKU\napp-1,\"Demo, \"\"Pro\"\"\",com.example.demo,DEMO\n"
+	if stdout != want {
+		t.Fatalf("expected %q, got %q", want, stdout)
+	}
+}
+
+func TestAppsListSelectExtractsField(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo","bundleId":"com.example.demo","sku":"DEMO"}}],"links":{"next":""}}`)
+	})
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStdout string
+		wantErr    string
+	}{
+		{name: "string field", path: "data.0.attributes.bundleId", wantStdout: "com.example.demo\n"},
+		{name: "object", path: "data.0.attributes", wantStdout: `{"name":"Demo","bundleId":"com.example.demo","sku":"DEMO"}` + "\n"},
+		{name: "missing field", path: "data.0.attributes.version", wantErr: `--select "data.0.attributes.version": no field "version"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse([]string{"apps", "list", "--select", test.path}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantErr != "" {
+				if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+				}
+				return
+			}
+			if runErr != nil {
+				t.Fatalf("run error: %v", runErr)
+			}
+			if stdout != test.wantStdout {
+				t.Fatalf("expected %q, got %q", test.wantStdout, stdout)
+			}
+		})
+	}
+}
+
+func TestAppsListColumnsSelectsCSVColumns(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo","bundleId":"com.example.demo","sku":"DEMO"}}],"links":{"next":""}}`)
+	})
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantStdout string
+		wantErr    string
+	}{
+		{name: "selected columns", args: []string{"--output", "csv", "--columns", "sku,id"}, wantStdout: "SKU,ID\nDEMO,app-1\n"},
+		{name: "unknown column", args: []string{"--output", "table", "--columns", "id,version"}, wantErr: `--columns: unknown column "version" (available: id, name, bundle-id, sku)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse(append([]string{"apps", "list"}, test.args...)); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantErr != "" {
+				if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+				}
+				return
+			}
+			if runErr != nil {
+				t.Fatalf("run error: %v", runErr)
+			}
+			if stdout != test.wantStdout {
+				t.Fatalf("expected %q, got %q", test.wantStdout, stdout)
+			}
+		})
+	}
+}
+
+func TestAppsListColumnsRejectedBeforeRequest(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return jsonResponse(http.StatusOK, `{"data":[],"links":{"next":""}}`)
+	})
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantStderr string
+	}{
+		{name: "unknown column", args: []string{"--paginate", "--output", "table", "--columns", "id,bogus"}, wantStderr: `Error: --columns: unknown column "bogus"`},
+		{name: "json output", args: []string{"--output", "json", "--columns", "id"}, wantStderr: "Error: --columns is only valid with table or csv output"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests = 0
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(append([]string{"apps", "list"}, test.args...)); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if requests != 0 {
+				t.Fatalf("expected no requests, got %d", requests)
+			}
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantStderr) {
+				t.Fatalf("expected stderr to contain %q, got %q", test.wantStderr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func runRawAppsList(t *testing.T, args []string, bodies map[string]string) (string, string, error) {
+	t.Helper()
+
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body, ok := bodies[req.URL.Query().Get("cursor")]
+		if !ok {
+			t.Fatalf("unexpected cursor in %s", req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestAppsListRawPrintsResponseBodyUnchanged(t *testing.T) {
+	body := `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo","futureField":{"nested":true}}}],"links":{"self":"https://api.appstoreconnect.apple.com/v1/apps"}}`
+
+	stdout, stderr, err := runRawAppsList(t, []string{"apps", "list", "--raw"}, map[string]string{"": body})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if stdout != body+"\n" {
+		t.Fatalf("expected raw body, got %q", stdout)
+	}
+}
+
+func TestAppsListRawPaginatePrintsEachPageAsNDJSON(t *testing.T) {
+	first := `{"data":[{"type":"apps","id":"app-1"}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=BQ&limit=200"}}`
+	second := "{\n  \"data\": [{\"type\": \"apps\", \"id\": \"app-2\"}],\n  \"links\": {}\n}"
+
+	stdout, _, err := runRawAppsList(t, []string{"apps", "list", "--raw", "--paginate"}, map[string]string{"": first, "BQ": second})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(stdout, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 NDJSON lines, got %d: %q", len(lines), stdout)
+	}
+	if lines[0] != first {
+		t.Fatalf("expected first page unchanged, got %q", lines[0])
+	}
+	if lines[1] != `{"data":[{"type":"apps","id":"app-2"}],"links":{}}` {
+		t.Fatalf("expected compacted second page, got %q", lines[1])
+	}
+}
+
+func TestAppsListRawRejectsSelect(t *testing.T) {
+	_, stderr, err := runRawAppsList(t, []string{"apps", "list", "--raw", "--select", "data.0.id"}, nil)
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	if !strings.Contains(stderr, "--raw cannot be combined with --select") {
+		t.Fatalf("expected --select conflict, got %q", stderr)
+	}
+}
+
+func TestBuildsListRawSkipsAppLookupBody(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	buildsBody := `{"data":[{"type":"builds","id":"build-1"}],"links":{}}`
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch req.URL.Path {
+		case "/v1/apps":
+			body = `{"data":[{"type":"apps","id":"app-lookup"}]}`
+		case "/v1/builds":
+			body = buildsBody
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "list", "--app", "com.example.lookup", "--raw"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stdout != buildsBody+"\n" {
+		t.Fatalf("expected only the builds response body, got %q", stdout)
+	}
+}
+
+func TestRawUsageErrorsExitBeforeRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "non-json output",
+			args:    []string{"apps", "list", "--raw", "--output", "table"},
+			wantErr: "--raw requires --output json",
+		},
+		{
+			name:    "builds since",
+			args:    []string{"builds", "list", "--app", "123", "--raw", "--since", "168h"},
+			wantErr: "--raw cannot be combined with --since",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				t.Fatalf("unexpected request to %s", req.URL.String())
+				return nil, nil
+			})
+
+			_, stderr := captureOutput(t, func() {
+				code := cmd.Run(test.args, "1.2.3")
+				if code != cmd.ExitUsage {
+					t.Fatalf("expected exit code %d, got %d", cmd.ExitUsage, code)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestRawFlagNotBoundOnNonFetchCommands(t *testing.T) {
+	root := RootCommand("1.2.3")
+	for _, path := range [][]string{{"status"}, {"apps", "list"}} {
+		command := root
+		for _, name := range path {
+			for _, sub := range command.Subcommands {
+				if sub.Name == name {
+					command = sub
+					break
+				}
+			}
+		}
+		if command.Name != path[len(path)-1] {
+			t.Fatalf("command %q not found", strings.Join(path, " "))
+		}
+		got := command.FlagSet.Lookup("raw") != nil
+		if want := path[0] != "status"; got != want {
+			t.Fatalf("%s: expected --raw bound=%v, got %v", strings.Join(path, " "), want, got)
+		}
+	}
+}
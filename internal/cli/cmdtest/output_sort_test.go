@@ -0,0 +1,43 @@
+package cmdtest
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCertificatesListSortByDateDescending(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":[` +
+			`{"type":"certificates","id":"cert-2027","attributes":{"name":"Z","expirationDate":"2027-01-01T00:00:00.000+0000"}},` +
+			`{"type":"certificates","id":"cert-2028","attributes":{"name":"C","expirationDate":"2028-06-01T00:00:00Z"}},` +
+			`{"type":"certificates","id":"cert-2026","attributes":{"name":"A","expirationDate":"2026-11-01T00:00:00Z"}}` +
+			`],"links":{}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	stdout, err := runCountCommand(t, "certificates", "list", "--sort-by", "attributes.expirationDate", "--sort-desc")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	first := strings.Index(stdout, `"id":"cert-2028"`)
+	second := strings.Index(stdout, `"id":"cert-2027"`)
+	third := strings.Index(stdout, `"id":"cert-2026"`)
+	if first < 0 || second < 0 || third < 0 || !(first < second && second < third) {
+		t.Fatalf("expected certificates sorted by expiration date descending, got %q", stdout)
+	}
+}
@@ -0,0 +1,202 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCertificatesListPaginateSaveAndResumeCursor(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	cursorPath := filepath.Join(t.TempDir(), "certificates.cursor")
+
+	const secondURL = "https://api.appstoreconnect.apple.com/v1/certificates?cursor=BQ&limit=200"
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	failSecondPage := true
+	var requested []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.String())
+		body := `{"data":[{"type":"certificates","id":"cert-page-1"}],"links":{"next":"` + secondURL + `"}}`
+		if req.URL.String() == secondURL {
+			if failSecondPage {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`)),
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+				}, nil
+			}
+			body = `{"data":[{"type":"certificates","id":"cert-page-2"}],"links":{}}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	run := func(args ...string) (string, error) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		var runErr error
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		return stdout, runErr
+	}
+
+	if _, err := run("--save-cursor", cursorPath, "certificates", "list", "--paginate"); err == nil {
+		t.Fatal("expected first run to fail on page 2")
+	}
+	saved, err := os.ReadFile(cursorPath)
+	if err != nil {
+		t.Fatalf("read cursor: %v", err)
+	}
+	if strings.TrimSpace(string(saved)) != secondURL {
+		t.Fatalf("expected saved cursor %q, got %q", secondURL, saved)
+	}
+
+	failSecondPage = false
+	requested = nil
+	stdout, err := run("--save-cursor", cursorPath, "--resume-cursor", cursorPath, "certificates", "list", "--paginate")
+	if err != nil {
+		t.Fatalf("resume run error: %v", err)
+	}
+	if !strings.Contains(stdout, `"id":"cert-page-2"`) || strings.Contains(stdout, `"id":"cert-page-1"`) {
+		t.Fatalf("expected only resumed page in output, got %q", stdout)
+	}
+	if len(requested) == 0 || requested[len(requested)-1] != secondURL {
+		t.Fatalf("expected resume to fetch the saved cursor, got %v", requested)
+	}
+	if _, err := os.Stat(cursorPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected cursor file to be removed after completion, got %v", err)
+	}
+}
+
+func TestResumeCursorRejectsForeignURL(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	cursorPath := filepath.Join(t.TempDir(), "cursor")
+	if err := os.WriteFile(cursorPath, []byte("https://evil.example.com/v1/certificates?cursor=AQ\n"), 0o600); err != nil {
+		t.Fatalf("write cursor: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"--resume-cursor", cursorPath, "certificates", "list", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "--resume-cursor") {
+		t.Fatalf("expected --resume-cursor validation error, got %v", runErr)
+	}
+}
+
+func TestPaginationCursorFlagsRequirePaginate(t *testing.T) {
+	for _, flagName := range []string{"--resume-cursor", "--save-cursor"} {
+		t.Run(flagName, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse([]string{flagName, filepath.Join(t.TempDir(), "cursor"), "certificates", "list"}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, "Error: "+flagName+" requires --paginate") {
+				t.Fatalf("expected --paginate requirement, got %q", stderr)
+			}
+		})
+	}
+}
+
+func TestResumeCursorSkipsInternalLookups(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	const appsNextURL = "https://api.appstoreconnect.apple.com/v1/apps?cursor=AQ"
+	const buildsResumeURL = "https://api.appstoreconnect.apple.com/v1/builds?cursor=BQ"
+	cursorPath := filepath.Join(t.TempDir(), "builds.cursor")
+	if err := os.WriteFile(cursorPath, []byte(buildsResumeURL+"\n"), 0o600); err != nil {
+		t.Fatalf("write cursor: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/apps":
+			if req.URL.Query().Get("cursor") != "" {
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-2","attributes":{"name":"My App"}}],"links":{}}`)
+			}
+			if req.URL.Query().Get("filter[name]") != "" {
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"My App Pro"}}],"links":{"next":"`+appsNextURL+`"}}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		case "/v1/builds":
+			if req.URL.Query().Get("cursor") == "BQ" {
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"b2"}],"links":{}}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"b1"}],"links":{"next":"`+buildsResumeURL+`"}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"--resume-cursor", cursorPath, "--save-cursor", cursorPath, "builds", "list", "--app", "My App", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"b2"`) || strings.Contains(stdout, `"id":"b1"`) {
+		t.Fatalf("expected builds list to resume at the saved page, got %q", stdout)
+	}
+	if _, err := os.Stat(cursorPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected cursor file to be removed after completion, stat err=%v", err)
+	}
+}
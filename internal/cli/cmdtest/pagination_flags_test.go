@@ -0,0 +1,264 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPaginateProgressWritesToStderrOnly(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const nextURL = "https://api.appstoreconnect.apple.com/v1/apps?cursor=BQ&limit=200"
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("cursor") == "" {
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1"},{"type":"apps","id":"app-2"}],"links":{"next":"`+nextURL+`"}}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-3"}],"links":{"next":""}}`)
+	})
+
+	run := func(args ...string) (string, string) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		return captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+	}
+
+	stdout, stderr := run("--progress", "apps", "list", "--paginate")
+	if !strings.Contains(stderr, "fetched 3 items across 2 pages...") {
+		t.Fatalf("expected progress on stderr, got %q", stderr)
+	}
+	var payload struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("expected clean JSON on stdout: %v\nstdout=%q", err, stdout)
+	}
+	if len(payload.Data) != 3 {
+		t.Fatalf("expected 3 apps, got %d", len(payload.Data))
+	}
+
+	// Without --progress, non-interactive runs stay silent.
+	_, stderr = run("apps", "list", "--paginate")
+	if stderr != "" {
+		t.Fatalf("expected no progress without --progress, got %q", stderr)
+	}
+}
+
+func TestPaginateProgressIgnoresInternalLookups(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/apps":
+			if req.URL.Query().Get("filter[name]") != "" {
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"My App"}}],"links":{}}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		case "/v1/builds":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"b1"},{"type":"builds","id":"b2"},{"type":"builds","id":"b3"}],"links":{}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--progress", "builds", "list", "--app", "My App", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "fetched 3 items across 1 page...") {
+		t.Fatalf("expected progress for the builds loop, got %q", stderr)
+	}
+	if strings.Count(stderr, "fetched ") != 1 {
+		t.Fatalf("expected progress only for the command's own loop, got %q", stderr)
+	}
+}
+
+func TestPaginateMaxItemsReturnsPartialResultWithNextLink(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const nextURL = "https://api.appstoreconnect.apple.com/v1/apps?cursor=BQ&limit=200"
+	requests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if req.URL.Query().Get("cursor") == "" {
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1"},{"type":"apps","id":"app-2"}],"links":{"next":"`+nextURL+`"}}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-3"}],"links":{"next":""}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"--max-items", "2", "apps", "list", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if requests != 1 {
+		t.Fatalf("expected pagination to stop after 1 request, got %d", requests)
+	}
+	var payload struct {
+		Data  []json.RawMessage `json:"data"`
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if len(payload.Data) != 2 {
+		t.Fatalf("expected 2 apps, got %d", len(payload.Data))
+	}
+	if payload.Links.Next != nextURL {
+		t.Fatalf("expected links.next %q, got %q", nextURL, payload.Links.Next)
+	}
+}
+
+func TestPaginateMaxItemsRejectsNegativeValue(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--max-items", "-1", "apps", "list", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp usage error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "Error: --max-items must be >= 0") {
+		t.Fatalf("expected max-items validation error, got %q", stderr)
+	}
+}
+
+func TestPaginateMaxItemsRequiresPaginate(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--max-items", "5", "apps", "list"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp usage error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "Error: --max-items requires --paginate") {
+		t.Fatalf("expected --paginate requirement, got %q", stderr)
+	}
+}
+
+func TestPaginateMaxItemsDoesNotTruncateInternalLookups(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const appsNextURL = "https://api.appstoreconnect.apple.com/v1/apps?cursor=AQ"
+	const buildsNextURL = "https://api.appstoreconnect.apple.com/v1/builds?cursor=AQ"
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/apps":
+			if req.URL.Query().Get("cursor") != "" {
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-2","attributes":{"name":"My App"}}],"links":{}}`)
+			}
+			if req.URL.Query().Get("filter[name]") != "" {
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"My App Pro"}}],"links":{"next":"`+appsNextURL+`"}}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		case "/v1/builds":
+			if got := req.URL.Query().Get("filter[app]"); got != "" && got != "app-2" {
+				t.Fatalf("expected builds for app-2, got filter[app]=%q", got)
+			}
+			if req.URL.Query().Get("cursor") != "" {
+				t.Fatal("expected --max-items to stop the builds loop after the first page")
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"b1"}],"links":{"next":"`+buildsNextURL+`"}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"--max-items", "1", "builds", "list", "--app", "My App", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data  []json.RawMessage `json:"data"`
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if len(payload.Data) != 1 || payload.Links.Next != buildsNextURL {
+		t.Fatalf("expected one build and links.next %q, got %d items and %q", buildsNextURL, len(payload.Data), payload.Links.Next)
+	}
+}
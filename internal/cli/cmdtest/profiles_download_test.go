@@ -0,0 +1,244 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestProfilesDownloadOutputDirUsesFilenameTemplate(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	b64 := base64.StdEncoding.EncodeToString([]byte("profile-bytes"))
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/profiles/p1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":{"type":"profiles","id":"p1","attributes":{"name":"Dev Profile","platform":"IOS","uuid":"00000000-0000-0000-0000-0000000000AA","profileContent":"` + b64 + `"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outDir := filepath.Join(t.TempDir(), "profiles")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "download", "--id", "p1", "--output-dir", outDir, "--filename", "{platform}-{name}.mobileprovision"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	wantPath := filepath.Join(outDir, "IOS-Dev_Profile.mobileprovision")
+	data, err := os.ReadFile(wantPath)
+	if err != nil {
+		t.Fatalf("ReadFile(%q) error: %v", wantPath, err)
+	}
+	if string(data) != "profile-bytes" {
+		t.Fatalf("unexpected profile content %q", string(data))
+	}
+	if !strings.Contains(stdout, "IOS-Dev_Profile.mobileprovision") {
+		t.Fatalf("expected output path in stdout, got %q", stdout)
+	}
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(wantPath)
+		if err != nil {
+			t.Fatalf("Stat(%q) error: %v", wantPath, err)
+		}
+		if info.Mode().Perm()&0o077 != 0 {
+			t.Fatalf("expected profile to default to 0600, got %o", info.Mode().Perm())
+		}
+	}
+}
+
+func TestProfilesDownloadFilenameValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "output and output-dir",
+			args:    []string{"profiles", "download", "--id", "p1", "--output", "a.mobileprovision", "--output-dir", "out"},
+			wantErr: "--output and --output-dir are mutually exclusive",
+		},
+		{
+			name:    "filename without output-dir",
+			args:    []string{"profiles", "download", "--id", "p1", "--output", "a.mobileprovision", "--filename", "{name}.mobileprovision"},
+			wantErr: "--filename requires --output-dir",
+		},
+		{
+			name:    "invalid file mode",
+			args:    []string{"profiles", "download", "--id", "p1", "--output", "a.mobileprovision", "--file-mode", "0400"},
+			wantErr: "--file-mode: file mode \"0400\" must allow owner read and write",
+		},
+		{
+			name:    "id and all",
+			args:    []string{"profiles", "download", "--id", "p1", "--all", "--output-dir", "out"},
+			wantErr: "--id and --all are mutually exclusive",
+		},
+		{
+			name:    "all without output-dir",
+			args:    []string{"profiles", "download", "--all", "--output", "a.mobileprovision"},
+			wantErr: "--all requires --output-dir",
+		},
+		{
+			name:    "path traversal",
+			args:    []string{"profiles", "download", "--id", "p1", "--output-dir", "out", "--filename", "../{name}.mobileprovision"},
+			wantErr: "must be a plain file name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestProfilesDownloadAllWritesEveryProfile(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	first := base64.StdEncoding.EncodeToString([]byte("first"))
+	second := base64.StdEncoding.EncodeToString([]byte("second"))
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/profiles" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		var body string
+		if req.URL.Query().Get("cursor") == "" {
+			body = `{"data":[{"type":"profiles","id":"p1","attributes":{"name":"First","uuid":"uuid-1","profileContent":"` + first + `"}}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/profiles?cursor=2"}}`
+		} else {
+			body = `{"data":[{"type":"profiles","id":"p2","attributes":{"name":"Second","uuid":"uuid-2","profileContent":"` + second + `"}}],"links":{}}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outDir := t.TempDir()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "download", "--all", "--output-dir", outDir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for name, want := range map[string]string{
+		"First-uuid-1.mobileprovision":  "first",
+		"Second-uuid-2.mobileprovision": "second",
+	} {
+		data, err := os.ReadFile(filepath.Join(outDir, name))
+		if err != nil {
+			t.Fatalf("ReadFile(%q) error: %v", name, err)
+		}
+		if string(data) != want {
+			t.Fatalf("content of %q = %q, want %q", name, string(data), want)
+		}
+	}
+	if !strings.Contains(stdout, `"id":"p1"`) || !strings.Contains(stdout, `"id":"p2"`) {
+		t.Fatalf("expected both profiles in output, got %q", stdout)
+	}
+}
+
+func TestProfilesDownloadForceOverwritesExistingFile(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	b64 := base64.StdEncoding.EncodeToString([]byte("new-bytes"))
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":{"type":"profiles","id":"p1","attributes":{"name":"Dev","profileContent":"` + b64 + `"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outPath := filepath.Join(t.TempDir(), "profile.mobileprovision")
+	if err := os.WriteFile(outPath, []byte("old-bytes"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	run := func(args ...string) error {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		var runErr error
+		captureOutput(t, func() {
+			if err := root.Parse(append([]string{"profiles", "download", "--id", "p1", "--output", outPath}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		return runErr
+	}
+
+	if err := run(); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected already exists error without --force, got %v", err)
+	}
+	if err := run("--force"); err != nil {
+		t.Fatalf("run with --force error: %v", err)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if string(data) != "new-bytes" {
+		t.Fatalf("expected overwritten content, got %q", string(data))
+	}
+}
@@ -0,0 +1,83 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRejectsNegativeValue(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--rate-limit", "-1", "apps", "list"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp usage error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "Error: --rate-limit must be >= 0") {
+		t.Fatalf("expected rate limit validation error, got %q", stderr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+}
+
+func TestRateLimitThrottlesPaginatedRequests(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	const nextURL = "https://api.appstoreconnect.apple.com/v1/apps?cursor=BQ&limit=200"
+	requests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if req.URL.Query().Get("cursor") == "" {
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1"}],"links":{"next":"`+nextURL+`"}}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-2"}],"links":{"next":""}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	start := time.Now()
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"--rate-limit", "10", "apps", "list", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	elapsed := time.Since(start)
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if elapsed < 100*time.Millisecond {
+		t.Fatalf("expected second page to wait for the rate limiter, finished in %s", elapsed)
+	}
+	if !strings.Contains(stdout, "app-1") || !strings.Contains(stdout, "app-2") {
+		t.Fatalf("expected both pages in output, got %q", stdout)
+	}
+}
@@ -76,7 +76,7 @@ func TestReviewItemsAddIncludesAssociatedErrorsInReturnedError(t *testing.T) {
 	}
 
 	message := runErr.Error()
-	if !strings.Contains(message, "review items-add: appStoreVersions with id 'version-1' is not in valid state.") {
+	if !strings.Contains(message, "review items-add: STATE_ERROR.ENTITY_STATE_INVALID: appStoreVersions with id 'version-1' is not in valid state.") {
 		t.Fatalf("expected wrapped top-level message, got %q", message)
 	}
 	if !strings.Contains(message, "Associated errors for /v1/ageRatingDeclarations/age-rating-1:") {
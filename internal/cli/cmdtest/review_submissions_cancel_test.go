@@ -0,0 +1,101 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReviewSubmissionsCancelChecksState(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       string
+		wantErr     string
+		wantPatched bool
+	}{
+		{
+			name:        "waiting for review is canceled",
+			state:       "WAITING_FOR_REVIEW",
+			wantPatched: true,
+		},
+		{
+			name:    "complete submission is refused",
+			state:   "COMPLETE",
+			wantErr: `submission "sub-1" is COMPLETE and cannot be canceled`,
+		},
+		{
+			name:    "canceling submission is refused",
+			state:   "CANCELING",
+			wantErr: `submission "sub-1" is CANCELING and cannot be canceled`,
+		},
+		{
+			name:    "unknown state is refused",
+			state:   "SOME_NEW_STATE",
+			wantErr: `submission "sub-1" is SOME_NEW_STATE and cannot be canceled`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+
+			patched := false
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path != "/v1/reviewSubmissions/sub-1" {
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+				}
+				switch req.Method {
+				case http.MethodGet:
+					return jsonResponse(http.StatusOK, `{"data":{"type":"reviewSubmissions","id":"sub-1","attributes":{"state":"`+test.state+`"}}}`)
+				case http.MethodPatch:
+					patched = true
+					return jsonResponse(http.StatusOK, `{"data":{"type":"reviewSubmissions","id":"sub-1","attributes":{"state":"CANCELING"}}}`)
+				default:
+					t.Fatalf("unexpected method: %s", req.Method)
+					return nil, nil
+				}
+			})
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse([]string{"review", "submissions-cancel", "--id", "sub-1", "--confirm"}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if patched != test.wantPatched {
+				t.Fatalf("expected patched=%t, got %t", test.wantPatched, patched)
+			}
+			if test.wantErr == "" {
+				if runErr != nil {
+					t.Fatalf("run error: %v", runErr)
+				}
+				if !strings.Contains(stdout, `"state":"CANCELING"`) {
+					t.Fatalf("expected new state in output, got %q", stdout)
+				}
+				return
+			}
+			if runErr == nil || errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected runtime error, got %v", runErr)
+			}
+			if !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
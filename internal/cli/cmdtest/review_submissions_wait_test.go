@@ -0,0 +1,177 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func reviewSubmissionWaitTransport(t *testing.T, states ...string) http.RoundTripper {
+	t.Helper()
+
+	requestCount := 0
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/reviewSubmissions/sub-1" {
+			t.Fatalf("expected path /v1/reviewSubmissions/sub-1, got %s", req.URL.Path)
+		}
+
+		state := states[min(requestCount, len(states)-1)]
+		requestCount++
+		body := `{"data":{"type":"reviewSubmissions","id":"sub-1","attributes":{"state":"` + state + `","platform":"IOS"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func TestReviewSubmissionsWaitPrintsTransitionsUntilComplete(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = reviewSubmissionWaitTransport(t, "WAITING_FOR_REVIEW", "WAITING_FOR_REVIEW", "IN_REVIEW", "COMPLETE")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"review", "submissions-wait", "--id", "sub-1", "--progress", "--poll-interval", "1ms", "--max-poll-interval", "2ms", "--timeout", "1s"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"sub-1"`) {
+		t.Fatalf("expected submission output, got %q", stdout)
+	}
+	for _, want := range []string{
+		"Review submission sub-1: WAITING_FOR_REVIEW (",
+		"Review submission sub-1: WAITING_FOR_REVIEW -> IN_REVIEW (",
+		"Review submission sub-1: IN_REVIEW -> COMPLETE (",
+	} {
+		if !strings.Contains(stderr, want) {
+			t.Fatalf("expected %q in progress output, got %q", want, stderr)
+		}
+	}
+	if strings.Count(stderr, "Review submission sub-1:") != 3 {
+		t.Fatalf("expected only state transitions in progress output, got %q", stderr)
+	}
+}
+
+func TestReviewSubmissionsWaitFailsOnUnresolvedIssues(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = reviewSubmissionWaitTransport(t, "IN_REVIEW", "UNRESOLVED_ISSUES")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"review", "submissions-wait", "--id", "sub-1", "--poll-interval", "1ms", "--timeout", "1s"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil {
+		t.Fatal("expected unresolved issues error")
+	}
+	if errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected runtime error, got usage error: %v", runErr)
+	}
+	if !strings.Contains(runErr.Error(), "submission sub-1 has unresolved issues") {
+		t.Fatalf("expected unresolved issues error, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout on failure, got %q", stdout)
+	}
+	if stderr != "" {
+		t.Fatalf("expected no progress output without --progress, got %q", stderr)
+	}
+}
+
+func TestReviewSubmissionsWaitReadyForReview(t *testing.T) {
+	tests := []struct {
+		name    string
+		states  []string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:   "still processing after submit",
+			states: []string{"READY_FOR_REVIEW", "WAITING_FOR_REVIEW", "COMPLETE"},
+		},
+		{
+			name:    "withdrawn after submit",
+			states:  []string{"WAITING_FOR_REVIEW", "READY_FOR_REVIEW"},
+			wantErr: "submission sub-1 was withdrawn from review",
+		},
+		{
+			name:    "never submitted within grace",
+			states:  []string{"READY_FOR_REVIEW"},
+			args:    []string{"--submit-grace", "0s"},
+			wantErr: "submission sub-1 is not submitted for review",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+			http.DefaultTransport = reviewSubmissionWaitTransport(t, test.states...)
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, _ := captureOutput(t, func() {
+				args := append([]string{"review", "submissions-wait", "--id", "sub-1", "--poll-interval", "1ms", "--max-poll-interval", "2ms", "--timeout", "1s"}, test.args...)
+				if err := root.Parse(args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantErr == "" {
+				if runErr != nil {
+					t.Fatalf("run error: %v", runErr)
+				}
+				if !strings.Contains(stdout, `"id":"sub-1"`) {
+					t.Fatalf("expected submission output, got %q", stdout)
+				}
+				return
+			}
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected %q, got %v", test.wantErr, runErr)
+			}
+			if stdout != "" {
+				t.Fatalf("expected empty stdout on failure, got %q", stdout)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"flag"
 	"io"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -324,6 +325,81 @@ func TestStatusRejectsUnknownIncludeSection(t *testing.T) {
 	}
 }
 
+func TestStatusRejectsInvalidConcurrency(t *testing.T) {
+	for _, value := range []string{"0", "-2"} {
+		t.Run(value, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse([]string{"status", "--app", "app-1", "--concurrency", value}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, "Error: --concurrency must be >= 1") {
+				t.Fatalf("expected concurrency validation error in stderr, got %q", stderr)
+			}
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+		})
+	}
+}
+
+func TestStatusConcurrencyOneFetchesSections(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/builds":
+			return statusJSONResponse(`{"data":[],"links":{"next":""}}`), nil
+		case "/v1/apps/app-1/appStoreVersions":
+			return statusJSONResponse(`{"data":[],"links":{"next":""}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"status", "--app", "app-1", "--include", "builds,appstore", "--concurrency", "1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%s", err, stdout)
+	}
+	for _, key := range []string{"builds", "appstore"} {
+		if _, ok := payload[key]; !ok {
+			t.Fatalf("expected %s section, got %v", key, payload)
+		}
+	}
+}
+
 func TestStatusTableOutput(t *testing.T) {
 	setupAuth(t)
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
@@ -377,6 +453,65 @@ func TestStatusTableOutput(t *testing.T) {
 	}
 }
 
+func TestStatusExplainAnnotatesStates(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/builds":
+			return statusJSONResponse(`{
+				"data":[{"type":"builds","id":"build-2","attributes":{"version":"45","uploadedDate":"2026-02-20T00:00:00Z","processingState":"VALID"}}],
+				"links":{"next":""}
+			}`), nil
+		case "/v1/builds/build-2/preReleaseVersion":
+			return statusJSONResponse(`{
+				"data":{"type":"preReleaseVersions","id":"prv-2","attributes":{"version":"1.2.3","platform":"IOS"}}
+			}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	run := func(args ...string) string {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(append([]string{"status", "--app", "app-1", "--include", "builds", "--explain"}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		return stdout
+	}
+
+	var payload struct {
+		Explanations map[string]string `json:"explanations"`
+	}
+	stdout := run()
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%s", err, stdout)
+	}
+	explanation := payload.Explanations["VALID"]
+	if explanation == "" {
+		t.Fatalf("expected explanation for VALID, got %v", payload.Explanations)
+	}
+
+	markdown := run("--output", "markdown")
+	if !strings.Contains(markdown, "VALID - "+explanation) {
+		t.Fatalf("expected explained state in markdown output, got %q", markdown)
+	}
+}
+
 func TestStatusTableOutputShowsNeedsAttentionWhenBlocked(t *testing.T) {
 	setupAuth(t)
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
@@ -563,3 +698,222 @@ func TestStatusTestFlightHandlesMissingBuildRelationship(t *testing.T) {
 func statusJSONResponse(body string) *http.Response {
 	return insightsJSONResponse(body)
 }
+
+func TestStatusSlackOutput(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/apps/app-1":
+			return statusJSONResponse(`{
+				"data":{"type":"apps","id":"app-1","attributes":{"name":"My App","bundleId":"com.example.myapp","sku":"my-app-sku"}}
+			}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"status", "--app", "app-1", "--include", "app", "--output", "slack"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var payload struct {
+		Text   string `json:"text"`
+		Blocks []struct {
+			Type string `json:"type"`
+			Text struct {
+				Text string `json:"text"`
+			} `json:"text"`
+			Fields []struct {
+				Type string `json:"type"`
+				Text string `json:"text"`
+			} `json:"fields"`
+		} `json:"blocks"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%s", err, stdout)
+	}
+
+	if payload.Text == "" {
+		t.Fatalf("expected fallback text, got %s", stdout)
+	}
+	if len(payload.Blocks) != 3 {
+		t.Fatalf("expected header, summary and app blocks, got %s", stdout)
+	}
+	if payload.Blocks[0].Type != "header" || payload.Blocks[0].Text.Text != "Release status: My App" {
+		t.Fatalf("unexpected header block: %s", stdout)
+	}
+	app := payload.Blocks[2]
+	if app.Type != "section" || app.Text.Text != "*App*" || len(app.Fields) != 2 || app.Fields[1].Text != "*Bundle ID*\ncom.example.myapp" {
+		t.Fatalf("unexpected app block: %s", stdout)
+	}
+}
+
+func TestStatusSlackOutputHonorsQuiet(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return statusJSONResponse(`{
+			"data":{"type":"apps","id":"app-1","attributes":{"name":"My App","bundleId":"com.example.myapp","sku":"my-app-sku"}}
+		}`), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"--quiet", "status", "--app", "app-1", "--include", "app", "--output", "slack"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected --quiet to suppress slack output, got %q", stdout)
+	}
+}
+
+func TestStatusSetOutputWritesGitHubOutputs(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+	outputPath := filepath.Join(t.TempDir(), "github-output")
+	t.Setenv("GITHUB_OUTPUT", outputPath)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/builds":
+			return statusJSONResponse(`{
+				"data":[{"type":"builds","id":"build-2","attributes":{"version":"45","uploadedDate":"2026-02-20T00:00:00Z","processingState":"VALID"}}],
+				"links":{"next":""}
+			}`), nil
+		case "/v1/builds/build-2/preReleaseVersion":
+			return statusJSONResponse(`{
+				"data":{"type":"preReleaseVersions","id":"prv-2","attributes":{"version":"1.2.3","platform":"IOS"}}
+			}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"status", "--app", "app-1", "--include", "builds", "--set-output"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read GITHUB_OUTPUT: %v", err)
+	}
+	for _, line := range []string{"health=", "blocker_count=0", "latest_build_id=build-2", "latest_build_number=45"} {
+		if !strings.Contains(string(data), line) {
+			t.Fatalf("expected %q in GITHUB_OUTPUT, got %q", line, string(data))
+		}
+	}
+	if strings.Contains(string(data), "app_store_state=") {
+		t.Fatalf("did not expect outputs for sections that were not fetched, got %q", string(data))
+	}
+}
+
+func TestStatusRejectsInvalidOutputFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "insecure url",
+			args:    []string{"status", "--app", "app-1", "--post-to", "http://hooks.example.com/release"},
+			wantErr: "Error: --post-to must use https",
+		},
+		{
+			name:    "slack with pretty",
+			args:    []string{"status", "--app", "app-1", "--output", "slack", "--pretty"},
+			wantErr: "Error: --pretty is only valid with JSON output",
+		},
+		{
+			name:    "set-output without GITHUB_OUTPUT",
+			args:    []string{"status", "--app", "app-1", "--set-output"},
+			wantErr: "Error: --set-output requires GITHUB_OUTPUT to be set",
+		},
+		{
+			name:    "header without url",
+			args:    []string{"status", "--app", "app-1", "--post-header", "Authorization: Bearer token"},
+			wantErr: "Error: --post-header requires --post-to",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("GITHUB_OUTPUT", "")
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp usage error, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+		})
+	}
+}
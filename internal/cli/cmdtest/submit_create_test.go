@@ -287,6 +287,7 @@ func TestSubmitCreateSkipsNonStaleSubmissionsFromCleanupResults(t *testing.T) {
 
 func TestSubmitCreateWarnsWhenStaleSubmissionQueryFails(t *testing.T) {
 	setupSubmitCreateAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 
 	originalTransport := http.DefaultTransport
 	t.Cleanup(func() {
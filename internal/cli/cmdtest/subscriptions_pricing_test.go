@@ -290,6 +290,7 @@ func TestSubscriptionsPricingUsesLatestEffectivePriceAsCurrent(t *testing.T) {
 
 func TestSubscriptionsPricingReturnsWorkerErrorNotContextCancelled(t *testing.T) {
 	setupAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 
 	originalTransport := http.DefaultTransport
 	t.Cleanup(func() {
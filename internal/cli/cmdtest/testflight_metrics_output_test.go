@@ -170,6 +170,7 @@ func TestTestFlightMetricsTestersTableOutput(t *testing.T) {
 
 func TestTestFlightMetricsPublicLinkReturnsFetchFailure(t *testing.T) {
 	setupAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
 
 	originalTransport := http.DefaultTransport
@@ -323,8 +324,8 @@ func TestTestFlightMetricsPublicLinkOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"testflight", "metrics", "public-link", "--group", "group-1", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"testflight", "metrics", "public-link", "--group", "group-1", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with table",
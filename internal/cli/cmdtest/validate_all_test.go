@@ -0,0 +1,195 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/validate"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+func TestValidateAllRequiresVersionSelector(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "all", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--version or --version-id is required") {
+		t.Fatalf("expected version selector error, got %q", stderr)
+	}
+}
+
+func TestValidateAllMergesDomainReports(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.iaps = `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"APPROVED"}}]}`
+	fixture.iapLocalizations = `{"data":[]}`
+	fixture.subscriptionGroups = `{"data":[]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "all", "--app", "app-1", "--version-id", "ver-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, shared.ErrBlockingIssues) {
+		t.Fatalf("expected blocking issues error, got %v", runErr)
+	}
+
+	var report validation.AllReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if report.AppStore == nil || report.IAP == nil || report.Subscriptions == nil {
+		t.Fatalf("expected all domain sections, got %s", stdout)
+	}
+	if report.AppStore.Summary.Blocking != 0 {
+		t.Fatalf("expected app store section to pass, got %+v", report.AppStore.Summary)
+	}
+	if !hasCheckWithID(report.IAP.Checks, "iap.pricing.missing") || !hasCheckWithID(report.IAP.Checks, "iap.localization.missing") {
+		t.Fatalf("expected IAP pricing and localization errors, got %+v", report.IAP.Checks)
+	}
+	if report.Summary.Blocking != report.IAP.Summary.Blocking || report.Summary.Blocking == 0 {
+		t.Fatalf("expected combined blocking to match IAP section, got %+v", report.Summary)
+	}
+}
+
+func TestValidateAllOutputsTable(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.iaps = `{"data":[]}`
+	fixture.subscriptionGroups = `{"data":[]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "all", "--app", "app-1", "--version-id", "ver-1", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"Domain", "appStore", "iap", "subscriptions", "total"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected table output to contain %q, got %q", want, stdout)
+		}
+	}
+}
+
+func TestValidateAllRejectsUnsupportedOutputBeforeFetching(t *testing.T) {
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		t.Fatal("client should not be created for an unsupported output format")
+		return nil, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "all", "--app", "app-1", "--version-id", "ver-1", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "csv") {
+		t.Fatalf("expected unsupported output error, got %q", stderr)
+	}
+}
+
+func TestValidateAllOutputsJUnitAndSetOutput(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.iaps = `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"APPROVED"}}]}`
+	fixture.iapLocalizations = `{"data":[]}`
+	fixture.subscriptionGroups = `{"data":[]}`
+	outputPath := filepath.Join(t.TempDir(), "github-output")
+	t.Setenv("GITHUB_OUTPUT", outputPath)
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "all", "--app", "app-1", "--version-id", "ver-1", "--output", "junit", "--set-output"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, shared.ErrBlockingIssues) {
+		t.Fatalf("expected blocking issues error, got %v", runErr)
+	}
+	if !strings.Contains(stdout, "<testsuite") || !strings.Contains(stdout, `classname="validate.iap"`) {
+		t.Fatalf("expected JUnit suite with IAP cases, got %q", stdout)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read GITHUB_OUTPUT: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "ready=false\n") || !strings.Contains(string(data), "blocking_count=") {
+		t.Fatalf("unexpected GITHUB_OUTPUT %q", string(data))
+	}
+}
+
+func TestValidateAllOutputsGitHubAnnotations(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.iaps = `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"APPROVED"}}]}`
+	fixture.iapLocalizations = `{"data":[]}`
+	fixture.subscriptionGroups = `{"data":[]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "all", "--app", "app-1", "--version-id", "ver-1", "--output", "github"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		_ = root.Run(context.Background())
+	})
+
+	if !strings.Contains(stdout, "::error title=iap.pricing.missing::") {
+		t.Fatalf("expected IAP annotation, got %q", stdout)
+	}
+}
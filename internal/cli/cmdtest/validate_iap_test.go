@@ -7,6 +7,7 @@ import (
 	"flag"
 	"io"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -17,7 +18,16 @@ import (
 )
 
 type validateIAPFixture struct {
-	iaps string
+	app           string
+	iaps          string
+	localizations string
+	// localizationsNext is served for localization requests with a cursor.
+	localizationsNext string
+	priceSchedule     string
+	manualPrices      string
+	availability      string
+	territories       string
+	screenshot        string
 }
 
 func newValidateIAPClient(t *testing.T, fixture validateIAPFixture) *asc.Client {
@@ -34,12 +44,31 @@ func newValidateIAPClient(t *testing.T, fixture validateIAPFixture) *asc.Client
 			return jsonResponse(http.StatusMethodNotAllowed, `{"errors":[{"status":405}]}`)
 		}
 
-		switch req.URL.Path {
-		case "/v1/apps/app-1/inAppPurchasesV2":
-			return jsonResponse(http.StatusOK, fixture.iaps)
-		default:
+		body := ""
+		switch path := req.URL.Path; {
+		case path == "/v1/apps/app-1":
+			body = fixture.app
+		case path == "/v1/apps/app-1/inAppPurchasesV2":
+			body = fixture.iaps
+		case strings.HasSuffix(path, "/inAppPurchaseLocalizations") && req.URL.Query().Get("cursor") != "":
+			body = fixture.localizationsNext
+		case strings.HasSuffix(path, "/inAppPurchaseLocalizations"):
+			body = fixture.localizations
+		case strings.HasSuffix(path, "/iapPriceSchedule"):
+			body = fixture.priceSchedule
+		case strings.HasSuffix(path, "/manualPrices"):
+			body = fixture.manualPrices
+		case strings.HasSuffix(path, "/inAppPurchaseAvailability"):
+			body = fixture.availability
+		case strings.HasSuffix(path, "/availableTerritories"):
+			body = fixture.territories
+		case strings.HasSuffix(path, "/relationships/appStoreReviewScreenshot"):
+			body = fixture.screenshot
+		}
+		if body == "" {
 			return jsonResponse(http.StatusNotFound, notFound)
 		}
+		return jsonResponse(http.StatusOK, body)
 	})
 
 	httpClient := &http.Client{Transport: transport}
@@ -52,7 +81,13 @@ func newValidateIAPClient(t *testing.T, fixture validateIAPFixture) *asc.Client
 
 func validValidateIAPFixture() validateIAPFixture {
 	return validateIAPFixture{
-		iaps: `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"APPROVED"}}]}`,
+		app:           `{"data":{"type":"apps","id":"app-1","attributes":{"name":"Example","primaryLocale":"en-US"}}}`,
+		iaps:          `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"APPROVED"}}]}`,
+		localizations: `{"data":[{"type":"inAppPurchaseLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Pro","description":"Unlock everything"}}]}`,
+		priceSchedule: `{"data":{"type":"inAppPurchasePriceSchedules","id":"schedule-1"}}`,
+		manualPrices:  `{"data":[{"type":"inAppPurchasePrices","id":"price-1","attributes":{"startDate":"2024-01-01"}}]}`,
+		availability:  `{"data":{"type":"inAppPurchaseAvailabilities","id":"availability-1","attributes":{"availableInNewTerritories":true}}}`,
+		territories:   `{"data":[{"type":"territories","id":"USA","attributes":{"currency":"USD"}}]}`,
 	}
 }
 
@@ -186,3 +221,355 @@ func TestValidateIAPWarnsAndStrictFails(t *testing.T) {
 		t.Fatalf("expected iap.review_readiness.needs_attention check, got %+v", strictReport.Checks)
 	}
 }
+
+func TestValidateIAPOutputsGitHubAnnotations(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.iaps = `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"READY_TO_SUBMIT"}}]}`
+
+	client := newValidateIAPClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--output", "github"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected no error (warning-only), got %v", err)
+		}
+	})
+	if !strings.HasPrefix(stdout, "::warning title=iap.review_readiness.needs_attention::") {
+		t.Fatalf("expected warning annotation, got %q", stdout)
+	}
+
+	root = RootCommand("1.2.3")
+	var runErr error
+	stdout, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--output", "github", "--strict"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError with --strict, got %v", runErr)
+	}
+	if !strings.HasPrefix(stdout, "::error title=iap.review_readiness.needs_attention::") {
+		t.Fatalf("expected error annotation under --strict, got %q", stdout)
+	}
+}
+
+func TestValidateIAPFiltersByProductID(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.iaps = `{"data":[` +
+		`{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"APPROVED"}},` +
+		`{"type":"inAppPurchases","id":"iap-2","attributes":{"name":"Coins","productId":"com.example.coins","inAppPurchaseType":"CONSUMABLE","state":"READY_TO_SUBMIT"}}` +
+		`]}`
+
+	client := newValidateIAPClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--product-id", "com.example.pro"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var report validation.IAPReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if report.IAPCount != 1 {
+		t.Fatalf("expected 1 IAP after filtering, got %d", report.IAPCount)
+	}
+	if report.Summary.Warnings != 0 {
+		t.Fatalf("expected filtered-out IAP warnings to be excluded, got %+v", report.Summary)
+	}
+}
+
+func TestValidateIAPProductIDNotFound(t *testing.T) {
+	client := newValidateIAPClient(t, validValidateIAPFixture())
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--product-id", "com.example.missing"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), `no in-app purchase with product ID "com.example.missing"`) {
+		t.Fatalf("expected not found error, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected no report output, got %q", stdout)
+	}
+}
+
+func TestValidateIAPFlagsMissingPriceAndAvailability(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.priceSchedule = ""
+	fixture.territories = `{"data":[]}`
+
+	client := newValidateIAPClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 1 blocking issue(s)") {
+		t.Fatalf("expected blocking error for missing price, got %v", runErr)
+	}
+
+	var report validation.IAPReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	severities := map[string]validation.Severity{}
+	for _, check := range report.Checks {
+		severities[check.ID] = check.Severity
+	}
+	if severities["iap.pricing.missing"] != validation.SeverityError {
+		t.Fatalf("expected iap.pricing.missing error, got %+v", report.Checks)
+	}
+	if severities["iap.availability.empty"] != validation.SeverityWarning {
+		t.Fatalf("expected iap.availability.empty warning, got %+v", report.Checks)
+	}
+}
+
+func TestValidateIAPFlagsIncompleteLocalizations(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.localizations = `{"data":[{"type":"inAppPurchaseLocalizations","id":"loc-1","attributes":{"locale":"de-DE","name":"Pro"}}]}`
+
+	client := newValidateIAPClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 2 blocking issue(s)") {
+		t.Fatalf("expected blocking localization errors, got %v", runErr)
+	}
+
+	var report validation.IAPReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	ids := map[string]string{}
+	for _, check := range report.Checks {
+		ids[check.ID] = check.Locale
+	}
+	if ids["iap.localization.primary_locale_missing"] != "en-US" {
+		t.Fatalf("expected primary locale check for en-US, got %+v", report.Checks)
+	}
+	if ids["iap.localization.description_missing"] != "de-DE" {
+		t.Fatalf("expected missing description check for de-DE, got %+v", report.Checks)
+	}
+}
+
+func TestValidateIAPPaginatesLocalizations(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.localizations = `{"data":[{"type":"inAppPurchaseLocalizations","id":"loc-1","attributes":{"locale":"de-DE","name":"Pro","description":"Alles freischalten"}}],"links":{"next":"https://api.appstoreconnect.apple.com/v2/inAppPurchases/iap-1/inAppPurchaseLocalizations?cursor=2"}}`
+	fixture.localizationsNext = `{"data":[{"type":"inAppPurchaseLocalizations","id":"loc-2","attributes":{"locale":"en-US","name":"Pro","description":"Unlock everything"}}]}`
+
+	client := newValidateIAPClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--concurrency", "2"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var report validation.IAPReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if report.Summary.Errors != 0 {
+		t.Fatalf("expected primary locale on the second page to satisfy checks, got %+v", report.Checks)
+	}
+}
+
+func TestValidateIAPRejectsInvalidConcurrency(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--concurrency", "0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--concurrency must be >= 1") {
+		t.Fatalf("expected concurrency error, got %q", stderr)
+	}
+}
+
+func TestValidateIAPWarnsForMissingReviewScreenshot(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.iaps = `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE","state":"READY_TO_SUBMIT"}}]}`
+	fixture.screenshot = `{"data":null}`
+
+	client := newValidateIAPClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected warning-only result, got %v", err)
+		}
+	})
+
+	var report validation.IAPReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	found := false
+	for _, check := range report.Checks {
+		if check.ID == "iap.review_screenshot.missing" && check.Severity == validation.SeverityWarning {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected iap.review_screenshot.missing warning, got %+v", report.Checks)
+	}
+
+	fixture.screenshot = `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"shot-1"}}`
+	client = newValidateIAPClient(t, fixture)
+	root = RootCommand("1.2.3")
+	stdout, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if strings.Contains(stdout, "iap.review_screenshot.missing") {
+		t.Fatalf("expected no screenshot warning when attached, got %q", stdout)
+	}
+}
+
+func TestValidateIAPIgnoreFileSuppressesBlockingFindings(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.priceSchedule = ""
+
+	client := newValidateIAPClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	ignorePath := filepath.Join(t.TempDir(), "ignore")
+	if err := os.WriteFile(ignorePath, []byte("# accepted for launch\niap.pricing.missing:com.example.pro\n"), 0o600); err != nil {
+		t.Fatalf("write ignore file: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--ignore-file", ignorePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected ignored finding not to block, got %v", err)
+		}
+	})
+
+	var report validation.IAPReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if report.Summary.Blocking != 0 || report.Summary.Ignored != 1 {
+		t.Fatalf("expected 0 blocking and 1 ignored, got %+v", report.Summary)
+	}
+	found := false
+	for _, check := range report.Checks {
+		if check.ID == "iap.pricing.missing" && check.Ignored {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected iap.pricing.missing to be reported as ignored, got %+v", report.Checks)
+	}
+}
+
+func TestValidateIAPRejectsInvalidIgnoreFile(t *testing.T) {
+	ignorePath := filepath.Join(t.TempDir(), "ignore")
+	if err := os.WriteFile(ignorePath, []byte("iap.pricing.missing\n"), 0o600); err != nil {
+		t.Fatalf("write ignore file: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1", "--ignore-file", ignorePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "line 1: expected check:productID") {
+		t.Fatalf("expected ignore file parse error, got %v", runErr)
+	}
+}
+
+func TestValidateIAPWithClientReturnsReport(t *testing.T) {
+	fixture := validValidateIAPFixture()
+	fixture.priceSchedule = ""
+	client := newValidateIAPClient(t, fixture)
+
+	report, err := validate.ValidateIAPWithClient(context.Background(), client, validate.IAPOptions{AppID: "app-1"})
+	if err != nil {
+		t.Fatalf("ValidateIAPWithClient() error: %v", err)
+	}
+	if report.IAPCount != 1 || report.Summary.Blocking != 1 {
+		t.Fatalf("expected one IAP with one blocking issue, got count=%d summary=%+v", report.IAPCount, report.Summary)
+	}
+}
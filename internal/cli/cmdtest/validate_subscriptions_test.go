@@ -17,8 +17,17 @@ import (
 )
 
 type validateSubscriptionsFixture struct {
+	app                  string
 	groups               string
 	subscriptionsByGroup map[string]string
+	localizations        string
+	availability         string
+	territories          string
+	prices               string
+	gracePeriod          string
+	introductoryOffers   string
+	// introductoryOffersNext is served for offer requests with a cursor.
+	introductoryOffersNext string
 }
 
 func newValidateSubscriptionsClient(t *testing.T, fixture validateSubscriptionsFixture) *asc.Client {
@@ -37,6 +46,22 @@ func newValidateSubscriptionsClient(t *testing.T, fixture validateSubscriptionsF
 
 		path := req.URL.Path
 		switch {
+		case path == "/v1/apps/app-1":
+			return jsonResponse(http.StatusOK, fixture.app)
+		case strings.HasSuffix(path, "/subscriptionLocalizations"):
+			return jsonResponse(http.StatusOK, fixture.localizations)
+		case strings.HasSuffix(path, "/subscriptionAvailability") && fixture.availability != "":
+			return jsonResponse(http.StatusOK, fixture.availability)
+		case strings.HasSuffix(path, "/availableTerritories") && fixture.territories != "":
+			return jsonResponse(http.StatusOK, fixture.territories)
+		case strings.HasSuffix(path, "/prices") && fixture.prices != "":
+			return jsonResponse(http.StatusOK, fixture.prices)
+		case path == "/v1/apps/app-1/subscriptionGracePeriod" && fixture.gracePeriod != "":
+			return jsonResponse(http.StatusOK, fixture.gracePeriod)
+		case strings.HasSuffix(path, "/introductoryOffers") && req.URL.Query().Get("cursor") != "":
+			return jsonResponse(http.StatusOK, fixture.introductoryOffersNext)
+		case strings.HasSuffix(path, "/introductoryOffers") && fixture.introductoryOffers != "":
+			return jsonResponse(http.StatusOK, fixture.introductoryOffers)
 		case path == "/v1/apps/app-1/subscriptionGroups":
 			return jsonResponse(http.StatusOK, fixture.groups)
 		case strings.HasPrefix(path, "/v1/subscriptionGroups/") && strings.HasSuffix(path, "/subscriptions"):
@@ -60,10 +85,12 @@ func newValidateSubscriptionsClient(t *testing.T, fixture validateSubscriptionsF
 
 func validValidateSubscriptionsFixture() validateSubscriptionsFixture {
 	return validateSubscriptionsFixture{
+		app:    `{"data":{"type":"apps","id":"app-1","attributes":{"name":"Example","primaryLocale":"en-US"}}}`,
 		groups: `{"data":[{"type":"subscriptionGroups","id":"group-1","attributes":{"referenceName":"Group"}}]}`,
 		subscriptionsByGroup: map[string]string{
 			"group-1": `{"data":[{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly","productId":"com.example.monthly","state":"APPROVED"}}]}`,
 		},
+		localizations: `{"data":[{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Monthly","description":"Monthly access"}}]}`,
 	}
 }
 
@@ -197,3 +224,272 @@ func TestValidateSubscriptionsWarnsAndStrictFails(t *testing.T) {
 		t.Fatalf("expected subscriptions.review_readiness.needs_attention check, got %+v", strictReport.Checks)
 	}
 }
+
+func TestValidateSubscriptionsFlagsMissingLocalizations(t *testing.T) {
+	fixture := validValidateSubscriptionsFixture()
+	fixture.localizations = `{"data":[]}`
+
+	client := newValidateSubscriptionsClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 1 blocking issue(s)") {
+		t.Fatalf("expected blocking localization error, got %v", runErr)
+	}
+
+	var report validation.SubscriptionsReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if len(report.Checks) != 1 || report.Checks[0].ID != "subscriptions.localization.missing" {
+		t.Fatalf("expected subscriptions.localization.missing check, got %+v", report.Checks)
+	}
+}
+
+func TestValidateSubscriptionsCheckPricingWarns(t *testing.T) {
+	fixture := validValidateSubscriptionsFixture()
+	fixture.availability = `{"data":{"type":"subscriptionAvailabilities","id":"availability-1","attributes":{"availableInNewTerritories":true}}}`
+	fixture.territories = `{"data":[{"type":"territories","id":"USA"},{"type":"territories","id":"GBR"},{"type":"territories","id":"JPN"}]}`
+	fixture.prices = `{"data":[` +
+		`{"type":"subscriptionPrices","id":"price-usa","relationships":{"territory":{"data":{"type":"territories","id":"USA"}},"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"pp-usa"}}}},` +
+		`{"type":"subscriptionPrices","id":"price-gbr","relationships":{"territory":{"data":{"type":"territories","id":"GBR"}}}}` +
+		`],"included":[{"type":"subscriptionPricePoints","id":"pp-usa","attributes":{"customerPrice":"4.99"}}]}`
+
+	client := newValidateSubscriptionsClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1", "--check-pricing"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected pricing warnings not to block, got %v", err)
+		}
+	})
+
+	var report validation.SubscriptionsReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	messages := map[string]string{}
+	for _, check := range report.Checks {
+		if check.Severity != validation.SeverityWarning {
+			t.Fatalf("expected warnings only, got %+v", check)
+		}
+		messages[check.ID] = check.Message
+	}
+	if !strings.Contains(messages["subscriptions.pricing.territory_missing"], "GBR, JPN") {
+		t.Fatalf("expected missing territory warning for GBR and JPN, got %+v", report.Checks)
+	}
+	if !strings.Contains(messages["subscriptions.pricing.tier_missing"], "GBR") {
+		t.Fatalf("expected missing tier warning for GBR, got %+v", report.Checks)
+	}
+}
+
+func TestValidateSubscriptionsSkipsPricingByDefault(t *testing.T) {
+	fixture := validValidateSubscriptionsFixture()
+	fixture.availability = `{"data":{"type":"subscriptionAvailabilities","id":"availability-1"}}`
+	fixture.territories = `{"data":[{"type":"territories","id":"USA"}]}`
+
+	client := newValidateSubscriptionsClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var report validation.SubscriptionsReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if len(report.Checks) != 0 {
+		t.Fatalf("expected no checks without --check-pricing, got %+v", report.Checks)
+	}
+}
+
+func TestValidateSubscriptionsBlocksMissingMetadata(t *testing.T) {
+	fixture := validValidateSubscriptionsFixture()
+	fixture.subscriptionsByGroup = map[string]string{
+		"group-1": `{"data":[{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly","productId":"com.example.monthly","state":"MISSING_METADATA"}}]}`,
+	}
+
+	client := newValidateSubscriptionsClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 1 blocking issue(s)") {
+		t.Fatalf("expected blocking MISSING_METADATA error, got %v", runErr)
+	}
+
+	var report validation.SubscriptionsReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if len(report.Checks) != 1 || report.Checks[0].ID != "subscriptions.metadata.missing" {
+		t.Fatalf("expected only subscriptions.metadata.missing, got %+v", report.Checks)
+	}
+}
+
+func TestValidateSubscriptionsWarnsWhenGracePeriodDisabled(t *testing.T) {
+	fixture := validValidateSubscriptionsFixture()
+	fixture.gracePeriod = `{"data":{"type":"subscriptionGracePeriods","id":"gp-1","attributes":{"optIn":false,"duration":"SIXTEEN_DAYS"}}}`
+
+	client := newValidateSubscriptionsClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected warning-only result, got %v", err)
+		}
+	})
+
+	var report validation.SubscriptionsReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if len(report.Checks) != 1 || report.Checks[0].ID != "subscriptions.grace_period.disabled" || report.Checks[0].ResourceID != "gp-1" {
+		t.Fatalf("expected grace period warning, got %+v", report.Checks)
+	}
+}
+
+func TestValidateSubscriptionsWarnsForEndedIntroductoryOffers(t *testing.T) {
+	fixture := validValidateSubscriptionsFixture()
+	fixture.localizations = `{"data":[{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Monthly","description":"Includes a free trial"}}]}`
+	fixture.introductoryOffers = `{"data":[{"type":"subscriptionIntroductoryOffers","id":"offer-1","attributes":{"endDate":"2020-01-31","offerMode":"FREE_TRIAL","duration":"ONE_WEEK","numberOfPeriods":1}}]}`
+
+	client := newValidateSubscriptionsClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected warning-only result, got %v", err)
+		}
+	})
+
+	var report validation.SubscriptionsReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	for _, id := range []string{"subscriptions.intro_offer.expired", "subscriptions.intro_offer.missing"} {
+		found := false
+		for _, check := range report.Checks {
+			if check.ID == id && check.Severity == validation.SeverityWarning {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("expected %s warning, got %+v", id, report.Checks)
+		}
+	}
+}
+
+func TestValidateSubscriptionsPaginatesIntroductoryOffers(t *testing.T) {
+	fixture := validValidateSubscriptionsFixture()
+	fixture.localizations = `{"data":[{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Monthly","description":"Includes a free trial"}}]}`
+	fixture.introductoryOffers = `{"data":[{"type":"subscriptionIntroductoryOffers","id":"offer-1","attributes":{"endDate":"2020-01-31","offerMode":"FREE_TRIAL","duration":"ONE_WEEK","numberOfPeriods":1}}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/subscriptions/sub-1/introductoryOffers?cursor=2"}}`
+	fixture.introductoryOffersNext = `{"data":[{"type":"subscriptionIntroductoryOffers","id":"offer-2","attributes":{"offerMode":"FREE_TRIAL","duration":"ONE_WEEK","numberOfPeriods":1}}]}`
+
+	client := newValidateSubscriptionsClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1", "--concurrency", "2"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected warning-only result, got %v", err)
+		}
+	})
+
+	var report validation.SubscriptionsReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	for _, check := range report.Checks {
+		if check.ID == "subscriptions.intro_offer.missing" {
+			t.Fatalf("expected current offer on the second page to count, got %+v", report.Checks)
+		}
+	}
+}
+
+func TestValidateSubscriptionsRejectsInvalidConcurrency(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1", "--concurrency", "0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--concurrency must be >= 1") {
+		t.Fatalf("expected concurrency error, got %q", stderr)
+	}
+}
+
+func TestValidateSubscriptionsWithClientReturnsReport(t *testing.T) {
+	client := newValidateSubscriptionsClient(t, validValidateSubscriptionsFixture())
+
+	report, err := validate.ValidateSubscriptionsWithClient(context.Background(), client, validate.SubscriptionsOptions{AppID: "app-1"})
+	if err != nil {
+		t.Fatalf("ValidateSubscriptionsWithClient() error: %v", err)
+	}
+	if report.SubscriptionCount != 1 || len(report.Checks) != 0 {
+		t.Fatalf("expected one clean subscription, got count=%d checks=%+v", report.SubscriptionCount, report.Checks)
+	}
+}
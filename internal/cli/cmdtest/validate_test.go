@@ -3,11 +3,13 @@ package cmdtest
 import (
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -28,12 +30,16 @@ type validateFixture struct {
 	reviewDetails        string
 	primaryCategory      string
 	build                string
+	buildFailures        int
 	priceSchedule        string
 	availabilityV2       string
 	availabilityV2Status int
 	territories          string
 	screenshotSets       map[string]string
 	screenshotsBySet     map[string]string
+	iaps                 string
+	iapLocalizations     string
+	subscriptionGroups   string
 }
 
 func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
@@ -43,6 +49,7 @@ func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
 	keyPath := filepath.Join(tmpDir, "key.p8")
 	writeECDSAPEM(t, keyPath)
 
+	buildAttempts := 0
 	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
 		if req.Method != http.MethodGet {
 			return jsonResponse(http.StatusMethodNotAllowed, `{"errors":[{"status":405}]}`)
@@ -78,6 +85,10 @@ func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
 			}
 			return jsonResponse(http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","title":"Not Found","detail":"resource not found"}]}`)
 		case path == "/v1/appStoreVersions/ver-1/build":
+			buildAttempts++
+			if buildAttempts <= fixture.buildFailures {
+				return nil, errors.New("connection reset by peer")
+			}
 			if fixture.build != "" {
 				return jsonResponse(http.StatusOK, fixture.build)
 			}
@@ -102,14 +113,22 @@ func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
 			return jsonResponse(http.StatusOK, `{"data":[]}`)
 		case strings.HasPrefix(path, "/v1/appStoreVersionLocalizations/") && strings.HasSuffix(path, "/appScreenshotSets"):
 			localizationID := strings.TrimSuffix(strings.TrimPrefix(path, "/v1/appStoreVersionLocalizations/"), "/appScreenshotSets")
-			if body, ok := fixture.screenshotSets[localizationID]; ok {
+			if body, ok := fixture.screenshotSets[localizationID+cursorSuffix(req)]; ok {
 				return jsonResponse(http.StatusOK, body)
 			}
 		case strings.HasPrefix(path, "/v1/appScreenshotSets/") && strings.HasSuffix(path, "/appScreenshots"):
 			setID := strings.TrimSuffix(strings.TrimPrefix(path, "/v1/appScreenshotSets/"), "/appScreenshots")
-			if body, ok := fixture.screenshotsBySet[setID]; ok {
+			if body, ok := fixture.screenshotsBySet[setID+cursorSuffix(req)]; ok {
 				return jsonResponse(http.StatusOK, body)
 			}
+		case path == "/v1/apps/app-1/inAppPurchasesV2" && fixture.iaps != "":
+			return jsonResponse(http.StatusOK, fixture.iaps)
+		case strings.HasSuffix(path, "/inAppPurchaseLocalizations") && fixture.iapLocalizations != "":
+			return jsonResponse(http.StatusOK, fixture.iapLocalizations)
+		case path == "/v1/apps/app-1/subscriptionGroups" && fixture.subscriptionGroups != "":
+			return jsonResponse(http.StatusOK, fixture.subscriptionGroups)
+		case strings.HasSuffix(path, "/iapPriceSchedule"), strings.HasSuffix(path, "/inAppPurchaseAvailability"):
+			return jsonResponse(http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","title":"Not Found","detail":"resource not found"}]}`)
 		}
 
 		return jsonResponse(http.StatusNotFound, `{"errors":[{"status":404}]}`)
@@ -123,6 +142,14 @@ func newValidateTestClient(t *testing.T, fixture validateFixture) *asc.Client {
 	return client
 }
 
+// cursorSuffix keys follow-up page fixtures as "<id>?cursor=<cursor>".
+func cursorSuffix(req *http.Request) string {
+	if cursor := req.URL.Query().Get("cursor"); cursor != "" {
+		return "?cursor=" + cursor
+	}
+	return ""
+}
+
 func jsonResponse(status int, body string) (*http.Response, error) {
 	return &http.Response{
 		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
@@ -298,6 +325,199 @@ func TestValidateOutputsJSONAndTable(t *testing.T) {
 	}
 }
 
+func TestValidateOutputsJUnit(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.versionLocs = `{"data":[{"type":"appStoreVersionLocalizations","id":"ver-loc-1","attributes":{"locale":"en-US","description":"","keywords":"keyword","supportUrl":"https://support.example.com"}}]}`
+	fixture.appInfoLocs = `{"data":[{"type":"appInfoLocalizations","id":"info-loc-1","attributes":{"locale":"en-US","name":"My App","privacyPolicyUrl":"https://example.com/privacy"}}]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--output", "junit"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if _, ok := errors.AsType[ReportedError](err); !ok {
+			t.Fatalf("expected ReportedError, got %v", err)
+		}
+	})
+
+	var suite struct {
+		XMLName   xml.Name `xml:"testsuite"`
+		Name      string   `xml:"name,attr"`
+		Tests     int      `xml:"tests,attr"`
+		Failures  int      `xml:"failures,attr"`
+		TestCases []struct {
+			Name      string `xml:"name,attr"`
+			Classname string `xml:"classname,attr"`
+			Failure   *struct {
+				Message string `xml:"message,attr"`
+				Type    string `xml:"type,attr"`
+			} `xml:"failure"`
+		} `xml:"testcase"`
+	}
+	if err := xml.Unmarshal([]byte(stdout), &suite); err != nil {
+		t.Fatalf("failed to parse JUnit output: %v\n%s", err, stdout)
+	}
+	if suite.Name != "asc validate" {
+		t.Fatalf("expected suite name %q, got %q", "asc validate", suite.Name)
+	}
+	if suite.Tests != 2 || suite.Failures != 1 {
+		t.Fatalf("expected 2 tests and 1 failure, got tests=%d failures=%d", suite.Tests, suite.Failures)
+	}
+	var failed, passed int
+	for _, tc := range suite.TestCases {
+		if tc.Failure != nil {
+			failed++
+			if tc.Name != "metadata.required.description en-US ver-loc-1" || tc.Classname != "validate.metadata" {
+				t.Fatalf("unexpected failing test case %+v", tc)
+			}
+			if tc.Failure.Type != "ERROR" || tc.Failure.Message != "description is required" {
+				t.Fatalf("unexpected failure %+v", tc.Failure)
+			}
+			continue
+		}
+		passed++
+	}
+	if failed != 1 || passed != 1 {
+		t.Fatalf("expected 1 failed and 1 passed test case, got %d/%d", failed, passed)
+	}
+}
+
+func TestValidateOutputsGitHubAnnotations(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.versionLocs = `{"data":[{"type":"appStoreVersionLocalizations","id":"ver-loc-1","attributes":{"locale":"en-US","description":"","keywords":"keyword","supportUrl":"https://support.example.com"}}]}`
+	fixture.appInfoLocs = `{"data":[{"type":"appInfoLocalizations","id":"info-loc-1","attributes":{"locale":"en-US","name":"My App","privacyPolicyUrl":"https://example.com/privacy"}}]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--output", "github"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if _, ok := errors.AsType[ReportedError](err); !ok {
+			t.Fatalf("expected ReportedError, got %v", err)
+		}
+	})
+
+	want := "::error title=metadata.required.description::description is required (locale en-US, field description, appStoreVersionLocalization ver-loc-1)%0AProvide a description for this localization\n" +
+		"::warning title=metadata.required.subtitle::subtitle is empty (locale en-US, field subtitle, appInfoLocalization info-loc-1)%0AProvide a subtitle for this localization\n"
+	if stdout != want {
+		t.Fatalf("unexpected annotations:\n%s\nwant:\n%s", stdout, want)
+	}
+}
+
+func TestValidateSetOutputWritesGitHubOutputs(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.versionLocs = `{"data":[{"type":"appStoreVersionLocalizations","id":"ver-loc-1","attributes":{"locale":"en-US","description":"","keywords":"keyword","supportUrl":"https://support.example.com"}}]}`
+	fixture.appInfoLocs = `{"data":[{"type":"appInfoLocalizations","id":"info-loc-1","attributes":{"locale":"en-US","name":"My App","privacyPolicyUrl":"https://example.com/privacy"}}]}`
+	outputPath := filepath.Join(t.TempDir(), "github-output")
+	t.Setenv("GITHUB_OUTPUT", outputPath)
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--set-output"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if _, ok := errors.AsType[ReportedError](err); !ok {
+			t.Fatalf("expected ReportedError, got %v", err)
+		}
+	})
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read GITHUB_OUTPUT: %v", err)
+	}
+	want := "ready=false\nerror_count=1\nwarning_count=1\nblocking_count=1\n"
+	if string(data) != want {
+		t.Fatalf("expected GITHUB_OUTPUT %q, got %q", want, string(data))
+	}
+}
+
+func TestValidateSetOutputRequiresGitHubOutputEnv(t *testing.T) {
+	t.Setenv("GITHUB_OUTPUT", "")
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		t.Fatal("client should not be created without GITHUB_OUTPUT")
+		return nil, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--set-output"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--set-output requires GITHUB_OUTPUT to be set") {
+		t.Fatalf("expected GITHUB_OUTPUT error, got %q", stderr)
+	}
+}
+
+func TestValidateRejectsUnsupportedOutput(t *testing.T) {
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		t.Fatal("client should not be created for invalid output")
+		return nil, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--output", "xml"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "unsupported format: xml") {
+		t.Fatalf("expected unsupported format error, got %q", stderr)
+	}
+}
+
+func TestValidateRejectsInvalidConcurrency(t *testing.T) {
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		t.Fatal("client should not be created for invalid --concurrency")
+		return nil, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--concurrency", "0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "--concurrency must be >= 1") {
+		t.Fatalf("expected --concurrency error, got %q", stderr)
+	}
+}
+
 func TestValidateSupportsVersionLookup(t *testing.T) {
 	fixture := validValidateFixture()
 	client := newValidateTestClient(t, fixture)
@@ -400,6 +620,92 @@ func TestValidateWarnsWhenPrivacyPolicyURLMissing(t *testing.T) {
 	}
 }
 
+func TestValidateFollowsScreenshotPagination(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.screenshotSets = map[string]string{
+		"ver-loc-1":          `{"data":[{"type":"appScreenshotSets","id":"set-1","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/appStoreVersionLocalizations/ver-loc-1/appScreenshotSets?cursor=2"}}`,
+		"ver-loc-1?cursor=2": `{"data":[{"type":"appScreenshotSets","id":"set-2","attributes":{"screenshotDisplayType":"APP_IPAD_PRO_3GEN_129"}}]}`,
+	}
+	fixture.screenshotsBySet = map[string]string{
+		"set-1":          `{"data":[{"type":"appScreenshots","id":"shot-1","attributes":{"fileName":"shot.png","imageAsset":{"width":1242,"height":2688}}}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/appScreenshotSets/set-1/appScreenshots?cursor=2"}}`,
+		"set-1?cursor=2": `{"data":[{"type":"appScreenshots","id":"shot-2","attributes":{"fileName":"wrong.png","imageAsset":{"width":100,"height":100}}}]}`,
+		"set-2":          `{"data":[{"type":"appScreenshots","id":"shot-3","attributes":{"fileName":"ipad.png","imageAsset":{"width":100,"height":100}}}]}`,
+	}
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		_ = root.Run(context.Background())
+	})
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\nstdout=%s", err, stdout)
+	}
+	mismatched := map[string]bool{}
+	for _, check := range report.Checks {
+		if check.ID == "screenshots.dimension_mismatch" {
+			mismatched[check.ResourceID] = true
+		}
+	}
+	if !mismatched["shot-2"] || !mismatched["shot-3"] {
+		t.Fatalf("expected dimension checks for screenshots on later pages, got %+v", report.Checks)
+	}
+}
+
+func TestValidateKeepsScreenshotChecksInLocalizationOrder(t *testing.T) {
+	fixture := validValidateFixture()
+	locales := []string{"en-US", "de-DE", "fr-FR", "ja", "es-ES", "it"}
+	var locs []string
+	fixture.screenshotSets = map[string]string{}
+	fixture.screenshotsBySet = map[string]string{}
+	for i, locale := range locales {
+		locID := fmt.Sprintf("ver-loc-%d", i+1)
+		setID := fmt.Sprintf("set-%d", i+1)
+		locs = append(locs, fmt.Sprintf(`{"type":"appStoreVersionLocalizations","id":%q,"attributes":{"locale":%q,"description":"Description","keywords":"keyword","whatsNew":"Notes","supportUrl":"https://support.example.com"}}`, locID, locale))
+		fixture.screenshotSets[locID] = fmt.Sprintf(`{"data":[{"type":"appScreenshotSets","id":%q,"attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}]}`, setID)
+		fixture.screenshotsBySet[setID] = fmt.Sprintf(`{"data":[{"type":"appScreenshots","id":"shot-%d","attributes":{"fileName":"shot.png","imageAsset":{"width":100,"height":100}}}]}`, i+1)
+	}
+	fixture.versionLocs = `{"data":[` + strings.Join(locs, ",") + `]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		_ = root.Run(context.Background())
+	})
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\nstdout=%s", err, stdout)
+	}
+	var got []string
+	for _, check := range report.Checks {
+		if check.ID == "screenshots.dimension_mismatch" {
+			got = append(got, check.ResourceID)
+		}
+	}
+	want := []string{"shot-1", "shot-2", "shot-3", "shot-4", "shot-5", "shot-6"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected dimension checks in localization order %v, got %v", want, got)
+	}
+}
+
 func TestValidateFailsForNonEditableVersionState(t *testing.T) {
 	fixture := validValidateFixture()
 	fixture.version = `{"data":{"type":"appStoreVersions","id":"ver-1","attributes":{"platform":"IOS","versionString":"1.0","appVersionState":"WAITING_FOR_REVIEW"}}}`
@@ -475,6 +781,76 @@ func TestValidateSkipsWhatsNewOnInitialRelease(t *testing.T) {
 	}
 }
 
+func TestValidateWarnsWhatsNewWhenAppHasPriorVersions(t *testing.T) {
+	fixture := validValidateFixture()
+	// A "1.0" version string is not an initial release when the app already
+	// has other App Store versions on the platform.
+	fixture.versions = `{"data":[{"type":"appStoreVersions","id":"ver-1","attributes":{"platform":"IOS","versionString":"1.0"}},{"type":"appStoreVersions","id":"ver-0","attributes":{"platform":"IOS","versionString":"0.9"}}]}`
+	fixture.versionLocs = `{"data":[{"type":"appStoreVersionLocalizations","id":"ver-loc-1","attributes":{"locale":"en-US","description":"Description","keywords":"keyword","whatsNew":"  ","promotionalText":"Promo","supportUrl":"https://support.example.com","marketingUrl":"https://marketing.example.com"}}]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--strict"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil {
+			t.Fatalf("expected error with --strict")
+		}
+		if _, ok := errors.AsType[ReportedError](err); !ok {
+			t.Fatalf("expected ReportedError, got %v", err)
+		}
+	})
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if !hasCheckWithID(report.Checks, "metadata.required.whats_new") {
+		t.Fatalf("expected metadata.required.whats_new check, got %+v", report.Checks)
+	}
+	if report.Summary.Warnings != 1 || report.Summary.Blocking != 1 {
+		t.Fatalf("expected one blocking warning, got %+v", report.Summary)
+	}
+}
+
+func TestValidateSkipsWhatsNewForAppsFirstVersion(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.versions = `{"data":[{"type":"appStoreVersions","id":"ver-1","attributes":{"platform":"IOS","versionString":"2.0"}}]}`
+	fixture.version = `{"data":{"type":"appStoreVersions","id":"ver-1","attributes":{"platform":"IOS","versionString":"2.0","appVersionState":"PREPARE_FOR_SUBMISSION"}}}`
+	fixture.versionLocs = `{"data":[{"type":"appStoreVersionLocalizations","id":"ver-loc-1","attributes":{"locale":"en-US","description":"Description","keywords":"keyword","promotionalText":"Promo","supportUrl":"https://support.example.com","marketingUrl":"https://marketing.example.com"}}]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--strict"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected no error with --strict, got %v", err)
+		}
+	})
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if hasCheckWithID(report.Checks, "metadata.required.whats_new") {
+		t.Fatalf("did not expect metadata.required.whats_new check for the app's first version")
+	}
+}
+
 func TestValidateMixedWarningAndError(t *testing.T) {
 	fixture := validValidateFixture()
 	fixture.versionLocs = `{"data":[{"type":"appStoreVersionLocalizations","id":"ver-loc-1","attributes":{"locale":"en-US","description":"","keywords":"keyword","supportUrl":"https://support.example.com"}}]}`
@@ -764,6 +1140,38 @@ func TestValidateFailsWhenBuildMissing(t *testing.T) {
 	}
 }
 
+func TestValidateRetriesTransientBuildFetchError(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.buildFailures = 1
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("expected retry to recover, got %v", runErr)
+	}
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if hasCheckWithID(report.Checks, "build.required.missing") {
+		t.Fatalf("expected attached build after retry, got %+v", report.Checks)
+	}
+}
+
 func TestValidateFailsWhenBuildMissingWithNullData(t *testing.T) {
 	fixture := validValidateFixture()
 	fixture.build = `{"data":null}`
@@ -979,3 +1387,52 @@ func TestValidateFailsWhenNoTerritoriesAvailable(t *testing.T) {
 		t.Fatalf("expected availability.territories.none check, got %+v", report.Checks)
 	}
 }
+
+func TestValidateLocalesOmitsOutOfScopeFindings(t *testing.T) {
+	fixture := validValidateFixture()
+	fixture.appInfoLocs = `{"data":[{"type":"appInfoLocalizations","id":"info-loc-1","attributes":{"locale":"en-US","name":"My App","subtitle":"Subtitle"}}]}`
+
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--locales", "fr-fr"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if hasCheckWithID(report.Checks, "metadata.recommended.privacy_policy_url") {
+		t.Fatalf("expected en-US privacy policy warning to be out of scope, got %+v", report.Checks)
+	}
+}
+
+func TestValidateRejectsUnsupportedLocales(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--locales", "en-US,xx"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if !strings.Contains(stderr, `--locales: unsupported locale "xx"`) {
+		t.Fatalf("expected unsupported locale error, got %q", stderr)
+	}
+}
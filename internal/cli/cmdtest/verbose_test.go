@@ -0,0 +1,66 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestRootVerboseLogsRequestsToStderrOnly(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Cleanup(func() { asc.SetVerboseLevel(0) })
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/certificates" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"certificates","id":"cert-verbose-1"}],"links":{}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--verbose=2", "certificates", "list"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	if strings.Contains(stdout, "→") || strings.Contains(stdout, "←") {
+		t.Fatalf("expected verbose logs to stay off stdout, got %q", stdout)
+	}
+	if !strings.Contains(stdout, "cert-verbose-1") {
+		t.Fatalf("expected certificate output on stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "→ GET https://api.appstoreconnect.apple.com/v1/certificates") {
+		t.Fatalf("expected request line on stderr, got %q", stderr)
+	}
+	if !strings.Contains(stderr, "← 200 OK GET") {
+		t.Fatalf("expected response status on stderr, got %q", stderr)
+	}
+	if !strings.Contains(stderr, "Authorization: Bearer [REDACTED]") {
+		t.Fatalf("expected redacted Authorization header on stderr, got %q", stderr)
+	}
+	if !strings.Contains(stderr, `"id":"cert-verbose-1"`) {
+		t.Fatalf("expected response body on stderr, got %q", stderr)
+	}
+}
@@ -0,0 +1,63 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestVersionCommandPrintsBuildMetadataJSON(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(&strings.Builder{})
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"version", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Version   string `json:"version"`
+		GoVersion string `json:"goVersion"`
+		Platform  string `json:"platform"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if payload.Version != "1.2.3" {
+		t.Fatalf("expected version 1.2.3, got %q", payload.Version)
+	}
+	if payload.GoVersion != runtime.Version() {
+		t.Fatalf("expected goVersion %q, got %q", runtime.Version(), payload.GoVersion)
+	}
+	if payload.Platform != runtime.GOOS+"/"+runtime.GOARCH {
+		t.Fatalf("unexpected platform %q", payload.Platform)
+	}
+}
+
+func TestVersionCommandRejectsUnsupportedOutput(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(&strings.Builder{})
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"version", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "--output must be one of: text, json") {
+		t.Fatalf("expected output validation error, got %q", stderr)
+	}
+}
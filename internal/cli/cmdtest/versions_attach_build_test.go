@@ -0,0 +1,115 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func versionsAttachBuildTransport(t *testing.T, buildAppID, processingState string, patched *bool) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1":
+			if req.URL.Query().Get("include") != "app" {
+				t.Fatalf("expected include=app, got %q", req.URL.Query().Get("include"))
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{},"relationships":{"app":{"data":{"type":"apps","id":"app-1"}}}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1/app":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"`+buildAppID+`","attributes":{}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds/build-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"`+processingState+`"}}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersions/version-1/relationships/build":
+			*patched = true
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       io.NopCloser(strings.NewReader("")),
+				Header:     http.Header{},
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+}
+
+func TestVersionsAttachBuildValidatesBeforeAttaching(t *testing.T) {
+	tests := []struct {
+		name            string
+		buildAppID      string
+		processingState string
+		wantErr         string
+	}{
+		{
+			name:            "valid build is attached",
+			buildAppID:      "app-1",
+			processingState: "VALID",
+		},
+		{
+			name:            "build from another app",
+			buildAppID:      "app-2",
+			processingState: "VALID",
+			wantErr:         `build "build-1" belongs to app "app-2", but version "version-1" belongs to app "app-1"`,
+		},
+		{
+			name:            "build still processing",
+			buildAppID:      "app-1",
+			processingState: "PROCESSING",
+			wantErr:         `build "build-1" has processing state PROCESSING; only VALID builds can be attached`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+
+			patched := false
+			http.DefaultTransport = versionsAttachBuildTransport(t, test.buildAppID, test.processingState, &patched)
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse([]string{"versions", "attach-build", "--version-id", "version-1", "--build", "build-1"}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantErr == "" {
+				if runErr != nil {
+					t.Fatalf("run error: %v", runErr)
+				}
+				if !patched {
+					t.Fatal("expected build relationship PATCH")
+				}
+				if !strings.Contains(stdout, `"attached":true`) {
+					t.Fatalf("expected attached result, got %q", stdout)
+				}
+				return
+			}
+
+			if runErr == nil || errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected runtime error, got %v", runErr)
+			}
+			if !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error %q, got %v", test.wantErr, runErr)
+			}
+			if patched {
+				t.Fatal("expected no PATCH when validation fails")
+			}
+		})
+	}
+}
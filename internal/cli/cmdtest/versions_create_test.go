@@ -0,0 +1,117 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func runVersionsCreate(t *testing.T, transport roundTripFunc, args ...string) (string, error) {
+	t.Helper()
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = transport
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"versions", "create"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, runErr
+}
+
+func TestVersionsCreateRefusesDuplicateVersion(t *testing.T) {
+	stdout, err := runVersionsCreate(t, func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/APP_ID/appStoreVersions" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("filter[versionString]") != "2.0.0" || query.Get("filter[platform]") != "IOS" {
+			t.Fatalf("unexpected duplicate-check filters: %s", req.URL.RawQuery)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"VERSION_1","attributes":{"versionString":"2.0.0","platform":"IOS"}}]}`)
+	}, "--app", "APP_ID", "--version", "2.0.0", "--platform", "ios")
+
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected duplicate error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `version "2.0.0" already exists for platform IOS (id VERSION_1)`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !errors.Is(err, asc.ErrConflict) {
+		t.Fatalf("expected duplicate error to wrap asc.ErrConflict, got %v", err)
+	}
+	if stdout != "" {
+		t.Fatalf("expected no stdout, got %q", stdout)
+	}
+}
+
+func TestVersionsCreateDuplicateVersionExitCode(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"VERSION_1","attributes":{"versionString":"2.0.0","platform":"IOS"}}]}`)
+	})
+
+	captureOutput(t, func() {
+		code := cmd.Run([]string{"versions", "create", "--app", "APP_ID", "--version", "2.0.0", "--platform", "ios"}, "1.2.3")
+		if code != cmd.ExitConflict {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitConflict, code)
+		}
+	})
+}
+
+func TestVersionsCreatePostsNewVersion(t *testing.T) {
+	var posted bool
+	stdout, err := runVersionsCreate(t, func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID/appStoreVersions":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersions":
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"versionString":"2.0.0"`) || !strings.Contains(string(body), `"platform":"MAC_OS"`) {
+				t.Fatalf("unexpected create body: %s", body)
+			}
+			posted = true
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersions","id":"NEW_VERSION","attributes":{"versionString":"2.0.0","platform":"MAC_OS","appStoreState":"PREPARE_FOR_SUBMISSION"}}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return nil, nil
+	}, "--app", "APP_ID", "--version", "2.0.0", "--platform", "MAC_OS")
+
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !posted {
+		t.Fatal("expected create request")
+	}
+	if !strings.Contains(stdout, `"id":"NEW_VERSION"`) {
+		t.Fatalf("expected new version ID in output, got %q", stdout)
+	}
+}
@@ -0,0 +1,52 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVersionsListFiltersByStateAndPlatform(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/APP_ID/appStoreVersions" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		query := req.URL.Query()
+		if got := query.Get("filter[appStoreState]"); got != "PREPARE_FOR_SUBMISSION" {
+			t.Fatalf("expected state filter, got %q (%s)", got, req.URL.RawQuery)
+		}
+		if got := query.Get("filter[platform]"); got != "IOS" {
+			t.Fatalf("expected platform filter, got %q", got)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"VERSION_1","attributes":{"versionString":"2.0.0","platform":"IOS","appStoreState":"PREPARE_FOR_SUBMISSION","createdDate":"2026-01-02T03:04:05Z"}}]}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "list", "--app", "APP_ID", "--state", "prepare_for_submission", "--platform", "ios", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	for _, want := range []string{"VERSION_1", "2.0.0", "IOS", "PREPARE_FOR_SUBMISSION", "2026-01-02T03:04:05Z"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in table output, got %q", want, stdout)
+		}
+	}
+}
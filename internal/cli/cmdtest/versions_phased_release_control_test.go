@@ -0,0 +1,133 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestVersionsPhasedReleaseControlChecksCurrentState(t *testing.T) {
+	tests := []struct {
+		name        string
+		subcommand  string
+		extraArgs   []string
+		current     string
+		wantState   string
+		wantErr     string
+		wantPatched bool
+	}{
+		{
+			name:        "pause active rollout",
+			subcommand:  "pause",
+			current:     "ACTIVE",
+			wantState:   "PAUSED",
+			wantPatched: true,
+		},
+		{
+			name:        "complete paused rollout",
+			subcommand:  "complete",
+			extraArgs:   []string{"--confirm"},
+			current:     "PAUSED",
+			wantState:   "COMPLETE",
+			wantPatched: true,
+		},
+		{
+			name:       "resume rejects active rollout",
+			subcommand: "resume",
+			current:    "ACTIVE",
+			wantErr:    `phased release "phased-1" is ACTIVE; resume requires PAUSED`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+
+			patched := false
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreVersionPhasedRelease":
+					return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersionPhasedReleases","id":"phased-1","attributes":{"phasedReleaseState":"`+test.current+`"}}}`)
+				case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionPhasedReleases/phased-1":
+					patched = true
+					body, _ := io.ReadAll(req.Body)
+					if !strings.Contains(string(body), `"phasedReleaseState":"`+test.wantState+`"`) {
+						t.Fatalf("expected state %s in body, got %s", test.wantState, body)
+					}
+					return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersionPhasedReleases","id":"phased-1","attributes":{"phasedReleaseState":"`+test.wantState+`"}}}`)
+				default:
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+					return nil, nil
+				}
+			})
+
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, _ := captureOutput(t, func() {
+				args := append([]string{"versions", "phased-release", test.subcommand, "--version-id", "version-1"}, test.extraArgs...)
+				if err := root.Parse(args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if patched != test.wantPatched {
+				t.Fatalf("expected patched=%t, got %t", test.wantPatched, patched)
+			}
+			if test.wantErr == "" {
+				if runErr != nil {
+					t.Fatalf("run error: %v", runErr)
+				}
+				if !strings.Contains(stdout, `"phasedReleaseState":"`+test.wantState+`"`) {
+					t.Fatalf("expected resulting state in output, got %q", stdout)
+				}
+				return
+			}
+			if runErr == nil || errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected runtime error, got %v", runErr)
+			}
+			if !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
+
+func TestVersionsPhasedReleaseCompleteRequiresConfirm(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"versions", "phased-release", "complete", "--version-id", "version-1"}, "1.2.3")
+		if code != cmd.ExitUsage {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitUsage, code)
+		}
+	})
+	if !strings.Contains(stderr, "--confirm is required") {
+		t.Fatalf("expected --confirm error, got %q", stderr)
+	}
+}
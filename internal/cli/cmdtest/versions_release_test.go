@@ -0,0 +1,76 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runVersionsRelease(t *testing.T, transport roundTripFunc) (string, error) {
+	t.Helper()
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = transport
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "release", "--version-id", "VERSION_123", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, runErr
+}
+
+func TestVersionsReleaseReportsResultingState(t *testing.T) {
+	released := false
+	stdout, err := runVersionsRelease(t, func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_123":
+			state := "PENDING_DEVELOPER_RELEASE"
+			if released {
+				state = "PROCESSING_FOR_APP_STORE"
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersions","id":"VERSION_123","attributes":{"appStoreState":"`+state+`"}}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersionReleaseRequests":
+			released = true
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"appStoreVersionReleaseRequests","id":"RELEASE_1"}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !released {
+		t.Fatal("expected release request")
+	}
+	for _, want := range []string{`"releaseRequestId":"RELEASE_1"`, `"state":"PROCESSING_FOR_APP_STORE"`} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %s in output, got %q", want, stdout)
+		}
+	}
+}
+
+func TestVersionsReleaseRefusesWrongState(t *testing.T) {
+	_, err := runVersionsRelease(t, func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersions","id":"VERSION_123","attributes":{"appStoreState":"WAITING_FOR_REVIEW"}}}`)
+	})
+	if err == nil || !strings.Contains(err.Error(), "version VERSION_123 is in state WAITING_FOR_REVIEW; only PENDING_DEVELOPER_RELEASE versions can be released") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
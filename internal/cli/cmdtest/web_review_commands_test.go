@@ -16,6 +16,8 @@ func TestWebReviewCommandsAreRegisteredAndLegacyRemoved(t *testing.T) {
 		{"web", "review"},
 		{"web", "review", "list"},
 		{"web", "review", "show"},
+		{"web", "review", "download"},
+		{"web", "review", "reply"},
 	} {
 		if sub := findSubcommand(root, path...); sub == nil {
 			t.Fatalf("expected command %q to be registered", strings.Join(path, " "))
@@ -151,3 +153,110 @@ func TestWebReviewShowRejectsInvalidPattern(t *testing.T) {
 		t.Fatalf("expected invalid pattern message, got %q", stderr)
 	}
 }
+
+func TestWebReviewDownloadAndReplyValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing submission",
+			args:    []string{"web", "review", "download", "--out", "files"},
+			wantErr: "--submission is required",
+		},
+		{
+			name:    "skip existing with overwrite",
+			args:    []string{"web", "review", "download", "--submission", "SUB_1", "--skip-existing", "--overwrite"},
+			wantErr: "--skip-existing and --overwrite are mutually exclusive",
+		},
+		{
+			name:    "invalid pattern",
+			args:    []string{"web", "review", "download", "--submission", "SUB_1", "--pattern", "["},
+			wantErr: "--pattern is invalid",
+		},
+		{
+			name:    "reply missing submission",
+			args:    []string{"web", "review", "reply", "--message", "hi", "--confirm"},
+			wantErr: "--submission is required",
+		},
+		{
+			name:    "reply missing message",
+			args:    []string{"web", "review", "reply", "--submission", "SUB_1", "--confirm"},
+			wantErr: "--message or --message-file is required",
+		},
+		{
+			name:    "reply message and file",
+			args:    []string{"web", "review", "reply", "--submission", "SUB_1", "--message", "hi", "--message-file", "reply.txt", "--confirm"},
+			wantErr: "--message and --message-file are mutually exclusive",
+		},
+		{
+			name:    "reply missing confirm",
+			args:    []string{"web", "review", "reply", "--submission", "SUB_1", "--message", "hi"},
+			wantErr: "--confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestWebReviewListRejectsInvalidFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "unknown platform",
+			args:    []string{"web", "review", "list", "--app", "123456789", "--filter-platform", "ANDROID"},
+			wantErr: "--platform must be one of",
+		},
+		{
+			name:    "conflicting state alias",
+			args:    []string{"web", "review", "list", "--app", "123456789", "--state", "COMPLETE", "--filter-state", "UNRESOLVED_ISSUES"},
+			wantErr: "--state and --filter-state are mutually exclusive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package configcmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// ConfigCommand returns the config command group.
+func ConfigCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "config",
+		ShortUsage: "asc config <subcommand> [flags]",
+		ShortHelp:  "Manage persisted defaults in the config file.",
+		LongHelp: `Manage persisted defaults in the config file.
+
+Values are stored in the active config file (ASC_CONFIG_PATH, the nearest
+./.asc/config.json, or ~/.asc/config.json). Flags override environment
+variables, which override config values: for example --app > ASC_APP_ID >
+app_id in config.
+
+Credentials are managed with "asc auth" instead.
+
+Examples:
+  asc config set app 123456789
+  asc config get app
+  asc config list`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ConfigGetCommand(),
+			ConfigSetCommand(),
+			ConfigListCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ConfigGetCommand returns the config get subcommand.
+func ConfigGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config get", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc config get <key>",
+		ShortHelp:  "Print a persisted default.",
+		LongHelp: fmt.Sprintf(`Print a persisted default.
+
+Keys: %s ("app" is an alias for app_id).
+
+Examples:
+  asc config get app`, strings.Join(config.SettingKeys(), ", ")),
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return shared.UsageError("config get requires exactly one key")
+			}
+			if _, err := config.ResolveSettingKey(args[0]); err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return fmt.Errorf("config get: %w", err)
+			}
+
+			value, err := cfg.GetSetting(args[0])
+			if err != nil {
+				return fmt.Errorf("config get: %w", err)
+			}
+			fmt.Println(value)
+			return nil
+		},
+	}
+}
+
+// ConfigSetCommand returns the config set subcommand.
+func ConfigSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config set", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc config set <key> <value>",
+		ShortHelp:  "Persist a default in the config file.",
+		LongHelp: fmt.Sprintf(`Persist a default in the config file.
+
+Keys: %s ("app" is an alias for app_id).
+Set an empty value to clear a key.
+
+Examples:
+  asc config set app 123456789
+  asc config set timeout 2m
+  asc config set app ""`, strings.Join(config.SettingKeys(), ", ")),
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) != 2 {
+				return shared.UsageError("config set requires a key and a value")
+			}
+			key, err := config.ResolveSettingKey(args[0])
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return fmt.Errorf("config set: %w", err)
+			}
+			if err := cfg.SetSetting(key, args[1]); err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			path, err := config.Path()
+			if err != nil {
+				return fmt.Errorf("config set: %w", err)
+			}
+			if err := config.SaveAt(path, cfg); err != nil {
+				return fmt.Errorf("config set: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "Set %s in %s\n", key, path)
+			return nil
+		},
+	}
+}
+
+// ConfigListCommand returns the config list subcommand.
+func ConfigListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("config list", flag.ExitOnError)
+
+	output := shared.BindOutputFlagsWith(fs, "output", "text", "Output format: text (default), json")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc config list [flags]",
+		ShortHelp:  "List persisted defaults.",
+		LongHelp: `List persisted defaults.
+
+Examples:
+  asc config list
+  asc config list --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("config list does not accept positional arguments")
+			}
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "text", "json")
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return fmt.Errorf("config list: %w", err)
+			}
+
+			values := cfg.Settings()
+			if normalizedOutput == "json" {
+				return shared.PrintOutput(values, "json", *output.Pretty)
+			}
+			for _, key := range config.SettingKeys() {
+				fmt.Printf("%s=%s\n", key, values[key])
+			}
+			return nil
+		},
+	}
+}
+
+// loadConfig returns the active config, or an empty one when no file exists yet.
+func loadConfig() (*config.Config, error) {
+	cfg, err := config.Load()
+	if errors.Is(err, config.ErrNotFound) {
+		return &config.Config{}, nil
+	}
+	return cfg, err
+}
@@ -100,7 +100,7 @@ Examples:
 				// Fetch all remaining pages
 				crashes, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetCrashes(ctx, resolvedAppID, asc.WithCrashNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("crashes: %w", err)
 				}
@@ -153,7 +153,7 @@ Examples:
 
 				devices, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("devices list: %w", err)
 				}
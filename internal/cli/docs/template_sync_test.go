@@ -37,6 +37,10 @@ func TestASCTemplateIncludesAllRootFlags(t *testing.T) {
 	root := cmd.RootCommand("test")
 	rootFlags := []string{}
 	root.FlagSet.VisitAll(func(f *flag.Flag) {
+		if len(f.Name) == 1 {
+			rootFlags = append(rootFlags, "-"+f.Name)
+			return
+		}
 		rootFlags = append(rootFlags, "--"+f.Name)
 	})
 
@@ -103,7 +103,7 @@ Examples:
 				// Fetch all remaining pages
 				feedback, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetFeedback(ctx, resolvedAppID, asc.WithFeedbackNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("feedback: %w", err)
 				}
@@ -129,7 +129,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterGroups(ctx, asc.WithGCGroupsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center groups list: %w", err)
 				}
@@ -426,7 +426,7 @@ Examples:
 					return fmt.Errorf("game-center groups achievements list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, fetch)
+				resp, err := asc.PaginateAll(requestCtx, firstPage, fetch, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center groups achievements list: %w", err)
 				}
@@ -600,7 +600,7 @@ Examples:
 					return fmt.Errorf("game-center groups leaderboards list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, fetch)
+				resp, err := asc.PaginateAll(requestCtx, firstPage, fetch, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center groups leaderboards list: %w", err)
 				}
@@ -772,7 +772,7 @@ Examples:
 					return fmt.Errorf("game-center groups leaderboard-sets list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, fetch)
+				resp, err := asc.PaginateAll(requestCtx, firstPage, fetch, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center groups leaderboard-sets list: %w", err)
 				}
@@ -876,7 +876,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterGroupActivities(ctx, id, asc.WithGCActivitiesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center groups activities list: %w", err)
 				}
@@ -977,7 +977,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterGroupChallenges(ctx, id, asc.WithGCChallengesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center groups challenges list: %w", err)
 				}
@@ -1128,7 +1128,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterGroupGameCenterDetails(ctx, id, asc.WithGCDetailsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center groups details list: %w", err)
 				}
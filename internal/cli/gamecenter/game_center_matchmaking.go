@@ -130,7 +130,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingQueues(ctx, asc.WithGCMatchmakingQueuesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center matchmaking queues list: %w", err)
 				}
@@ -439,7 +439,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingRuleSets(ctx, asc.WithGCMatchmakingRuleSetsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center matchmaking rule-sets list: %w", err)
 				}
@@ -755,7 +755,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingRuleSetQueues(ctx, id, asc.WithGCMatchmakingQueuesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center matchmaking rule-sets queues list: %w", err)
 				}
@@ -860,7 +860,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingRules(ctx, id, asc.WithGCMatchmakingRulesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center matchmaking rules list: %w", err)
 				}
@@ -1173,7 +1173,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingTeams(ctx, id, asc.WithGCMatchmakingTeamsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("game-center matchmaking teams list: %w", err)
 				}
@@ -1655,7 +1655,7 @@ func runMetricsQueue(ctx context.Context, name string, queueID *string, granular
 				return fetchRequests(ctx, id, asc.WithGCMatchmakingMetricsNextURL(nextURL))
 			}
 			return fetchSizes(ctx, id, asc.WithGCMatchmakingMetricsNextURL(nextURL))
-		})
+		}, shared.PaginateOptions()...)
 		if err != nil {
 			return fmt.Errorf("game-center matchmaking metrics %s: %w", name, err)
 		}
@@ -1717,7 +1717,7 @@ func runMetricsRule(ctx context.Context, name string, ruleID *string, granularit
 
 		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return fetch(ctx, id, asc.WithGCMatchmakingMetricsNextURL(nextURL))
-		})
+		}, shared.PaginateOptions()...)
 		if err != nil {
 			return fmt.Errorf("game-center matchmaking metrics %s: %w", name, err)
 		}
@@ -0,0 +1,179 @@
+package helpcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// searchResult is one command matched by asc help search.
+type searchResult struct {
+	Command   string `json:"command"`
+	ShortHelp string `json:"shortHelp"`
+}
+
+// HelpCommand returns the asc help command group. It searches the help
+// metadata of rootSubcommands and does not require auth or network access.
+func HelpCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
+	fs := flag.NewFlagSet("help", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "help",
+		ShortUsage: "asc help <subcommand> [flags]",
+		ShortHelp:  "Search command help.",
+		LongHelp: `Search command help.
+
+Examples:
+  asc help search screenshots
+  asc help search --output table "phased release"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			HelpSearchCommand(rootSubcommands),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// HelpSearchCommand returns the asc help search subcommand.
+func HelpSearchCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
+	fs := flag.NewFlagSet("help search", flag.ExitOnError)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "search",
+		ShortUsage: "asc help search <term>... [flags]",
+		ShortHelp:  "Find commands whose name or help text matches a term.",
+		LongHelp: `Find commands whose name or help text matches a term.
+
+Walks the full command tree and matches every term (case-insensitive) against
+each command's path, short help, and long help. Commands whose path matches are
+listed first.
+
+Examples:
+  asc help search screenshots
+  asc help search review submission
+  asc help search --output table "phased release"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			_ = ctx
+			terms := searchTerms(args)
+			if len(terms) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: a search term is required")
+				return flag.ErrHelp
+			}
+
+			results := searchCommands(rootSubcommands, terms)
+			if err := shared.PrintOutputWithRenderers(
+				results,
+				*output.Output,
+				*output.Pretty,
+				func() error {
+					asc.RenderTable([]string{"Command", "Description"}, searchResultRows(results))
+					return nil
+				},
+				func() error {
+					asc.RenderMarkdown([]string{"Command", "Description"}, searchResultRows(results))
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("help search: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+func searchTerms(args []string) []string {
+	terms := make([]string, 0, len(args))
+	for _, arg := range args {
+		for _, field := range strings.Fields(strings.ToLower(arg)) {
+			terms = append(terms, field)
+		}
+	}
+	return terms
+}
+
+// searchCommands walks the command tree depth-first and returns commands
+// matching every term, ordered by match rank and then tree order.
+func searchCommands(commands []*ffcli.Command, terms []string) []searchResult {
+	type rankedResult struct {
+		searchResult
+		rank int
+	}
+
+	var matches []rankedResult
+	var walk func(prefix string, cmds []*ffcli.Command)
+	walk = func(prefix string, cmds []*ffcli.Command) {
+		for _, cmd := range cmds {
+			if cmd == nil || strings.TrimSpace(cmd.Name) == "" {
+				continue
+			}
+			path := prefix + " " + cmd.Name
+			if rank, ok := matchCommand(path, cmd, terms); ok {
+				matches = append(matches, rankedResult{
+					searchResult: searchResult{Command: path, ShortHelp: strings.TrimSpace(cmd.ShortHelp)},
+					rank:         rank,
+				})
+			}
+			walk(path, cmd.Subcommands)
+		}
+	}
+	walk("asc", commands)
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].rank < matches[j].rank
+	})
+
+	results := make([]searchResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, match.searchResult)
+	}
+	return results
+}
+
+// matchCommand reports whether every term appears in the command's path or
+// help text. The rank is the weakest field any term had to fall back to.
+func matchCommand(path string, cmd *ffcli.Command, terms []string) (int, bool) {
+	// Fields are ordered by rank: path matches sort before help text matches.
+	fields := []string{
+		strings.ToLower(path),
+		strings.ToLower(cmd.ShortHelp),
+		strings.ToLower(cmd.LongHelp),
+	}
+
+	rank := 0
+	for _, term := range terms {
+		found := false
+		for fieldRank, field := range fields {
+			if strings.Contains(field, term) {
+				rank = max(rank, fieldRank)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return rank, true
+}
+
+func searchResultRows(results []searchResult) [][]string {
+	rows := make([][]string, 0, len(results))
+	for _, result := range results {
+		rows = append(rows, []string{result.Command, result.ShortHelp})
+	}
+	return rows
+}
@@ -0,0 +1,66 @@
+package helpcmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"reflect"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func testCommandTree() []*ffcli.Command {
+	return []*ffcli.Command{
+		{
+			Name:      "builds",
+			ShortHelp: "Manage builds.",
+			Subcommands: []*ffcli.Command{
+				{Name: "list", ShortHelp: "List builds for an app."},
+				{Name: "expire", ShortHelp: "Expire a build.", LongHelp: "Expire a build so testers can no longer install it."},
+			},
+		},
+		{
+			Name:      "testflight",
+			ShortHelp: "Manage TestFlight testers and builds.",
+			Subcommands: []*ffcli.Command{
+				{Name: "testers", ShortHelp: "Manage beta testers."},
+			},
+		},
+		nil,
+		{Name: " "},
+	}
+}
+
+func TestSearchCommandsRanksPathMatchesFirst(t *testing.T) {
+	results := searchCommands(testCommandTree(), []string{"builds"})
+
+	want := []searchResult{
+		{Command: "asc builds", ShortHelp: "Manage builds."},
+		{Command: "asc builds list", ShortHelp: "List builds for an app."},
+		{Command: "asc builds expire", ShortHelp: "Expire a build."},
+		{Command: "asc testflight", ShortHelp: "Manage TestFlight testers and builds."},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Fatalf("unexpected results:\n got %+v\nwant %+v", results, want)
+	}
+}
+
+func TestSearchCommandsRequiresEveryTerm(t *testing.T) {
+	results := searchCommands(testCommandTree(), searchTerms([]string{"Expire", "TESTERS"}))
+
+	want := []searchResult{{Command: "asc builds expire", ShortHelp: "Expire a build."}}
+	if !reflect.DeepEqual(results, want) {
+		t.Fatalf("unexpected results:\n got %+v\nwant %+v", results, want)
+	}
+}
+
+func TestHelpSearchRequiresTerm(t *testing.T) {
+	cmd := HelpSearchCommand(testCommandTree())
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), []string{"  "}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp for missing term, got %v", err)
+	}
+}
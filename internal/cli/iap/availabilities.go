@@ -137,7 +137,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetInAppPurchaseAvailabilityAvailableTerritories(ctx, id, asc.WithIAPAvailabilityTerritoriesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("iap availabilities available-territories: %w", err)
 				}
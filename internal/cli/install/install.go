@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"regexp"
+	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -15,6 +17,10 @@ import (
 
 const defaultSkillsPackage = "rudrankriyam/asc-skills"
 
+// skillsVersionPattern accepts tags like 1.2.0, v1.2.0-beta.1, or main, and
+// rejects anything that could be parsed as a flag or a second package spec.
+var skillsVersionPattern = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z._-]*$`)
+
 var (
 	lookupNpx      = exec.LookPath
 	runCommand     = defaultRunCommand
@@ -24,19 +30,30 @@ var (
 // InstallSkillsCommand returns the top-level `install-skills` command.
 func InstallSkillsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("install-skills", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Print the command that would run without executing it")
+	version := fs.String("version", "", "Pin the skill pack to a version or tag (e.g., 1.2.0)")
 
 	return &ffcli.Command{
 		Name:       "install-skills",
-		ShortUsage: "asc install-skills",
+		ShortUsage: "asc install-skills [flags]",
 		ShortHelp:  "Install the asc skill pack for App Store Connect workflows.",
 		LongHelp: `Install the asc skill pack for App Store Connect workflows.
 
+Runs "skills add" through npx, falling back to "pnpm dlx" or bunx when npx is
+not on PATH.
+
 Examples:
-  asc install-skills`,
+  asc install-skills
+  asc install-skills --dry-run
+  asc install-skills --version 1.2.0`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if err := installSkills(ctx); err != nil {
+			pkg, err := skillsPackage(*version)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if err := installSkills(ctx, installOptions{Package: pkg, DryRun: *dryRun}); err != nil {
 				return fmt.Errorf("install skills: %w", err)
 			}
 			return nil
@@ -44,14 +61,67 @@ Examples:
 	}
 }
 
-func installSkills(ctx context.Context) error {
-	path, err := lookupNpx("npx")
+type installOptions struct {
+	Package string
+	DryRun  bool
+}
+
+// skillsPackage returns the package spec passed to `skills add`, pinned to
+// version when one is given.
+func skillsPackage(version string) (string, error) {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return defaultSkillsPackage, nil
+	}
+	if !skillsVersionPattern.MatchString(version) {
+		return "", fmt.Errorf("--version must contain only letters, digits, '.', '_' or '-' (got %q)", version)
+	}
+	return defaultSkillsPackage + "@" + version, nil
+}
+
+// packageRunner is a tool that can fetch and run the skills CLI on demand.
+type packageRunner struct {
+	Name string
+	Args []string
+}
+
+// packageRunners lists the supported runners in order of preference.
+var packageRunners = []packageRunner{
+	{Name: "npx", Args: []string{"--yes"}},
+	{Name: "pnpm", Args: []string{"dlx"}},
+	{Name: "bunx"},
+}
+
+func installSkills(ctx context.Context, opts installOptions) error {
+	runner, path, err := findPackageRunner()
 	if err != nil {
-		return fmt.Errorf("%w; install Node.js to continue", errNpxNotFound)
+		return err
 	}
 
 	// `npx add-skill` is deprecated upstream; use the new subcommand style.
-	return runCommand(ctx, path, "--yes", "skills", "add", defaultSkillsPackage)
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = defaultSkillsPackage
+	}
+	args := append(append([]string{}, runner.Args...), "skills", "add", pkg)
+	if opts.DryRun {
+		fmt.Fprintf(os.Stdout, "Would run: %s\n", strings.Join(append([]string{runner.Name}, args...), " "))
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "Installing %s with %s\n", pkg, runner.Name)
+	return runCommand(ctx, path, args...)
+}
+
+// findPackageRunner returns the first runner found on PATH, preferring npx.
+func findPackageRunner() (packageRunner, string, error) {
+	names := make([]string, 0, len(packageRunners))
+	for _, runner := range packageRunners {
+		if path, err := lookupNpx(runner.Name); err == nil {
+			return runner, path, nil
+		}
+		names = append(names, runner.Name)
+	}
+	return packageRunner{}, "", fmt.Errorf("%w (also tried %s); install Node.js to continue", errNpxNotFound, strings.Join(names[1:], ", "))
 }
 
 func defaultRunCommand(ctx context.Context, name string, args ...string) error {
@@ -3,6 +3,9 @@ package install
 import (
 	"context"
 	"errors"
+	"flag"
+	"io"
+	"os"
 	"reflect"
 	"testing"
 )
@@ -75,3 +78,188 @@ func TestInstallSkillsFailsWhenNpxMissing(t *testing.T) {
 		t.Fatalf("expected npx error, got %q", err.Error())
 	}
 }
+
+func TestInstallSkillsDryRunPrintsCommandWithoutRunning(t *testing.T) {
+	originalLookup := lookupNpx
+	originalRun := runCommand
+	t.Cleanup(func() {
+		lookupNpx = originalLookup
+		runCommand = originalRun
+	})
+
+	lookupNpx = func(name string) (string, error) {
+		return "/bin/npx", nil
+	}
+	runCommand = func(ctx context.Context, name string, args ...string) error {
+		t.Fatal("runCommand should not be called in dry-run mode")
+		return nil
+	}
+
+	cmd := InstallSkillsCommand()
+	if err := cmd.Parse([]string{"--dry-run"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+
+	stdout := captureStdout(t, func() {
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	expected := "Would run: npx --yes skills add " + defaultSkillsPackage + "\n"
+	if stdout != expected {
+		t.Fatalf("expected %q, got %q", expected, stdout)
+	}
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	original := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe error: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = original }()
+
+	fn()
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("close error: %v", err)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+	return string(data)
+}
+
+func TestInstallSkillsPinsVersion(t *testing.T) {
+	originalLookup := lookupNpx
+	originalRun := runCommand
+	t.Cleanup(func() {
+		lookupNpx = originalLookup
+		runCommand = originalRun
+	})
+
+	lookupNpx = func(name string) (string, error) {
+		return "/bin/npx", nil
+	}
+	var gotArgs []string
+	runCommand = func(ctx context.Context, name string, args ...string) error {
+		gotArgs = append([]string{}, args...)
+		return nil
+	}
+
+	cmd := InstallSkillsCommand()
+	if err := cmd.Parse([]string{"--version", "v1.2.0"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if err := cmd.Run(context.Background()); err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	expected := []string{"--yes", "skills", "add", defaultSkillsPackage + "@v1.2.0"}
+	if !reflect.DeepEqual(gotArgs, expected) {
+		t.Fatalf("expected args %v, got %v", expected, gotArgs)
+	}
+}
+
+func TestInstallSkillsRejectsInvalidVersion(t *testing.T) {
+	originalRun := runCommand
+	t.Cleanup(func() { runCommand = originalRun })
+	runCommand = func(ctx context.Context, name string, args ...string) error {
+		t.Fatal("runCommand should not be called for an invalid version")
+		return nil
+	}
+
+	for _, version := range []string{"--force", "1.0 extra", "1.0@2"} {
+		cmd := InstallSkillsCommand()
+		if err := cmd.Parse([]string{"--version", version}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := cmd.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("version %q: expected ErrHelp, got %v", version, err)
+		}
+	}
+}
+
+func TestInstallSkillsFallsBackToPnpmDlx(t *testing.T) {
+	originalLookup := lookupNpx
+	originalRun := runCommand
+	t.Cleanup(func() {
+		lookupNpx = originalLookup
+		runCommand = originalRun
+	})
+
+	var lookedUp []string
+	lookupNpx = func(name string) (string, error) {
+		lookedUp = append(lookedUp, name)
+		if name == "pnpm" {
+			return "/bin/pnpm", nil
+		}
+		return "", errors.New("missing")
+	}
+	var gotName string
+	var gotArgs []string
+	runCommand = func(ctx context.Context, name string, args ...string) error {
+		gotName = name
+		gotArgs = append([]string{}, args...)
+		return nil
+	}
+
+	cmd := InstallSkillsCommand()
+	if err := cmd.Parse([]string{}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if err := cmd.Run(context.Background()); err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	if !reflect.DeepEqual(lookedUp, []string{"npx", "pnpm"}) {
+		t.Fatalf("expected npx then pnpm lookups, got %v", lookedUp)
+	}
+	if gotName != "/bin/pnpm" {
+		t.Fatalf("expected pnpm path /bin/pnpm, got %q", gotName)
+	}
+	expected := []string{"dlx", "skills", "add", defaultSkillsPackage}
+	if !reflect.DeepEqual(gotArgs, expected) {
+		t.Fatalf("expected args %v, got %v", expected, gotArgs)
+	}
+}
+
+func TestInstallSkillsDryRunShowsBunxFallback(t *testing.T) {
+	originalLookup := lookupNpx
+	originalRun := runCommand
+	t.Cleanup(func() {
+		lookupNpx = originalLookup
+		runCommand = originalRun
+	})
+
+	lookupNpx = func(name string) (string, error) {
+		if name == "bunx" {
+			return "/bin/bunx", nil
+		}
+		return "", errors.New("missing")
+	}
+	runCommand = func(ctx context.Context, name string, args ...string) error {
+		t.Fatal("runCommand should not be called in dry-run mode")
+		return nil
+	}
+
+	cmd := InstallSkillsCommand()
+	if err := cmd.Parse([]string{"--dry-run"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	stdout := captureStdout(t, func() {
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	expected := "Would run: bunx skills add " + defaultSkillsPackage + "\n"
+	if stdout != expected {
+		t.Fatalf("expected %q, got %q", expected, stdout)
+	}
+}
@@ -0,0 +1,235 @@
+package localizations
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// LocalizationsCreateCommand returns the create localizations subcommand.
+func LocalizationsCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+
+	versionID := fs.String("version", "", "App Store version ID (for version localizations)")
+	versionIDAlias := fs.String("version-id", "", "Alias for --version")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID, for app-info localizations)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
+	locale := fs.String("locale", "", "Locale to create (required, e.g., fr-FR)")
+
+	// App-info fields
+	name := fs.String("name", "", "App name (app-info)")
+	subtitle := fs.String("subtitle", "", "App subtitle (app-info)")
+	privacyPolicyURL := fs.String("privacy-policy-url", "", "Privacy policy URL (app-info)")
+	privacyChoicesURL := fs.String("privacy-choices-url", "", "Privacy choices URL (app-info)")
+	privacyPolicyText := fs.String("privacy-policy-text", "", "Privacy policy text (app-info)")
+
+	// Version fields
+	description := fs.String("description", "", "App description (version)")
+	keywords := fs.String("keywords", "", "Search keywords (version)")
+	whatsNew := fs.String("whats-new", "", "What's new text (version)")
+	promotionalText := fs.String("promotional-text", "", "Promotional text (version)")
+	supportURL := fs.String("support-url", "", "Support URL (version)")
+	marketingURL := fs.String("marketing-url", "", "Marketing URL (version)")
+
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc localizations create --locale LOCALE [flags]",
+		ShortHelp:  "Create a localization for a new locale.",
+		LongHelp: `Create a localization for a locale that does not exist yet.
+
+The locale must be one of the locales App Store Connect supports. Use
+"asc localizations update" to change an existing localization.
+
+For version localizations (description, keywords, whatsNew):
+  asc localizations create --version-id "VERSION_ID" --locale "fr-FR" --description "Description" --keywords "one,two"
+
+For app-info localizations (name, subtitle, privacy URLs):
+  asc localizations create --app "APP_ID" --type app-info --locale "fr-FR" --name "Mon App"
+
+Fields for the other localization type are rejected rather than ignored.`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			normalizedType, err := shared.NormalizeLocalizationType(*locType)
+			if err != nil {
+				return fmt.Errorf("localizations create: %w", err)
+			}
+
+			localeValue := strings.TrimSpace(*locale)
+			if localeValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required")
+				return flag.ErrHelp
+			}
+			canonicalLocale, ok := shared.CanonicalAppStoreLocale(localeValue)
+			if !ok {
+				return shared.UsageErrorf("unsupported locale %q (supported: %s)", localeValue, strings.Join(shared.SupportedAppStoreLocales(), ", "))
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+			if err := rejectOtherTypeFlags(normalizedType, visited); err != nil {
+				return err
+			}
+
+			switch normalizedType {
+			case shared.LocalizationTypeAppInfo:
+				return createAppInfoLocalization(ctx, createAppInfoParams{
+					appID:     *appID,
+					appInfoID: *appInfoID,
+					attrs: asc.AppInfoLocalizationAttributes{
+						Locale:            canonicalLocale,
+						Name:              *name,
+						Subtitle:          *subtitle,
+						PrivacyPolicyURL:  *privacyPolicyURL,
+						PrivacyChoicesURL: *privacyChoicesURL,
+						PrivacyPolicyText: *privacyPolicyText,
+					},
+					output: output,
+				})
+			case shared.LocalizationTypeVersion:
+				vid := strings.TrimSpace(*versionID)
+				alias := strings.TrimSpace(*versionIDAlias)
+				if vid != "" && alias != "" && vid != alias {
+					return shared.UsageError("--version and --version-id conflict; set only one")
+				}
+				if vid == "" {
+					vid = alias
+				}
+				return createVersionLocalization(ctx, createVersionParams{
+					versionID: vid,
+					attrs: asc.AppStoreVersionLocalizationAttributes{
+						Locale:          canonicalLocale,
+						Description:     *description,
+						Keywords:        *keywords,
+						WhatsNew:        *whatsNew,
+						PromotionalText: *promotionalText,
+						SupportURL:      *supportURL,
+						MarketingURL:    *marketingURL,
+					},
+					output: output,
+				})
+			default:
+				return fmt.Errorf("localizations create: unsupported type %q", normalizedType)
+			}
+		},
+	}
+}
+
+var (
+	appInfoOnlyCreateFlags = []string{"app-info", "name", "subtitle", "privacy-policy-url", "privacy-choices-url", "privacy-policy-text"}
+	versionOnlyCreateFlags = []string{"version", "version-id", "description", "keywords", "whats-new", "promotional-text", "support-url", "marketing-url"}
+)
+
+// rejectOtherTypeFlags returns a usage error when a flag that only applies to
+// the other localization type was set, instead of silently dropping it.
+func rejectOtherTypeFlags(locType string, visited map[string]bool) error {
+	otherType, otherFlags := shared.LocalizationTypeAppInfo, appInfoOnlyCreateFlags
+	if locType == shared.LocalizationTypeAppInfo {
+		otherType, otherFlags = shared.LocalizationTypeVersion, versionOnlyCreateFlags
+	}
+
+	var set []string
+	for _, name := range otherFlags {
+		if visited[name] {
+			set = append(set, "--"+name)
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	return shared.UsageErrorf("%s only apply to --type %s localizations", strings.Join(set, ", "), otherType)
+}
+
+type createAppInfoParams struct {
+	appID, appInfoID string
+	attrs            asc.AppInfoLocalizationAttributes
+	output           shared.OutputFlags
+}
+
+func createAppInfoLocalization(ctx context.Context, p createAppInfoParams) error {
+	resolvedAppID := shared.ResolveAppID(p.appID)
+	if resolvedAppID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations (or set ASC_APP_ID)")
+		return flag.ErrHelp
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("localizations create: %w", err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	appInfo, err := shared.ResolveAppInfoID(requestCtx, client, resolvedAppID, strings.TrimSpace(p.appInfoID))
+	if err != nil {
+		return fmt.Errorf("localizations create: %w", err)
+	}
+
+	existing, err := client.GetAppInfoLocalizations(requestCtx, appInfo, asc.WithAppInfoLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("localizations create: failed to fetch localizations: %w", err)
+	}
+	for _, item := range existing.Data {
+		if strings.EqualFold(strings.TrimSpace(item.Attributes.Locale), p.attrs.Locale) {
+			return fmt.Errorf("localizations create: localization for locale %q already exists (id %s); use localizations update", p.attrs.Locale, item.ID)
+		}
+	}
+
+	resp, err := client.CreateAppInfoLocalization(requestCtx, appInfo, p.attrs)
+	if err != nil {
+		return fmt.Errorf("localizations create: %w", err)
+	}
+
+	return shared.PrintOutput(resp, *p.output.Output, *p.output.Pretty)
+}
+
+type createVersionParams struct {
+	versionID string
+	attrs     asc.AppStoreVersionLocalizationAttributes
+	output    shared.OutputFlags
+}
+
+func createVersionLocalization(ctx context.Context, p createVersionParams) error {
+	if p.versionID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --version is required for version localizations")
+		return flag.ErrHelp
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("localizations create: %w", err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	existing, err := client.GetAppStoreVersionLocalizations(requestCtx, p.versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("localizations create: failed to fetch localizations: %w", err)
+	}
+	for _, item := range existing.Data {
+		if strings.EqualFold(strings.TrimSpace(item.Attributes.Locale), p.attrs.Locale) {
+			return fmt.Errorf("localizations create: localization for locale %q already exists (id %s); use localizations update", p.attrs.Locale, item.ID)
+		}
+	}
+
+	resp, err := client.CreateAppStoreVersionLocalization(requestCtx, p.versionID, p.attrs)
+	if err != nil {
+		return fmt.Errorf("localizations create: %w", err)
+	}
+
+	return shared.PrintOutput(resp, *p.output.Output, *p.output.Pretty)
+}
@@ -25,6 +25,7 @@ func LocalizationsCommand() *ffcli.Command {
 
 Examples:
   asc localizations list --version "VERSION_ID"
+  asc localizations create --version "VERSION_ID" --locale "fr-FR" --description "Description"
   asc localizations search-keywords list --localization-id "LOCALIZATION_ID"
   asc localizations preview-sets list --localization-id "LOCALIZATION_ID"
   asc localizations preview-sets get --id "PREVIEW_SET_ID"
@@ -35,6 +36,7 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			LocalizationsListCommand(),
+			LocalizationsCreateCommand(),
 			LocalizationsUpdateCommand(),
 			LocalizationsSearchKeywordsCommand(),
 			LocalizationsPreviewSetsCommand(),
@@ -124,7 +126,7 @@ Examples:
 					// Fetch all remaining pages
 					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionLocalizations(ctx, strings.TrimSpace(*versionID), asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("localizations list: %w", err)
 					}
@@ -175,7 +177,7 @@ Examples:
 					// Fetch all remaining pages
 					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppInfoLocalizations(ctx, appInfo, asc.WithAppInfoLocalizationsNextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("localizations list: %w", err)
 					}
@@ -269,7 +271,7 @@ Examples:
 
 					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionLocalizations(ctx, strings.TrimSpace(*versionID), asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
@@ -349,7 +351,7 @@ Examples:
 
 					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppInfoLocalizations(ctx, appInfo, asc.WithAppInfoLocalizationsNextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
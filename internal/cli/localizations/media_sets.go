@@ -95,7 +95,7 @@ Examples:
 				}
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionLocalizationPreviewSets(ctx, trimmedID, asc.WithAppStoreVersionLocalizationPreviewSetsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("localizations preview-sets list: %w", err)
 				}
@@ -209,7 +209,7 @@ Examples:
 				}
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionLocalizationPreviewSetsRelationships(ctx, trimmedID, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("localizations preview-sets relationships: %w", err)
 				}
@@ -403,7 +403,7 @@ Examples:
 				}
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionLocalizationScreenshotSets(ctx, trimmedID, asc.WithAppStoreVersionLocalizationScreenshotSetsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("localizations screenshot-sets list: %w", err)
 				}
@@ -475,7 +475,7 @@ Examples:
 				}
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionLocalizationScreenshotSetsRelationships(ctx, trimmedID, asc.WithLinkagesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("localizations screenshot-sets relationships: %w", err)
 				}
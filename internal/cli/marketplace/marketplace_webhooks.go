@@ -103,7 +103,7 @@ Examples:
 
 				webhooks, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetMarketplaceWebhooks(ctx, asc.WithMarketplaceWebhooksNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("marketplace webhooks list: %w", err)
 				}
@@ -22,56 +22,6 @@ const (
 
 var localePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]+)*$`)
 
-var supportedMetadataLocales = []string{
-	"ar-SA",
-	"ca",
-	"cs",
-	"da",
-	"de-DE",
-	"el",
-	"en-AU",
-	"en-CA",
-	"en-GB",
-	"en-US",
-	"es-ES",
-	"es-MX",
-	"fi",
-	"fr-CA",
-	"fr-FR",
-	"he",
-	"hi",
-	"hr",
-	"hu",
-	"id",
-	"it",
-	"ja",
-	"ko",
-	"ms",
-	"nl-NL",
-	"no",
-	"pl",
-	"pt-BR",
-	"pt-PT",
-	"ro",
-	"ru",
-	"sk",
-	"sv",
-	"th",
-	"tr",
-	"uk",
-	"vi",
-	"zh-Hans",
-	"zh-Hant",
-}
-
-var supportedMetadataLocaleByFold = func() map[string]string {
-	result := make(map[string]string, len(supportedMetadataLocales))
-	for _, locale := range supportedMetadataLocales {
-		result[strings.ToLower(locale)] = locale
-	}
-	return result
-}()
-
 // AppInfoLocalization is the canonical app-info localization schema.
 type AppInfoLocalization struct {
 	Name              string `json:"name,omitempty"`
@@ -371,7 +321,7 @@ func validateLocale(locale string) (string, error) {
 	if len(resolved) > 20 || !localePattern.MatchString(resolved) {
 		return "", fmt.Errorf("invalid locale %q", resolved)
 	}
-	canonical, ok := supportedMetadataLocaleByFold[strings.ToLower(resolved)]
+	canonical, ok := shared.CanonicalAppStoreLocale(resolved)
 	if !ok {
 		return "", fmt.Errorf("unsupported locale %q", resolved)
 	}
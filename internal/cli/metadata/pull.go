@@ -36,6 +36,7 @@ func MetadataPullCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
+	versionID := fs.String("version-id", "", "App Store version ID (alternative to --version)")
 	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
 	dir := fs.String("dir", "", "Output root directory (required)")
 	force := fs.Bool("force", false, "Overwrite existing metadata files in --dir")
@@ -44,7 +45,7 @@ func MetadataPullCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "pull",
-		ShortUsage: "asc metadata pull --app \"APP_ID\" --version \"1.2.3\" --dir \"./metadata\" [flags]",
+		ShortUsage: "asc metadata pull --app \"APP_ID\" (--version \"1.2.3\" | --version-id \"VERSION_ID\") --dir \"./metadata\" [flags]",
 		ShortHelp:  "Pull metadata from App Store Connect into canonical files.",
 		LongHelp: `Pull metadata from App Store Connect into canonical files.
 
@@ -53,6 +54,7 @@ Phase 1 supports localization metadata for app-info and app-store versions.
 Examples:
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata"
   asc metadata pull --app "APP_ID" --version "1.2.3" --platform IOS --dir "./metadata"
+  asc metadata pull --app "APP_ID" --version-id "VERSION_ID" --dir "./metadata"
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata" --force`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -67,8 +69,9 @@ Examples:
 			}
 
 			versionValue := strings.TrimSpace(*version)
-			if versionValue == "" {
-				return shared.UsageError("--version is required")
+			versionIDValue := strings.TrimSpace(*versionID)
+			if err := validateVersionSelector(versionValue, versionIDValue); err != nil {
+				return err
 			}
 
 			dirValue := strings.TrimSpace(*dir)
@@ -78,6 +81,9 @@ Examples:
 
 			platformValue := strings.TrimSpace(*platform)
 			if platformValue != "" {
+				if versionIDValue != "" {
+					return shared.UsageError("--platform cannot be used with --version-id")
+				}
 				normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(platformValue)
 				if err != nil {
 					return shared.UsageError(err.Error())
@@ -103,7 +109,7 @@ Examples:
 				return fmt.Errorf("metadata pull: %w", err)
 			}
 
-			versionIDValue, err := resolveVersionID(requestCtx, client, resolvedAppID, versionValue, platformValue)
+			versionValue, versionIDValue, err = resolveVersionTarget(requestCtx, client, resolvedAppID, versionValue, versionIDValue, platformValue)
 			if err != nil {
 				if errors.Is(err, flag.ErrHelp) {
 					return err
@@ -115,7 +121,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("metadata pull: %w", err)
 			}
-			versionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
+			versionItems, err := shared.FetchVersionLocalizations(requestCtx, client, versionIDValue)
 			if err != nil {
 				return fmt.Errorf("metadata pull: %w", err)
 			}
@@ -241,6 +247,41 @@ func parseIncludes(value string) ([]string, error) {
 	return result, nil
 }
 
+// validateVersionSelector requires exactly one of --version and --version-id.
+func validateVersionSelector(version, versionID string) error {
+	if version == "" && versionID == "" {
+		return shared.UsageError("--version or --version-id is required")
+	}
+	if version != "" && versionID != "" {
+		return shared.UsageError("--version and --version-id are mutually exclusive")
+	}
+	return nil
+}
+
+// resolveVersionTarget returns the version string and App Store version ID
+// for whichever of version or versionID was provided. The version string
+// names the on-disk version directory, so it is looked up when only the ID
+// is known.
+func resolveVersionTarget(ctx context.Context, client *asc.Client, appID, version, versionID, platform string) (string, string, error) {
+	if versionID == "" {
+		resolvedID, err := resolveVersionID(ctx, client, appID, version, platform)
+		if err != nil {
+			return "", "", err
+		}
+		return version, resolvedID, nil
+	}
+
+	resp, err := client.GetAppStoreVersion(ctx, versionID)
+	if err != nil {
+		return "", "", err
+	}
+	versionString := strings.TrimSpace(resp.Data.Attributes.VersionString)
+	if versionString == "" {
+		return "", "", fmt.Errorf("app store version %q has no version string", versionID)
+	}
+	return versionString, versionID, nil
+}
+
 func resolveVersionID(ctx context.Context, client *asc.Client, appID, version, platform string) (string, error) {
 	if platform != "" {
 		return shared.ResolveAppStoreVersionID(ctx, client, appID, version, platform)
@@ -289,31 +330,6 @@ func fetchAppInfoLocalizations(ctx context.Context, client *asc.Client, appInfoI
 	return typed.Data, nil
 }
 
-func fetchVersionLocalizations(ctx context.Context, client *asc.Client, versionID string) ([]asc.Resource[asc.AppStoreVersionLocalizationAttributes], error) {
-	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
-	if err != nil {
-		return nil, err
-	}
-	if firstPage == nil || firstPage.Links.Next == "" {
-		if firstPage == nil {
-			return nil, nil
-		}
-		return firstPage.Data, nil
-	}
-
-	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-		return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-	})
-	if err != nil {
-		return nil, err
-	}
-	typed, ok := paginated.(*asc.AppStoreVersionLocalizationsResponse)
-	if !ok {
-		return nil, fmt.Errorf("unexpected pagination response type")
-	}
-	return typed.Data, nil
-}
-
 func printPullResultTable(result PullResult) error {
 	fmt.Printf("App ID: %s\n", result.AppID)
 	fmt.Printf("Version: %s\n", result.Version)
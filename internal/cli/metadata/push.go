@@ -70,6 +70,7 @@ type PushPlanResult struct {
 	Version   string        `json:"version"`
 	VersionID string        `json:"versionId"`
 	Dir       string        `json:"dir"`
+	Locales   []string      `json:"locales,omitempty"`
 	DryRun    bool          `json:"dryRun"`
 	Applied   bool          `json:"applied,omitempty"`
 	Includes  []string      `json:"includes"`
@@ -113,9 +114,11 @@ func MetadataPushCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
+	versionID := fs.String("version-id", "", "App Store version ID (alternative to --version)")
 	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
 	dir := fs.String("dir", "", "Metadata root directory (required)")
 	include := fs.String("include", includeLocalizations, "Included metadata scopes (comma-separated)")
+	locales := fs.String("locales", "", "Limit changes to these locales (comma-separated, e.g. en-US,de-DE)")
 	dryRun := fs.Bool("dry-run", false, "Preview changes without mutating App Store Connect")
 	allowDeletes := fs.Bool("allow-deletes", false, "Allow destructive delete operations when applying changes (disables default locale fallback for missing locales)")
 	confirm := fs.Bool("confirm", false, "Confirm destructive operations (required with --allow-deletes)")
@@ -123,7 +126,7 @@ func MetadataPushCommand() *ffcli.Command {
 
 	return &ffcli.Command{
 		Name:       "push",
-		ShortUsage: "asc metadata push --app \"APP_ID\" --version \"1.2.3\" --dir \"./metadata\" [--dry-run]",
+		ShortUsage: "asc metadata push --app \"APP_ID\" (--version \"1.2.3\" | --version-id \"VERSION_ID\") --dir \"./metadata\" [--dry-run]",
 		ShortHelp:  "Push metadata changes from canonical files.",
 		LongHelp: `Push metadata changes from canonical files.
 
@@ -131,12 +134,14 @@ Examples:
   asc metadata push --app "APP_ID" --version "1.2.3" --dir "./metadata" --dry-run
   asc metadata push --app "APP_ID" --version "1.2.3" --platform IOS --dir "./metadata" --dry-run
   asc metadata push --app "APP_ID" --version "1.2.3" --dir "./metadata"
+  asc metadata push --app "APP_ID" --version-id "VERSION_ID" --dir "./metadata" --locales "en-US,de-DE" --dry-run
   asc metadata push --app "APP_ID" --version "1.2.3" --dir "./metadata" --allow-deletes --confirm
 
 Notes:
   - default.json fallback is applied only when --allow-deletes is not set.
   - with --allow-deletes, remote locales missing locally are planned as deletes.
-  - omitted fields are treated as no-op; they do not imply deletion.`,
+  - omitted fields are treated as no-op; they do not imply deletion.
+  - with --locales, local files and remote localizations for other locales are ignored.`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -148,8 +153,9 @@ Notes:
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
 			versionValue := strings.TrimSpace(*version)
-			if versionValue == "" {
-				return shared.UsageError("--version is required")
+			versionIDValue := strings.TrimSpace(*versionID)
+			if err := validateVersionSelector(versionValue, versionIDValue); err != nil {
+				return err
 			}
 			dirValue := strings.TrimSpace(*dir)
 			if dirValue == "" {
@@ -158,6 +164,9 @@ Notes:
 
 			platformValue := strings.TrimSpace(*platform)
 			if platformValue != "" {
+				if versionIDValue != "" {
+					return shared.UsageError("--platform cannot be used with --version-id")
+				}
 				normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(platformValue)
 				if err != nil {
 					return shared.UsageError(err.Error())
@@ -170,9 +179,19 @@ Notes:
 				return shared.UsageError(err.Error())
 			}
 
-			localBundle, err := loadLocalMetadata(dirValue, versionValue)
+			localeFilter, err := parseLocaleFilter(*locales)
 			if err != nil {
-				return err
+				return shared.UsageError(err.Error())
+			}
+
+			// With --version-id the version directory is only known after the
+			// version is fetched, so local files are read once it resolves.
+			var localBundle localMetadataBundle
+			if versionValue != "" {
+				localBundle, err = loadLocalMetadata(dirValue, versionValue)
+				if err != nil {
+					return err
+				}
 			}
 
 			client, err := shared.GetASCClient()
@@ -187,23 +206,41 @@ Notes:
 			if err != nil {
 				return fmt.Errorf("metadata push: %w", err)
 			}
-			versionIDValue, err := resolveVersionID(requestCtx, client, resolvedAppID, versionValue, platformValue)
+			loadAfterResolve := versionValue == ""
+			versionValue, versionIDValue, err = resolveVersionTarget(requestCtx, client, resolvedAppID, versionValue, versionIDValue, platformValue)
 			if err != nil {
 				if errors.Is(err, flag.ErrHelp) {
 					return err
 				}
 				return fmt.Errorf("metadata push: %w", err)
 			}
+			if loadAfterResolve {
+				localBundle, err = loadLocalMetadata(dirValue, versionValue)
+				if err != nil {
+					return err
+				}
+			}
 
 			remoteAppInfoItems, err := fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
 			if err != nil {
 				return fmt.Errorf("metadata push: %w", err)
 			}
-			remoteVersionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
+			remoteVersionItems, err := shared.FetchVersionLocalizations(requestCtx, client, versionIDValue)
 			if err != nil {
 				return fmt.Errorf("metadata push: %w", err)
 			}
 
+			if len(localeFilter) > 0 {
+				localBundle.appInfo = filterLocaleMap(localBundle.appInfo, localeFilter)
+				localBundle.version = filterLocaleMap(localBundle.version, localeFilter)
+				remoteAppInfoItems = filterLocaleResources(remoteAppInfoItems, localeFilter, func(attrs asc.AppInfoLocalizationAttributes) string {
+					return attrs.Locale
+				})
+				remoteVersionItems = filterLocaleResources(remoteVersionItems, localeFilter, func(attrs asc.AppStoreVersionLocalizationAttributes) string {
+					return attrs.Locale
+				})
+			}
+
 			remoteAppInfo := make(map[string]AppInfoLocalization, len(remoteAppInfoItems))
 			for _, item := range remoteAppInfoItems {
 				locale := strings.TrimSpace(item.Attributes.Locale)
@@ -268,6 +305,7 @@ Notes:
 				Version:   versionValue,
 				VersionID: versionIDValue,
 				Dir:       dirValue,
+				Locales:   sortedLocaleFilter(localeFilter),
 				DryRun:    *dryRun,
 				Includes:  includes,
 				Adds:      adds,
@@ -316,6 +354,68 @@ Notes:
 	}
 }
 
+// parseLocaleFilter parses --locales into a set keyed by canonical locale.
+// An empty value yields a nil set, meaning every locale is in scope.
+func parseLocaleFilter(value string) (map[string]struct{}, error) {
+	items := shared.SplitCSV(value)
+	if len(items) == 0 {
+		return nil, nil
+	}
+	filter := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		locale, err := validateLocale(item)
+		if err != nil {
+			return nil, fmt.Errorf("--locales: %w", err)
+		}
+		if locale == DefaultLocale {
+			return nil, fmt.Errorf("--locales: %q is not a locale", DefaultLocale)
+		}
+		filter[locale] = struct{}{}
+	}
+	return filter, nil
+}
+
+func sortedLocaleFilter(filter map[string]struct{}) []string {
+	if len(filter) == 0 {
+		return nil
+	}
+	locales := make([]string, 0, len(filter))
+	for locale := range filter {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+func localeInFilter(locale string, filter map[string]struct{}) bool {
+	canonical, ok := shared.CanonicalAppStoreLocale(locale)
+	if !ok {
+		return false
+	}
+	_, ok = filter[canonical]
+	return ok
+}
+
+func filterLocaleMap[T any](values map[string]T, filter map[string]struct{}) map[string]T {
+	result := make(map[string]T, len(values))
+	for locale, value := range values {
+		if localeInFilter(locale, filter) {
+			result[locale] = value
+		}
+	}
+	return result
+}
+
+func filterLocaleResources[T any](items []asc.Resource[T], filter map[string]struct{}, locale func(T) string) []asc.Resource[T] {
+	result := make([]asc.Resource[T], 0, len(items))
+	for _, item := range items {
+		if localeInFilter(locale(item.Attributes), filter) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 func loadLocalMetadata(dir, version string) (localMetadataBundle, error) {
 	localAppInfo := make(map[string]appInfoLocalPatch)
 	localVersion := make(map[string]versionLocalPatch)
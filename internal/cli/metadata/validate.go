@@ -93,7 +93,7 @@ Examples:
 			}
 
 			if result.ErrorCount > 0 {
-				return shared.NewReportedError(fmt.Errorf("metadata validate: found %d error(s)", result.ErrorCount))
+				return shared.NewBlockingIssuesError(fmt.Errorf("metadata validate: found %d error(s)", result.ErrorCount))
 			}
 			return nil
 		},
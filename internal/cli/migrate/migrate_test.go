@@ -278,8 +278,8 @@ func TestPrintMigrateOutput_RejectsPrettyForTable(t *testing.T) {
 }
 
 func TestPrintMigrateOutput_UnsupportedFormat(t *testing.T) {
-	err := printMigrateOutput(&MigrateImportResult{}, "yaml", false)
-	if err == nil || !strings.Contains(err.Error(), "unsupported format: yaml") {
+	err := printMigrateOutput(&MigrateImportResult{}, "xml", false)
+	if err == nil || !strings.Contains(err.Error(), "unsupported format: xml") {
 		t.Fatalf("expected unsupported format error, got %v", err)
 	}
 }
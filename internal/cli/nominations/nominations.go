@@ -176,7 +176,7 @@ Examples:
 
 				nominations, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetNominations(ctx, asc.WithNominationsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("nominations list: %w", err)
 				}
@@ -100,7 +100,7 @@ Examples:
 
 				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionOfferCodeCustomCodes(ctx, trimmedOfferCodeID, asc.WithSubscriptionOfferCodeCustomCodesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("offer-codes custom-codes list: %w", err)
 				}
@@ -112,7 +112,7 @@ Examples:
 
 				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionOfferCodeOneTimeUseCodes(ctx, trimmedOfferCodeID, asc.WithSubscriptionOfferCodeOneTimeUseCodesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("offer-codes list: %w", err)
 				}
@@ -94,7 +94,7 @@ Examples:
 
 				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionOfferCodePrices(ctx, trimmedOfferCodeID, asc.WithSubscriptionOfferCodePricesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("offer-codes prices list: %w", err)
 				}
@@ -0,0 +1,86 @@
+package opencmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// openURLFn opens the resolved link for --launch (overridden in tests).
+var openURLFn = shared.OpenURL
+
+// OpenCommand returns the asc open command.
+func OpenCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("open", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID, bundle ID, or exact app name (required, or ASC_APP_ID env)")
+	section := fs.String("section", "", "Page to link: "+strings.Join(shared.AppSections, ", ")+" (default: app overview)")
+	launch := fs.Bool("launch", false, "Open the link in your default browser")
+
+	return &ffcli.Command{
+		Name:       "open",
+		ShortUsage: "asc open --app APP_ID [--section SECTION] [--launch]",
+		ShortHelp:  "Print or open the App Store Connect web page for an app.",
+		LongHelp: `Print or open the App Store Connect web page for an app.
+
+Prints the same deep links as the links section of asc status. Numeric app
+IDs need no authentication; bundle IDs and app names are looked up first.
+
+Examples:
+  asc open --app "123456789"
+  asc open --app "123456789" --section testflight
+  asc open --app "com.example.app" --section review --launch`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: open does not accept positional arguments")
+				return flag.ErrHelp
+			}
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if err := shared.ValidateAppSection(*section); err != nil {
+				return shared.UsageError("--section: " + err.Error())
+			}
+
+			if _, err := strconv.ParseUint(resolvedAppID, 10, 64); err != nil {
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("open: %w", err)
+				}
+
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				resolvedAppID, err = shared.ResolveAppIDWithLookup(requestCtx, client, resolvedAppID)
+				if err != nil {
+					return fmt.Errorf("open: %w", err)
+				}
+			}
+
+			link, err := shared.AppStoreConnectAppURL(resolvedAppID, *section)
+			if err != nil {
+				return fmt.Errorf("open: %w", err)
+			}
+			fmt.Fprintln(os.Stdout, link)
+
+			if *launch {
+				if err := openURLFn(link); err != nil {
+					return fmt.Errorf("open: %w", err)
+				}
+			}
+			return nil
+		},
+	}
+}
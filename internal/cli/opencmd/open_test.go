@@ -0,0 +1,29 @@
+package opencmd
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOpenLaunchOpensLink(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	prevOpenURL := openURLFn
+	t.Cleanup(func() { openURLFn = prevOpenURL })
+
+	var opened string
+	openURLFn = func(target string) error {
+		opened = target
+		return nil
+	}
+
+	cmd := OpenCommand()
+	if err := cmd.FlagSet.Parse([]string{"--app", "123456789", "--section", "review", "--launch"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), nil); err != nil {
+		t.Fatalf("Exec() error: %v", err)
+	}
+	if opened != "https://appstoreconnect.apple.com/apps/123456789/appstore/review" {
+		t.Fatalf("unexpected launched URL %q", opened)
+	}
+}
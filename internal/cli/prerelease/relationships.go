@@ -133,7 +133,7 @@ Examples:
 					}
 					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return getPreReleaseRelationshipList(ctx, client, relationshipType, versionValue, asc.WithLinkagesNextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("pre-release-versions relationships get: %w", err)
 					}
@@ -119,7 +119,7 @@ Examples:
 
 				territories, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetTerritories(ctx, asc.WithTerritoriesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("pricing territories list: %w", err)
 				}
@@ -203,7 +203,7 @@ Examples:
 
 				points, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppPricePoints(ctx, resolvedAppID, asc.WithPricePointsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("pricing price-points: %w", err)
 				}
@@ -115,7 +115,7 @@ Examples:
 
 					paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionExperimentsV2(ctx, resolvedAppID, asc.WithAppStoreVersionExperimentsV2NextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("experiments list: %w", err)
 					}
@@ -160,7 +160,7 @@ Examples:
 
 				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionExperiments(ctx, trimmedVersionID, asc.WithAppStoreVersionExperimentsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("experiments list: %w", err)
 				}
@@ -122,6 +122,7 @@ func ProfilesLocalInstallCommand() *ffcli.Command {
 	profileID := fs.String("id", "", "Profile ID to download and install")
 	installDir := fs.String("install-dir", "", "Install directory (defaults to Xcode's Provisioning Profiles dir on macOS)")
 	force := fs.Bool("force", false, "Overwrite an existing installed profile with the same UUID")
+	fileMode := fs.String("file-mode", "0600", "Permissions for the installed profile (octal, e.g. 0644)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -154,6 +155,10 @@ Examples:
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
+			perm, err := shared.ParseFileMode(*fileMode)
+			if err != nil {
+				return shared.UsageError("--file-mode: " + err.Error())
+			}
 
 			var (
 				content []byte
@@ -218,7 +223,7 @@ Examples:
 				return fmt.Errorf("profiles local install: stat output path: %w", err)
 			}
 
-			if err := writeProfileFile(destPath, content, *force); err != nil {
+			if err := writeProfileFile(destPath, content, *force, perm); err != nil {
 				if errors.Is(err, os.ErrExist) {
 					return fmt.Errorf("profiles local install: output file already exists: %w", err)
 				}
@@ -2,14 +2,75 @@ package profiles
 
 import (
 	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
-func writeProfileFile(path string, content []byte, force bool) error {
+// defaultProfileFilenameTemplate names downloaded profiles when only an output
+// directory is given.
+const defaultProfileFilenameTemplate = "{name}-{uuid}.mobileprovision"
+
+var profileFilenamePlaceholderRegex = regexp.MustCompile(`\{[^{}]*\}`)
+
+func writeProfileFile(path string, content []byte, force bool, perm os.FileMode) error {
 	if !force {
-		return shared.WriteProfileFile(path, content)
+		return shared.WriteProfileFileWithPerm(path, content, perm)
 	}
-	_, err := shared.WriteFileNoSymlinkOverwrite(path, bytes.NewReader(content), 0o644, ".asc-profile-*", ".asc-profile-backup-*")
+	_, err := shared.WriteFileNoSymlinkOverwrite(path, bytes.NewReader(content), perm, ".asc-profile-*", ".asc-profile-backup-*")
 	return err
 }
+
+// resolveProfileFilename expands a filename template such as
+// "{name}-{uuid}.mobileprovision" against a profile. Supported placeholders are
+// {id}, {name}, {uuid}, {platform}, and {type}. Each value is reduced to a safe
+// filename component (falling back to the profile ID when empty), and the
+// result must be a plain file name so it cannot escape the output directory.
+func resolveProfileFilename(template, id string, attrs asc.ProfileAttributes) (string, error) {
+	template = strings.TrimSpace(template)
+	if template == "" {
+		template = defaultProfileFilenameTemplate
+	}
+
+	fallback := shared.SanitizeFilenamePart(id)
+	if fallback == "" {
+		fallback = "profile"
+	}
+	values := map[string]string{
+		"{id}":       id,
+		"{name}":     attrs.Name,
+		"{uuid}":     attrs.UUID,
+		"{platform}": string(attrs.Platform),
+		"{type}":     attrs.ProfileType,
+	}
+
+	var expandErr error
+	name := profileFilenamePlaceholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		value, ok := values[placeholder]
+		if !ok {
+			if expandErr == nil {
+				expandErr = fmt.Errorf("unknown filename placeholder %s (supported: {id}, {name}, {uuid}, {platform}, {type})", placeholder)
+			}
+			return ""
+		}
+		if sanitized := shared.SanitizeFilenamePart(value); sanitized != "" {
+			return sanitized
+		}
+		return fallback
+	})
+	if expandErr != nil {
+		return "", expandErr
+	}
+
+	if strings.ContainsAny(name, `/\{}`) {
+		return "", fmt.Errorf("filename %q must be a plain file name", name)
+	}
+	if shared.SanitizeFilenamePart(name) == "" {
+		return "", fmt.Errorf("filename template %q produced an empty file name", template)
+	}
+	return name, nil
+}
@@ -0,0 +1,69 @@
+package profiles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestResolveProfileFilename(t *testing.T) {
+	attrs := asc.ProfileAttributes{
+		Name:        "My App / Dev",
+		UUID:        "00000000-0000-0000-0000-0000000000AA",
+		Platform:    asc.Platform("IOS"),
+		ProfileType: "IOS_APP_DEVELOPMENT",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "default", template: "", want: "My_App___Dev-00000000-0000-0000-0000-0000000000AA.mobileprovision"},
+		{name: "all placeholders", template: "{platform}_{type}_{id}.mobileprovision", want: "IOS_IOS_APP_DEVELOPMENT_p1.mobileprovision"},
+		{name: "literal only", template: "profile.mobileprovision", want: "profile.mobileprovision"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := resolveProfileFilename(test.template, "p1", attrs)
+			if err != nil {
+				t.Fatalf("resolveProfileFilename() error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("resolveProfileFilename() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestResolveProfileFilename_FallsBackToIDForEmptyValues(t *testing.T) {
+	got, err := resolveProfileFilename("{name}.mobileprovision", "p1", asc.ProfileAttributes{Name: "../.."})
+	if err != nil {
+		t.Fatalf("resolveProfileFilename() error: %v", err)
+	}
+	if got != "p1.mobileprovision" {
+		t.Fatalf("resolveProfileFilename() = %q, want %q", got, "p1.mobileprovision")
+	}
+}
+
+func TestResolveProfileFilename_RejectsUnsafeTemplates(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  string
+	}{
+		{template: "../{name}.mobileprovision", wantErr: "plain file name"},
+		{template: "sub/{uuid}.mobileprovision", wantErr: "plain file name"},
+		{template: `sub\{uuid}.mobileprovision`, wantErr: "plain file name"},
+		{template: "{bundle}.mobileprovision", wantErr: "unknown filename placeholder {bundle}"},
+		{template: "..", wantErr: "empty file name"},
+	}
+	for _, test := range tests {
+		t.Run(test.template, func(t *testing.T) {
+			_, err := resolveProfileFilename(test.template, "p1", asc.ProfileAttributes{Name: "App"})
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -109,6 +110,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("profiles list: %w", err)
@@ -304,30 +306,65 @@ func ProfilesDownloadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("download", flag.ExitOnError)
 
 	id := fs.String("id", "", "Profile ID")
+	all := fs.Bool("all", false, "Download every profile into --output-dir")
 	outputPath := fs.String("output", "", "Output .mobileprovision file path")
+	outputDir := fs.String("output-dir", "", "Output directory; the file name comes from --filename")
+	filenameTemplate := fs.String("filename", "", "File name template for --output-dir (default: "+defaultProfileFilenameTemplate+")")
+	fileMode := fs.String("file-mode", "0600", "Permissions for the written profile (octal, e.g. 0644)")
+	force := fs.Bool("force", false, "Overwrite existing output files")
 	output := shared.BindMetadataOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "download",
-		ShortUsage: "asc profiles download --id \"PROFILE_ID\" --output ./profile.mobileprovision",
+		ShortUsage: "asc profiles download (--id \"PROFILE_ID\" | --all) (--output ./profile.mobileprovision | --output-dir ./profiles)",
 		ShortHelp:  "Download a provisioning profile.",
 		LongHelp: `Download a provisioning profile.
 
+With --output-dir, the file name is built from --filename, which accepts the
+placeholders {id}, {name}, {uuid}, {platform}, and {type}. --all downloads
+every profile into --output-dir; either all files are written or none are.
+
 Examples:
-  asc profiles download --id "PROFILE_ID" --output "./profile.mobileprovision"`,
+  asc profiles download --id "PROFILE_ID" --output "./profile.mobileprovision"
+  asc profiles download --id "PROFILE_ID" --output-dir "./profiles"
+  asc profiles download --id "PROFILE_ID" --output-dir "./profiles" --filename "{platform}-{name}.mobileprovision"
+  asc profiles download --all --output-dir "./profiles" --force`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			if idValue == "" && !*all {
+				fmt.Fprintln(os.Stderr, "Error: --id or --all is required")
 				return flag.ErrHelp
 			}
+			if idValue != "" && *all {
+				return shared.UsageError("--id and --all are mutually exclusive")
+			}
 			pathValue := strings.TrimSpace(*outputPath)
-			if pathValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --output is required")
+			dirValue := strings.TrimSpace(*outputDir)
+			if pathValue == "" && dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output or --output-dir is required")
 				return flag.ErrHelp
 			}
+			if pathValue != "" && dirValue != "" {
+				return shared.UsageError("--output and --output-dir are mutually exclusive")
+			}
+			if *all && dirValue == "" {
+				return shared.UsageError("--all requires --output-dir")
+			}
+			if dirValue == "" && strings.TrimSpace(*filenameTemplate) != "" {
+				return shared.UsageError("--filename requires --output-dir")
+			}
+			perm, err := shared.ParseFileMode(*fileMode)
+			if err != nil {
+				return shared.UsageError("--file-mode: " + err.Error())
+			}
+			if dirValue != "" {
+				// Reject bad templates before any network call.
+				if _, err := resolveProfileFilename(*filenameTemplate, "profile", asc.ProfileAttributes{}); err != nil {
+					return shared.UsageError(err.Error())
+				}
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -337,6 +374,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if *all {
+				result, err := downloadAllProfiles(requestCtx, client, dirValue, *filenameTemplate, perm, *force)
+				if err != nil {
+					return fmt.Errorf("profiles download: %w", err)
+				}
+				return shared.PrintOutput(result, *output.OutputFormat, *output.Pretty)
+			}
+
 			resp, err := client.GetProfile(requestCtx, idValue)
 			if err != nil {
 				return fmt.Errorf("profiles download: failed to fetch: %w", err)
@@ -352,7 +397,15 @@ Examples:
 				return fmt.Errorf("profiles download: %w", err)
 			}
 
-			if err := shared.WriteProfileFile(pathValue, decoded); err != nil {
+			if dirValue != "" {
+				fileName, err := resolveProfileFilename(*filenameTemplate, idValue, resp.Data.Attributes)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				pathValue = filepath.Join(dirValue, fileName)
+			}
+
+			if err := writeProfileFile(pathValue, decoded, *force, perm); err != nil {
 				return fmt.Errorf("profiles download: %w", err)
 			}
 
@@ -367,6 +420,54 @@ Examples:
 	}
 }
 
+// downloadAllProfiles fetches every profile and writes them into dir as a
+// single transaction, so a failure never leaves a partial set on disk.
+func downloadAllProfiles(ctx context.Context, client *asc.Client, dir, filenameTemplate string, perm os.FileMode, force bool) (*asc.ProfilesDownloadResult, error) {
+	paginated, err := shared.PaginateWithSpinner(ctx,
+		func(ctx context.Context) (asc.PaginatedResponse, error) {
+			return client.GetProfiles(ctx, asc.WithProfilesLimit(200))
+		},
+		func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+	profiles, ok := paginated.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", paginated)
+	}
+
+	result := &asc.ProfilesDownloadResult{
+		OutputDir: dir,
+		Profiles:  make([]asc.ProfileDownloadResult, 0, len(profiles.Data)),
+	}
+	files := make([]shared.FileWrite, 0, len(profiles.Data))
+	for _, profile := range profiles.Data {
+		decoded, err := decodeProfileContent(profile.Attributes.ProfileContent)
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: %w", profile.ID, err)
+		}
+		fileName, err := resolveProfileFilename(filenameTemplate, profile.ID, profile.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: %w", profile.ID, err)
+		}
+		path := filepath.Join(dir, fileName)
+		files = append(files, shared.FileWrite{Path: path, Data: decoded, Perm: perm})
+		result.Profiles = append(result.Profiles, asc.ProfileDownloadResult{
+			ID:         profile.ID,
+			Name:       profile.Attributes.Name,
+			OutputPath: path,
+		})
+	}
+
+	if err := shared.WriteFilesNoSymlink(files, force, ".asc-profile-*", ".asc-profile-backup-*"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func decodeProfileContent(content string) ([]byte, error) {
 	normalized := strings.Join(strings.Fields(content), "")
 	if normalized == "" {
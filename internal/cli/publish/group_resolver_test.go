@@ -462,6 +462,7 @@ func TestListAllPublishBetaGroups_Paginated(t *testing.T) {
 
 func TestListAllPublishBetaGroups_PaginationAPIError(t *testing.T) {
 	setupTestAuth(t)
+	t.Setenv("ASC_MAX_RETRIES", "0")
 
 	callCount := 0
 	swapTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
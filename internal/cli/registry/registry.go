@@ -1,9 +1,6 @@
 package registry
 
 import (
-	"context"
-	"fmt"
-
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/accessibility"
@@ -14,6 +11,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/alternativedistribution"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/analytics"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/androidiosmapping"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apicmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/app_events"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/appclips"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apps"
@@ -27,7 +25,9 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/bundleids"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/categories"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/certificates"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/changelog"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/completion"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/configcmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/crashes"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/devices"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/diffcmd"
@@ -37,6 +37,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/feedback"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/finance"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/gamecenter"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/helpcmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/iap"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/initcmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/insights"
@@ -50,6 +51,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notarization"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/offercodes"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/opencmd"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/passtypeids"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/performance"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/preorders"
@@ -64,7 +66,6 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/routingcoverage"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/sandbox"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/screenshots"
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/signing"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/status"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/submit"
@@ -81,25 +82,12 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/xcodecloud"
 )
 
-// VersionCommand returns a version subcommand.
-func VersionCommand(version string) *ffcli.Command {
-	return &ffcli.Command{
-		Name:       "version",
-		ShortUsage: "asc version",
-		ShortHelp:  "Print version information and exit.",
-		UsageFunc:  shared.DefaultUsageFunc,
-		Exec: func(ctx context.Context, args []string) error {
-			fmt.Println(version)
-			return nil
-		},
-	}
-}
-
 // Subcommands returns all root subcommands in display order.
 func Subcommands(version string) []*ffcli.Command {
 	subs := []*ffcli.Command{
 		auth.AuthCommand(),
-		auth.AuthDoctorCommand(),
+		auth.DoctorCommand(),
+		configcmd.ConfigCommand(),
 		web.WebCommand(),
 		account.AccountCommand(),
 		install.InstallSkillsCommand(),
@@ -107,8 +95,10 @@ func Subcommands(version string) []*ffcli.Command {
 		docs.DocsCommand(),
 		diffcmd.DiffCommand(),
 		status.StatusCommand(),
+		opencmd.OpenCommand(),
 		insights.InsightsCommand(),
 		releasenotes.ReleaseNotesCommand(),
+		changelog.ChangelogCommand(),
 		feedback.FeedbackCommand(),
 		crashes.CrashesCommand(),
 		reviews.ReviewsCommand(),
@@ -175,9 +165,12 @@ func Subcommands(version string) []*ffcli.Command {
 		migrate.MigrateCommand(),
 		notify.NotifyCommand(),
 		gamecenter.GameCenterCommand(),
+		apicmd.GetCommand(),
+		apicmd.PatchCommand(),
 		VersionCommand(version),
 	}
 
 	subs = append(subs, completion.CompletionCommand(subs))
+	subs = append(subs, helpcmd.HelpCommand(subs))
 	return subs
 }
@@ -2,6 +2,7 @@ package registry
 
 import (
 	"context"
+	"runtime/debug"
 	"strings"
 	"testing"
 )
@@ -44,3 +45,25 @@ func TestSubcommandsIncludesCoreEntries(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildVersionInfoIncludesVCSSettings(t *testing.T) {
+	original := readBuildInfo
+	t.Cleanup(func() { readBuildInfo = original })
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "abc123"},
+			{Key: "vcs.time", Value: "2026-01-02T03:04:05Z"},
+			{Key: "vcs.modified", Value: "true"},
+		}}, true
+	}
+
+	info := buildVersionInfo("1.2.3")
+	if info.Revision != "abc123" || info.RevisionTime != "2026-01-02T03:04:05Z" || !info.Modified {
+		t.Fatalf("unexpected VCS metadata: %+v", info)
+	}
+
+	text := formatVersionText(info)
+	if !strings.Contains(text, "revision: abc123 (2026-01-02T03:04:05Z) dirty") {
+		t.Fatalf("expected revision line in text output, got %q", text)
+	}
+}
@@ -0,0 +1,107 @@
+package registry
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// readBuildInfo is swapped in tests to simulate VCS-stamped builds.
+var readBuildInfo = debug.ReadBuildInfo
+
+// VersionInfo describes the running asc build.
+type VersionInfo struct {
+	Version      string `json:"version"`
+	Revision     string `json:"revision,omitempty"`
+	RevisionTime string `json:"revisionTime,omitempty"`
+	Modified     bool   `json:"modified,omitempty"`
+	GoVersion    string `json:"goVersion"`
+	Platform     string `json:"platform"`
+}
+
+// VersionCommand returns a version subcommand.
+func VersionCommand(version string) *ffcli.Command {
+	fs := flag.NewFlagSet("version", flag.ExitOnError)
+	output := fs.String("output", "text", "Output format: text (default), json")
+	pretty := shared.BindPrettyJSONFlag(fs)
+
+	return &ffcli.Command{
+		Name:       "version",
+		ShortUsage: "asc version [flags]",
+		ShortHelp:  "Print version information and exit.",
+		LongHelp: `Print version information and exit.
+
+Reports the asc version, the VCS revision embedded at build time, and the
+Go runtime version. Include this output in bug reports.
+
+Examples:
+  asc version
+  asc version --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			info := buildVersionInfo(version)
+
+			switch strings.ToLower(strings.TrimSpace(*output)) {
+			case "", "text":
+				fmt.Fprint(os.Stdout, formatVersionText(info))
+				return nil
+			case "json":
+				return shared.PrintOutput(info, "json", *pretty)
+			default:
+				fmt.Fprintln(os.Stderr, "Error: --output must be one of: text, json")
+				return flag.ErrHelp
+			}
+		},
+	}
+}
+
+func buildVersionInfo(version string) VersionInfo {
+	info := VersionInfo{
+		Version:   version,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+
+	buildInfo, ok := readBuildInfo()
+	if !ok || buildInfo == nil {
+		return info
+	}
+	for _, setting := range buildInfo.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.Revision = setting.Value
+		case "vcs.time":
+			info.RevisionTime = setting.Value
+		case "vcs.modified":
+			info.Modified = setting.Value == "true"
+		}
+	}
+	return info
+}
+
+func formatVersionText(info VersionInfo) string {
+	var b strings.Builder
+	fmt.Fprintln(&b, info.Version)
+	if info.Revision != "" {
+		revision := info.Revision
+		if info.RevisionTime != "" {
+			revision += " (" + info.RevisionTime + ")"
+		}
+		if info.Modified {
+			revision += " dirty"
+		}
+		fmt.Fprintf(&b, "revision: %s\n", revision)
+	}
+	fmt.Fprintf(&b, "go: %s\n", info.GoVersion)
+	fmt.Fprintf(&b, "platform: %s\n", info.Platform)
+	return b.String()
+}
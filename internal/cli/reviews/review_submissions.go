@@ -96,6 +96,7 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return client.ListReviewSubmissions(ctx, asc.WithReviewSubmissionsNextURL(nextURL))
 						},
+						shared.PaginateOptions()...,
 					)
 					if err != nil {
 						return fmt.Errorf("review submissions-list: %w", err)
@@ -121,6 +122,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetReviewSubmissions(ctx, resolvedAppID, asc.WithReviewSubmissionsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("review submissions-list: %w", err)
@@ -344,6 +346,9 @@ func ReviewSubmissionsCancelCommand() *ffcli.Command {
 		ShortHelp:  "Cancel a review submission.",
 		LongHelp: `Cancel a review submission.
 
+Only submissions that are ready for, waiting for, in, or blocked in review
+can be canceled. The response reports the new submission state.
+
 Examples:
   asc review submissions-cancel --id "SUBMISSION_ID" --confirm`,
 		FlagSet:   fs,
@@ -366,6 +371,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			current, err := client.GetReviewSubmission(requestCtx, strings.TrimSpace(*submissionID))
+			if err != nil {
+				return fmt.Errorf("review submissions-cancel: %w", err)
+			}
+			if state := current.Data.Attributes.SubmissionState; !isCancelableReviewSubmissionState(state) {
+				return fmt.Errorf("review submissions-cancel: submission %q is %s and cannot be canceled", strings.TrimSpace(*submissionID), state)
+			}
+
 			resp, err := client.CancelReviewSubmission(requestCtx, strings.TrimSpace(*submissionID))
 			if err != nil {
 				return fmt.Errorf("review submissions-cancel: %w", err)
@@ -376,6 +389,20 @@ Examples:
 	}
 }
 
+// isCancelableReviewSubmissionState reports whether a submission can still be
+// pulled back. Only the states documented in the command help qualify.
+func isCancelableReviewSubmissionState(state asc.ReviewSubmissionState) bool {
+	switch asc.ReviewSubmissionState(strings.ToUpper(strings.TrimSpace(string(state)))) {
+	case asc.ReviewSubmissionStateReadyForReview,
+		asc.ReviewSubmissionStateWaitingForReview,
+		asc.ReviewSubmissionStateInReview,
+		asc.ReviewSubmissionStateUnresolvedIssues:
+		return true
+	default:
+		return false
+	}
+}
+
 // ReviewSubmissionsItemsIDsCommand returns the review submission item IDs subcommand.
 func ReviewSubmissionsItemsIDsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("submissions-items-ids", flag.ExitOnError)
@@ -432,6 +459,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetReviewSubmissionItemsRelationships(ctx, trimmedID, asc.WithLinkagesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("review submissions-items-ids: %w", err)
@@ -0,0 +1,156 @@
+package reviews
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	reviewWaitDefaultTimeout      = 48 * time.Hour
+	reviewWaitDefaultPollInterval = time.Minute
+	reviewWaitDefaultMaxInterval  = 10 * time.Minute
+	reviewWaitDefaultSubmitGrace  = 10 * time.Minute
+)
+
+// ReviewSubmissionsWaitCommand returns the review submissions wait subcommand.
+func ReviewSubmissionsWaitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("submissions-wait", flag.ExitOnError)
+
+	submissionID := fs.String("id", "", "Review submission ID (required)")
+	timeout := fs.Duration("timeout", reviewWaitDefaultTimeout, "Maximum time to wait for a review decision")
+	pollInterval := fs.Duration("poll-interval", reviewWaitDefaultPollInterval, "Initial polling interval for submission state checks")
+	maxPollInterval := fs.Duration("max-poll-interval", reviewWaitDefaultMaxInterval, "Longest wait between checks; the interval doubles after each check up to this cap")
+	submitGrace := fs.Duration("submit-grace", reviewWaitDefaultSubmitGrace, "How long READY_FOR_REVIEW may last before a never-submitted submission fails")
+	progress := fs.Bool("progress", false, "Print each state transition to stderr")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "submissions-wait",
+		ShortUsage: "asc review submissions-wait --id SUBMISSION_ID [flags]",
+		ShortHelp:  "Wait for a review submission to reach a decision.",
+		LongHelp: `Wait for a review submission to reach a decision.
+
+This command polls the review submission state until a terminal condition:
+  - COMPLETE          -> exits 0 and prints the submission
+  - UNRESOLVED_ISSUES -> exits non-zero (App Review found problems)
+  - CANCELING         -> exits non-zero (the submission was withdrawn)
+  - READY_FOR_REVIEW  -> exits non-zero once the submission was withdrawn
+                         after WAITING_FOR_REVIEW or IN_REVIEW, or was never
+                         submitted within --submit-grace
+
+WAITING_FOR_REVIEW, IN_REVIEW, and COMPLETING keep waiting, as does
+READY_FOR_REVIEW right after submitting while Apple processes it. The wait between
+checks starts at --poll-interval and doubles after each check, up to
+--max-poll-interval. Hitting --timeout exits non-zero.
+
+Examples:
+  asc review submissions-wait --id "SUBMISSION_ID"
+  asc review submissions-wait --id "SUBMISSION_ID" --progress
+  asc review submissions-wait --id "SUBMISSION_ID" --timeout 72h --poll-interval 5m
+  asc review submissions-wait --id "SUBMISSION_ID" --submit-grace 30m`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*submissionID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if *pollInterval <= 0 {
+				return shared.UsageError("--poll-interval must be greater than 0")
+			}
+			if *maxPollInterval <= 0 {
+				return shared.UsageError("--max-poll-interval must be greater than 0")
+			}
+			if *timeout <= 0 {
+				return shared.UsageError("--timeout must be greater than 0")
+			}
+			if *submitGrace < 0 {
+				return shared.UsageError("--submit-grace must be >= 0")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("review submissions-wait: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, *timeout)
+			defer cancel()
+
+			resp, err := waitForReviewSubmission(requestCtx, client, id, *pollInterval, *maxPollInterval, *submitGrace, *progress)
+			if err != nil {
+				if errors.Is(err, context.DeadlineExceeded) {
+					return fmt.Errorf("review submissions-wait: timed out waiting for submission %s after %s", id, (*timeout).Round(time.Second))
+				}
+				return fmt.Errorf("review submissions-wait: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func waitForReviewSubmission(
+	ctx context.Context,
+	client *asc.Client,
+	submissionID string,
+	pollInterval time.Duration,
+	maxPollInterval time.Duration,
+	submitGrace time.Duration,
+	progress bool,
+) (*asc.ReviewSubmissionResponse, error) {
+	started := time.Now()
+	lastState := ""
+	// READY_FOR_REVIEW is only terminal once the submission has left it, or if
+	// it never does; a freshly submitted item can report it for a short while.
+	submitted := false
+
+	return asc.PollUntilWithBackoff(ctx, pollInterval, maxPollInterval, func(ctx context.Context) (*asc.ReviewSubmissionResponse, bool, error) {
+		resp, err := client.GetReviewSubmission(ctx, submissionID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		state := strings.ToUpper(strings.TrimSpace(string(resp.Data.Attributes.SubmissionState)))
+		if state == "" {
+			state = "UNKNOWN"
+		}
+		if progress && state != lastState {
+			if lastState == "" {
+				fmt.Fprintf(os.Stderr, "Review submission %s: %s (%s elapsed)\n", submissionID, state, time.Since(started).Round(time.Second))
+			} else {
+				fmt.Fprintf(os.Stderr, "Review submission %s: %s -> %s (%s elapsed)\n", submissionID, lastState, state, time.Since(started).Round(time.Second))
+			}
+		}
+		lastState = state
+
+		switch asc.ReviewSubmissionState(state) {
+		case asc.ReviewSubmissionStateWaitingForReview, asc.ReviewSubmissionStateInReview:
+			submitted = true
+		case asc.ReviewSubmissionStateComplete:
+			return resp, true, nil
+		case asc.ReviewSubmissionStateUnresolvedIssues:
+			return nil, false, fmt.Errorf("submission %s has unresolved issues; check the Resolution Center", submissionID)
+		case asc.ReviewSubmissionStateCanceling:
+			return nil, false, fmt.Errorf("submission %s was canceled", submissionID)
+		case asc.ReviewSubmissionStateReadyForReview:
+			if submitted {
+				return nil, false, fmt.Errorf("submission %s was withdrawn from review (state %s)", submissionID, state)
+			}
+			if time.Since(started) >= submitGrace {
+				return nil, false, fmt.Errorf("submission %s is not submitted for review (state %s after %s)", submissionID, state, submitGrace.Round(time.Second))
+			}
+		}
+		return nil, false, nil
+	})
+}
@@ -155,6 +155,7 @@ func executeReviewsList(ctx context.Context, appID, output string, pretty bool,
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetReviews(ctx, appID, asc.WithNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("reviews: %w", err)
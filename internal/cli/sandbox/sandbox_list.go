@@ -85,7 +85,7 @@ Examples:
 				// Fetch all remaining pages
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSandboxTesters(ctx, asc.WithSandboxTestersNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("sandbox list: %w", err)
 				}
@@ -36,7 +36,16 @@ func ResolveAppIDWithLookup(ctx context.Context, client appLookupClient, appID s
 		return strings.TrimSpace(byBundle.Data[0].ID), nil
 	}
 	if len(byBundle.Data) > 1 {
-		return "", fmt.Errorf("multiple apps found for bundle ID %q; use --app with App Store Connect app ID", resolved)
+		choices := make([]Choice, 0, len(byBundle.Data))
+		for _, app := range byBundle.Data {
+			id := strings.TrimSpace(app.ID)
+			choices = append(choices, Choice{ID: id, Label: fmt.Sprintf("%s (%s)", id, app.Attributes.Name)})
+		}
+		return SelectAmbiguous(
+			fmt.Sprintf("Multiple apps match bundle ID %q. Select one:", resolved),
+			choices,
+			fmt.Errorf("multiple apps found for bundle ID %q; use --app with App Store Connect app ID", resolved),
+		)
 	}
 
 	nameMatchIDs, err := findExactAppNameMatches(ctx, client, resolved, true)
@@ -47,7 +56,7 @@ func ResolveAppIDWithLookup(ctx context.Context, client appLookupClient, appID s
 		return nameMatchIDs[0], nil
 	}
 	if len(nameMatchIDs) > 1 {
-		return "", fmt.Errorf("multiple apps found for name %q (%s); use --app with App Store Connect app ID", resolved, strings.Join(nameMatchIDs, ", "))
+		return selectAmbiguousAppName(resolved, nameMatchIDs)
 	}
 
 	// ASC name filtering is fuzzy in practice; full-scan fallback preserves exact-name semantics.
@@ -59,7 +68,7 @@ func ResolveAppIDWithLookup(ctx context.Context, client appLookupClient, appID s
 		return nameMatchIDs[0], nil
 	}
 	if len(nameMatchIDs) > 1 {
-		return "", fmt.Errorf("multiple apps found for name %q (%s); use --app with App Store Connect app ID", resolved, strings.Join(nameMatchIDs, ", "))
+		return selectAmbiguousAppName(resolved, nameMatchIDs)
 	}
 
 	// Backward compatibility: if no exact name match exists, keep legacy behavior
@@ -72,11 +81,24 @@ func ResolveAppIDWithLookup(ctx context.Context, client appLookupClient, appID s
 		return fuzzyMatches[0], nil
 	}
 	if len(fuzzyMatches) > 1 {
-		return "", fmt.Errorf("multiple apps found for name %q (%s); use --app with App Store Connect app ID", resolved, strings.Join(fuzzyMatches, ", "))
+		return selectAmbiguousAppName(resolved, fuzzyMatches)
 	}
 	return "", fmt.Errorf("app %q not found (expected app ID, exact bundle ID, or exact app name)", resolved)
 }
 
+// selectAmbiguousAppName prompts for one of several apps sharing a name.
+func selectAmbiguousAppName(name string, ids []string) (string, error) {
+	choices := make([]Choice, 0, len(ids))
+	for _, id := range ids {
+		choices = append(choices, Choice{ID: id})
+	}
+	return SelectAmbiguous(
+		fmt.Sprintf("Multiple apps match name %q. Select one:", name),
+		choices,
+		fmt.Errorf("multiple apps found for name %q (%s); use --app with App Store Connect app ID", name, strings.Join(ids, ", ")),
+	)
+}
+
 func findExactAppNameMatches(ctx context.Context, client appLookupClient, name string, useNameFilter bool) ([]string, error) {
 	name = strings.TrimSpace(name)
 	if name == "" || client == nil {
@@ -205,3 +205,26 @@ func TestResolveAppIDWithLookup_FallsBackToUniqueFuzzyMatchWhenNoExact(t *testin
 		t.Fatalf("expected legacy fuzzy fallback app id app-fuzzy, got %q", got)
 	}
 }
+
+func TestResolveAppIDWithLookup_PromptsForAmbiguousName(t *testing.T) {
+	stubInteractivePrompt(t, true, func(_ string, labels []string) (int, error) {
+		if len(labels) != 2 || labels[0] != "111" || labels[1] != "222" {
+			t.Fatalf("unexpected prompt labels %v", labels)
+		}
+		return 1, nil
+	})
+
+	client := &sequenceAppLookupStub{
+		responses: []*asc.AppsResponse{
+			{},
+			appsResponseFromApps([]appFixture{{id: "222", name: "Demo"}, {id: "111", name: "Demo"}}),
+		},
+	}
+	got, err := ResolveAppIDWithLookup(context.Background(), client, "Demo")
+	if err != nil {
+		t.Fatalf("ResolveAppIDWithLookup() error: %v", err)
+	}
+	if got != "222" {
+		t.Fatalf("expected selected app 222, got %q", got)
+	}
+}
@@ -23,11 +23,29 @@ func ResolveAppStoreVersionID(ctx context.Context, client *asc.Client, appID, ve
 		return "", fmt.Errorf("app store version not found for version %q and platform %q", version, platform)
 	}
 	if len(resp.Data) > 1 {
-		return "", fmt.Errorf("multiple app store versions found for version %q and platform %q (use --version-id)", version, platform)
+		return SelectAmbiguous(
+			fmt.Sprintf("Multiple app store versions match version %q. Select one:", version),
+			AppStoreVersionChoices(resp.Data),
+			fmt.Errorf("multiple app store versions found for version %q and platform %q (use --version-id)", version, platform),
+		)
 	}
 	return resp.Data[0].ID, nil
 }
 
+// AppStoreVersionChoices labels versions for SelectAmbiguous with their
+// platform and state.
+func AppStoreVersionChoices(versions []asc.Resource[asc.AppStoreVersionAttributes]) []Choice {
+	choices := make([]Choice, 0, len(versions))
+	for _, version := range versions {
+		attrs := version.Attributes
+		choices = append(choices, Choice{
+			ID:    version.ID,
+			Label: fmt.Sprintf("%s %s (%s, %s)", version.ID, attrs.VersionString, attrs.Platform, ResolveAppStoreVersionState(attrs)),
+		})
+	}
+	return choices
+}
+
 // ResolveAppInfoID resolves the app info ID, optionally using a provided override.
 func ResolveAppInfoID(ctx context.Context, client *asc.Client, appID, appInfoID string) (string, error) {
 	if strings.TrimSpace(appInfoID) != "" {
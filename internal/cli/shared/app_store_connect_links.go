@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const appStoreConnectWebURL = "https://appstoreconnect.apple.com"
+
+// App Store Connect web UI sections for AppStoreConnectAppURL. The empty
+// section is the app overview page.
+const (
+	AppSectionOverview   = ""
+	AppSectionAppStore   = "appstore"
+	AppSectionTestFlight = "testflight"
+	AppSectionReview     = "review"
+)
+
+// AppSections lists the named sections accepted by AppStoreConnectAppURL.
+var AppSections = []string{AppSectionTestFlight, AppSectionReview, AppSectionAppStore}
+
+// AppStoreConnectAppURL returns the App Store Connect web UI link for an app
+// section. Status links and asc open both build URLs here so they match.
+func AppStoreConnectAppURL(appID, section string) (string, error) {
+	appID = strings.TrimSpace(appID)
+	if appID == "" {
+		return "", fmt.Errorf("app ID is required")
+	}
+	path, err := appSectionPath(section)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/apps/%s%s", appStoreConnectWebURL, url.PathEscape(appID), path), nil
+}
+
+// ValidateAppSection reports whether section is accepted by
+// AppStoreConnectAppURL.
+func ValidateAppSection(section string) error {
+	_, err := appSectionPath(section)
+	return err
+}
+
+func appSectionPath(section string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(section)) {
+	case AppSectionOverview:
+		return "", nil
+	case AppSectionAppStore:
+		return "/appstore", nil
+	case AppSectionTestFlight:
+		return "/testflight/ios", nil
+	case AppSectionReview:
+		return "/appstore/review", nil
+	default:
+		return "", fmt.Errorf("unknown section %q (expected %s)", section, strings.Join(AppSections, ", "))
+	}
+}
@@ -0,0 +1,28 @@
+package shared
+
+import "testing"
+
+func TestAppStoreConnectAppURL(t *testing.T) {
+	tests := []struct {
+		section string
+		want    string
+	}{
+		{section: "", want: "https://appstoreconnect.apple.com/apps/123"},
+		{section: "appstore", want: "https://appstoreconnect.apple.com/apps/123/appstore"},
+		{section: "TestFlight", want: "https://appstoreconnect.apple.com/apps/123/testflight/ios"},
+		{section: "review", want: "https://appstoreconnect.apple.com/apps/123/appstore/review"},
+	}
+	for _, test := range tests {
+		got, err := AppStoreConnectAppURL("123", test.section)
+		if err != nil {
+			t.Fatalf("AppStoreConnectAppURL(%q) error: %v", test.section, err)
+		}
+		if got != test.want {
+			t.Fatalf("AppStoreConnectAppURL(%q) = %q, want %q", test.section, got, test.want)
+		}
+	}
+
+	if err := ValidateAppSection("pricing"); err == nil {
+		t.Fatal("expected error for unknown section")
+	}
+}
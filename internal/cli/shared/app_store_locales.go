@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"sort"
+	"strings"
+)
+
+// appStoreLocales lists the locales App Store Connect accepts for app-info
+// and version localizations.
+var appStoreLocales = []string{
+	"ar-SA",
+	"ca",
+	"cs",
+	"da",
+	"de-DE",
+	"el",
+	"en-AU",
+	"en-CA",
+	"en-GB",
+	"en-US",
+	"es-ES",
+	"es-MX",
+	"fi",
+	"fr-CA",
+	"fr-FR",
+	"he",
+	"hi",
+	"hr",
+	"hu",
+	"id",
+	"it",
+	"ja",
+	"ko",
+	"ms",
+	"nl-NL",
+	"no",
+	"pl",
+	"pt-BR",
+	"pt-PT",
+	"ro",
+	"ru",
+	"sk",
+	"sv",
+	"th",
+	"tr",
+	"uk",
+	"vi",
+	"zh-Hans",
+	"zh-Hant",
+}
+
+var appStoreLocaleByFold = func() map[string]string {
+	result := make(map[string]string, len(appStoreLocales))
+	for _, locale := range appStoreLocales {
+		result[strings.ToLower(locale)] = locale
+	}
+	return result
+}()
+
+// SupportedAppStoreLocales returns the App Store localization locales in sorted order.
+func SupportedAppStoreLocales() []string {
+	result := append([]string(nil), appStoreLocales...)
+	sort.Strings(result)
+	return result
+}
+
+// CanonicalAppStoreLocale returns Apple's spelling of locale (matched
+// case-insensitively) and whether it is a supported App Store locale.
+func CanonicalAppStoreLocale(locale string) (string, bool) {
+	canonical, ok := appStoreLocaleByFold[strings.ToLower(strings.TrimSpace(locale))]
+	return canonical, ok
+}
@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"context"
+	"sync"
+)
+
+// RunBounded calls fn for every index in [0, n) with at most limit calls in
+// flight. The first error cancels the context passed to the remaining calls,
+// and calls that have not started yet are skipped. It returns the first error,
+// or the parent context's error when ctx ends before every call finished.
+func RunBounded(ctx context.Context, n, limit int, fn func(ctx context.Context, index int) error) error {
+	if n <= 0 {
+		return nil
+	}
+	limit = max(min(limit, n), 1)
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, limit)
+	var (
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	for index := range n {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+			case <-workerCtx.Done():
+				return
+			}
+			defer func() { <-sem }()
+			if workerCtx.Err() != nil {
+				return
+			}
+
+			if err := fn(workerCtx, index); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		})
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
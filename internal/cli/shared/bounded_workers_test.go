@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBounded_RespectsLimit(t *testing.T) {
+	var running, peak, calls atomic.Int32
+	err := RunBounded(context.Background(), 8, 3, func(ctx context.Context, index int) error {
+		calls.Add(1)
+		current := running.Add(1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RunBounded() error: %v", err)
+	}
+	if got := calls.Load(); got != 8 {
+		t.Fatalf("expected 8 calls, got %d", got)
+	}
+	if got := peak.Load(); got > 3 {
+		t.Fatalf("expected at most 3 concurrent calls, got %d", got)
+	}
+}
+
+func TestRunBounded_FirstErrorCancelsRemainingCalls(t *testing.T) {
+	wantErr := errors.New("boom")
+	var started atomic.Int32
+	err := RunBounded(context.Background(), 20, 1, func(ctx context.Context, index int) error {
+		started.Add(1)
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if got := started.Load(); got != 1 {
+		t.Fatalf("expected calls after the first error to be skipped, got %d calls", got)
+	}
+}
+
+func TestRunBounded_ReturnsParentContextError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	err := RunBounded(ctx, 5, 2, func(ctx context.Context, index int) error {
+		calls.Add(1)
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Fatalf("expected no calls on a canceled context, got %d", got)
+	}
+}
@@ -60,8 +60,53 @@ func TestApplyRootLoggingOverridesAPIDebugEnablesDebug(t *testing.T) {
 	}
 }
 
+func TestApplyRootLoggingOverridesVerboseLevels(t *testing.T) {
+	resetRootLoggingFlagsForTest()
+	t.Cleanup(func() {
+		resetRootLoggingFlagsForTest()
+		asc.SetVerboseLevel(0)
+	})
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{args: nil, want: 0},
+		{args: []string{"--verbose"}, want: asc.VerboseRequests},
+		{args: []string{"--verbose=2"}, want: asc.VerboseBodies},
+		{args: []string{"--verbose=2", "--verbose=false"}, want: 0},
+		{args: []string{"-v"}, want: asc.VerboseRequests},
+		{args: []string{"-v=2"}, want: asc.VerboseBodies},
+	}
+	for _, test := range tests {
+		fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		BindRootFlags(fs)
+		if err := fs.Parse(test.args); err != nil {
+			t.Fatalf("parse %v: %v", test.args, err)
+		}
+		ApplyRootLoggingOverrides()
+		if got := asc.ResolveVerboseLevel(); got != test.want {
+			t.Fatalf("args %v: verbose level = %d, want %d", test.args, got, test.want)
+		}
+	}
+}
+
+func TestBindRootFlagsRejectsInvalidVerboseLevel(t *testing.T) {
+	resetRootLoggingFlagsForTest()
+	t.Cleanup(resetRootLoggingFlagsForTest)
+
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	BindRootFlags(fs)
+	if err := fs.Parse([]string{"--verbose=3"}); err == nil {
+		t.Fatal("expected --verbose=3 to be rejected")
+	}
+}
+
 func resetRootLoggingFlagsForTest() {
 	retryLog = OptionalBool{}
 	debug = OptionalBool{}
 	apiDebug = OptionalBool{}
+	verbose = 0
 }
@@ -2,7 +2,9 @@ package errfmt
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"strings"
 
@@ -57,6 +59,13 @@ func Classify(err error) ClassifiedError {
 		}
 	}
 
+	if isClockSkew(err) {
+		return ClassifiedError{
+			Message: err.Error(),
+			Hint:    "Turn on automatic date and time (or sync with NTP) and run the command again. `asc doctor` checks the clock against Apple's servers.",
+		}
+	}
+
 	if errors.Is(err, asc.ErrUnauthorized) {
 		return ClassifiedError{
 			Message: err.Error(),
@@ -95,3 +104,83 @@ func FormatStderr(err error) string {
 	}
 	return fmt.Sprintf("Error: %s\nHint: %s\n", ce.Message, ce.Hint)
 }
+
+// JSONError is the payload of the structured error written to stderr when a
+// command runs with --output json.
+type JSONError struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Detail    string `json:"detail,omitempty"`
+	Status    int    `json:"status,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+	Hint      string `json:"hint,omitempty"`
+}
+
+// ToJSONError builds the structured form of err. App Store Connect errors keep
+// their code, detail, HTTP status, and request ID header; other errors get a
+// code derived from their classification.
+func ToJSONError(err error) JSONError {
+	if err == nil {
+		return JSONError{}
+	}
+
+	ce := Classify(err)
+	result := JSONError{
+		Code:    errorCode(err),
+		Message: ce.Message,
+		Hint:    ce.Hint,
+	}
+	if apiErr, ok := errors.AsType[*asc.APIError](err); ok {
+		if code := strings.TrimSpace(apiErr.Code); code != "" && !isClockSkew(err) {
+			result.Code = code
+		}
+		result.Detail = strings.TrimSpace(apiErr.Detail)
+		result.Status = apiErr.StatusCode
+		result.RequestID = strings.TrimSpace(apiErr.RequestID)
+	}
+	return result
+}
+
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return "USAGE"
+	case errors.Is(err, shared.ErrMissingAuth):
+		return "MISSING_AUTH"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "TIMEOUT"
+	case isClockSkew(err):
+		return "CLOCK_SKEW"
+	case errors.Is(err, shared.ErrBlockingIssues):
+		return "BLOCKING_ISSUES"
+	case errors.Is(err, asc.ErrUnauthorized):
+		return "UNAUTHORIZED"
+	case errors.Is(err, asc.ErrForbidden):
+		return "FORBIDDEN"
+	case errors.Is(err, asc.ErrNotFound):
+		return "NOT_FOUND"
+	case errors.Is(err, asc.ErrConflict):
+		return "CONFLICT"
+	default:
+		return "ERROR"
+	}
+}
+
+func isClockSkew(err error) bool {
+	_, ok := errors.AsType[*asc.ClockSkewError](err)
+	return ok
+}
+
+// FormatStderrJSON renders err as a single-line {"error": {...}} object.
+func FormatStderrJSON(err error) string {
+	if err == nil {
+		return ""
+	}
+	payload, marshalErr := json.Marshal(struct {
+		Error JSONError `json:"error"`
+	}{Error: ToJSONError(err)})
+	if marshalErr != nil {
+		return FormatStderr(err)
+	}
+	return string(payload) + "\n"
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
@@ -130,3 +131,66 @@ func wrap(base error, target error) error {
 	_ = base
 	return isWrapper{target: target}
 }
+
+func TestFormatStderrJSON_APIError(t *testing.T) {
+	err := fmt.Errorf("apps get: %w", &asc.APIError{
+		RequestID:  "abc-123",
+		Code:       "ENTITY_ERROR.ATTRIBUTE.INVALID",
+		Title:      "An attribute value is invalid.",
+		Detail:     "The bundle ID is taken.",
+		StatusCode: 409,
+	})
+
+	got := FormatStderrJSON(err)
+	want := `{"error":{"code":"ENTITY_ERROR.ATTRIBUTE.INVALID","message":"apps get: ENTITY_ERROR.ATTRIBUTE.INVALID: An attribute value is invalid.: The bundle ID is taken. (request-id: abc-123)","detail":"The bundle ID is taken.","status":409,"requestId":"abc-123"}}` + "\n"
+	if got != want {
+		t.Fatalf("FormatStderrJSON() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatStderrJSON_ReportedBlockingIssues(t *testing.T) {
+	err := shared.NewBlockingIssuesError(errors.New("validate: found 2 error(s)"))
+
+	got := ToJSONError(err)
+	if got.Code != "BLOCKING_ISSUES" {
+		t.Fatalf("expected BLOCKING_ISSUES code, got %q", got.Code)
+	}
+	if got.Message != "validate: found 2 error(s)" {
+		t.Fatalf("unexpected message %q", got.Message)
+	}
+	if got.RequestID != "" || got.Status != 0 {
+		t.Fatalf("expected no API fields, got %+v", got)
+	}
+}
+
+func TestFormatStderrJSON_GenericError(t *testing.T) {
+	got := FormatStderrJSON(errors.New("boom"))
+	if got != `{"error":{"code":"ERROR","message":"boom"}}`+"\n" {
+		t.Fatalf("unexpected output %q", got)
+	}
+}
+
+func TestToJSONError_OmitsRequestIDWithoutHeader(t *testing.T) {
+	got := ToJSONError(asc.ParseErrorWithStatus([]byte(`{"errors":[{"id":"error-id","status":"404","code":"NOT_FOUND","title":"Not found"}]}`), 404))
+	if got.RequestID != "" {
+		t.Fatalf("expected errors[].id not to be reported as a request ID, got %q", got.RequestID)
+	}
+}
+
+func TestClassify_ClockSkew(t *testing.T) {
+	err := fmt.Errorf("apps list: %w", &asc.ClockSkewError{
+		Skew: 2 * time.Minute,
+		Err:  &asc.APIError{Code: "NOT_AUTHORIZED", StatusCode: 401},
+	})
+
+	ce := Classify(err)
+	if !strings.Contains(ce.Message, "your system clock is off by 120 seconds") {
+		t.Fatalf("expected clock skew message, got %q", ce.Message)
+	}
+	if !strings.Contains(ce.Hint, "automatic date and time") {
+		t.Fatalf("expected clock hint, got %q", ce.Hint)
+	}
+	if got := ToJSONError(err); got.Code != "CLOCK_SKEW" || got.Status != 401 {
+		t.Fatalf("expected CLOCK_SKEW code with status 401, got %+v", got)
+	}
+}
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -38,10 +39,69 @@ func NewReportedError(err error) error {
 	return reportedError{err: err}
 }
 
+// ErrBlockingIssues marks a check that completed but found blocking issues,
+// such as a validate command reporting errors.
+var ErrBlockingIssues = errors.New("blocking issues found")
+
+type blockingIssuesError struct {
+	err error
+}
+
+func (e blockingIssuesError) Error() string {
+	return e.err.Error()
+}
+
+func (e blockingIssuesError) Unwrap() error {
+	return e.err
+}
+
+func (e blockingIssuesError) Is(target error) bool {
+	return target == ErrBlockingIssues
+}
+
+// NewBlockingIssuesError wraps an already-reported error for a check that
+// found blocking issues, so callers map it to the blocking exit code.
+func NewBlockingIssuesError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return NewReportedError(blockingIssuesError{err: err})
+}
+
+// jsonUsageErrors defers usage error output to the caller, which renders it as
+// a structured JSON error when the command runs with --output json.
+var jsonUsageErrors bool
+
+// SetJSONUsageErrors makes UsageError return its message instead of printing
+// it, so it can be rendered as a structured JSON error.
+func SetJSONUsageErrors(enabled bool) {
+	jsonUsageErrors = enabled
+}
+
+// usageError carries a usage error's message when printing is deferred. It
+// matches flag.ErrHelp so exit code mapping is unchanged.
+type usageError struct {
+	message string
+}
+
+func (e usageError) Error() string { return e.message }
+
+func (e usageError) Unwrap() error { return flag.ErrHelp }
+
+// IsDeferredUsageError reports whether err carries a usage error message that
+// UsageError left for the caller to render.
+func IsDeferredUsageError(err error) bool {
+	_, ok := errors.AsType[usageError](err)
+	return ok
+}
+
 // UsageError prints a CLI validation error and returns flag.ErrHelp so callers
 // map the failure to usage exit code semantics.
 func UsageError(message string) error {
 	trimmed := strings.TrimSpace(message)
+	if jsonUsageErrors && trimmed != "" {
+		return usageError{message: trimmed}
+	}
 	if trimmed != "" {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", trimmed)
 	}
@@ -0,0 +1,79 @@
+package shared
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// GitHubOutputEnv names the file GitHub Actions reads step outputs from.
+const GitHubOutputEnv = "GITHUB_OUTPUT"
+
+// GitHubOutput is a single step output written as a name=value line.
+type GitHubOutput struct {
+	Name  string
+	Value string
+}
+
+// BindSetOutputFlag registers the --set-output flag.
+func BindSetOutputFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("set-output", false, "Write key results as name=value step outputs to $"+GitHubOutputEnv)
+}
+
+// ResolveGitHubOutputPath returns the step output file when --set-output is
+// enabled, or an empty path when it is not.
+func ResolveGitHubOutputPath(enabled bool) (string, error) {
+	if !enabled {
+		return "", nil
+	}
+	path := strings.TrimSpace(os.Getenv(GitHubOutputEnv))
+	if path == "" {
+		return "", fmt.Errorf("--set-output requires %s to be set", GitHubOutputEnv)
+	}
+	return path, nil
+}
+
+// WriteGitHubOutputs appends outputs to the step output file at path.
+// Multi-line values use the heredoc delimiter syntax.
+func WriteGitHubOutputs(path string, outputs []GitHubOutput) error {
+	if path == "" || len(outputs) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	for _, output := range outputs {
+		if !strings.ContainsAny(output.Value, "\r\n") {
+			fmt.Fprintf(&b, "%s=%s\n", output.Name, output.Value)
+			continue
+		}
+		delimiter, err := githubOutputDelimiter()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "%s<<%s\n%s\n%s\n", output.Name, delimiter, output.Value, delimiter)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", GitHubOutputEnv, err)
+	}
+	if _, err := file.WriteString(b.String()); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write %s: %w", GitHubOutputEnv, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", GitHubOutputEnv, err)
+	}
+	return nil
+}
+
+func githubOutputDelimiter() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate output delimiter: %w", err)
+	}
+	return "ASC_EOF_" + hex.EncodeToString(buf), nil
+}
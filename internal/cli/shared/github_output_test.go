@@ -0,0 +1,51 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func TestResolveGitHubOutputPath(t *testing.T) {
+	t.Setenv(GitHubOutputEnv, "")
+
+	path, err := ResolveGitHubOutputPath(false)
+	if err != nil || path != "" {
+		t.Fatalf("expected disabled output to resolve empty, got %q, %v", path, err)
+	}
+	if _, err := ResolveGitHubOutputPath(true); err == nil {
+		t.Fatal("expected error when GITHUB_OUTPUT is unset")
+	}
+
+	t.Setenv(GitHubOutputEnv, "/tmp/github-output")
+	path, err = ResolveGitHubOutputPath(true)
+	if err != nil || path != "/tmp/github-output" {
+		t.Fatalf("expected GITHUB_OUTPUT path, got %q, %v", path, err)
+	}
+}
+
+func TestWriteGitHubOutputs_AppendsLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output")
+	if err := os.WriteFile(path, []byte("existing=1\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	err := WriteGitHubOutputs(path, []GitHubOutput{
+		{Name: "ready", Value: "true"},
+		{Name: "next_action", Value: "line one\nline two"},
+	})
+	if err != nil {
+		t.Fatalf("WriteGitHubOutputs() error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	pattern := regexp.MustCompile(`^existing=1\nready=true\nnext_action<<(ASC_EOF_[0-9a-f]+)\nline one\nline two\n(ASC_EOF_[0-9a-f]+)\n$`)
+	match := pattern.FindStringSubmatch(string(data))
+	if match == nil || match[1] != match[2] {
+		t.Fatalf("unexpected output file contents %q", string(data))
+	}
+}
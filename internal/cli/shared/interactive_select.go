@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+const interactiveEnvVar = "ASC_INTERACTIVE"
+
+var (
+	// interactive opts in to prompts; ASC_INTERACTIVE=1 does the same.
+	interactive bool
+	// interactiveDisabled keeps prompts off for commands gated by --confirm,
+	// so a confirmed destructive run never acts on a choice made at a prompt.
+	interactiveDisabled bool
+)
+
+// Choice is one candidate offered by SelectAmbiguous.
+type Choice struct {
+	ID    string
+	Label string
+}
+
+// promptSelectFn asks the user to pick one of labels and returns its index.
+var promptSelectFn = func(message string, labels []string) (int, error) {
+	var index int
+	err := survey.AskOne(&survey.Select{
+		Message: message,
+		Options: labels,
+	}, &index, survey.WithStdio(os.Stdin, os.Stderr, os.Stderr))
+	return index, err
+}
+
+// InteractiveEnabled reports whether commands may prompt for input. Prompts
+// are opt-in through --interactive or ASC_INTERACTIVE, need a terminal on
+// stdin and stderr, and are never used by commands that take --confirm.
+func InteractiveEnabled() bool {
+	if interactiveDisabled || !interactiveRequested() {
+		return false
+	}
+	return isTerminal(int(os.Stdin.Fd())) && isTerminal(int(os.Stderr.Fd()))
+}
+
+// ValidateInteractiveFlag disables prompts for the selected command when it is
+// gated by --confirm, rejecting an explicit --interactive there.
+func ValidateInteractiveFlag(confirmGated bool) error {
+	interactiveDisabled = confirmGated
+	if confirmGated && interactive {
+		return UsageError("--interactive is not supported by commands that require --confirm")
+	}
+	return nil
+}
+
+func interactiveRequested() bool {
+	if interactive {
+		return true
+	}
+	value := strings.TrimSpace(os.Getenv(interactiveEnvVar))
+	if value == "" {
+		return false
+	}
+	switch strings.ToLower(value) {
+	case "1", "t", "true", "yes", "y", "on":
+		return true
+	case "0", "f", "false", "no", "n", "off":
+		return false
+	default:
+		fmt.Fprintf(
+			os.Stderr,
+			"Warning: invalid %s value %q (expected true/false, 1/0, yes/no, y/n, or on/off); prompts disabled\n",
+			interactiveEnvVar,
+			value,
+		)
+		return false
+	}
+}
+
+// SelectAmbiguous lets the user pick one of several candidates for an
+// ambiguous identifier. When prompting is not possible it returns ambiguousErr
+// unchanged, so non-interactive runs fail exactly as before.
+func SelectAmbiguous(message string, choices []Choice, ambiguousErr error) (string, error) {
+	if len(choices) < 2 || !InteractiveEnabled() {
+		return "", ambiguousErr
+	}
+
+	labels := make([]string, len(choices))
+	for i, choice := range choices {
+		labels[i] = choice.Label
+		if labels[i] == "" {
+			labels[i] = choice.ID
+		}
+	}
+	index, err := promptSelectFn(message, labels)
+	if err != nil {
+		return "", fmt.Errorf("%w (selection cancelled: %v)", ambiguousErr, err)
+	}
+	if index < 0 || index >= len(choices) {
+		return "", ambiguousErr
+	}
+	return choices[index].ID, nil
+}
@@ -0,0 +1,108 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"testing"
+)
+
+func stubInteractivePrompt(t *testing.T, terminal bool, prompt func(string, []string) (int, error)) {
+	t.Helper()
+	prevIsTerminal := isTerminal
+	prevPrompt := promptSelectFn
+	prevInteractive := interactive
+	prevDisabled := interactiveDisabled
+	t.Cleanup(func() {
+		isTerminal = prevIsTerminal
+		promptSelectFn = prevPrompt
+		interactive = prevInteractive
+		interactiveDisabled = prevDisabled
+	})
+	t.Setenv(interactiveEnvVar, "")
+	isTerminal = func(int) bool { return terminal }
+	promptSelectFn = prompt
+	interactive = true
+	interactiveDisabled = false
+}
+
+func TestSelectAmbiguous_PromptsWhenInteractive(t *testing.T) {
+	var gotLabels []string
+	stubInteractivePrompt(t, true, func(_ string, labels []string) (int, error) {
+		gotLabels = labels
+		return 1, nil
+	})
+
+	choices := []Choice{{ID: "ver-1", Label: "ver-1 1.0 (IOS)"}, {ID: "ver-2"}}
+	got, err := SelectAmbiguous("Select one:", choices, errors.New("ambiguous"))
+	if err != nil {
+		t.Fatalf("SelectAmbiguous() error: %v", err)
+	}
+	if got != "ver-2" {
+		t.Fatalf("expected ver-2, got %q", got)
+	}
+	if len(gotLabels) != 2 || gotLabels[0] != "ver-1 1.0 (IOS)" || gotLabels[1] != "ver-2" {
+		t.Fatalf("unexpected prompt labels %v", gotLabels)
+	}
+}
+
+func TestSelectAmbiguous_ReturnsErrorWithoutPrompt(t *testing.T) {
+	ambiguousErr := errors.New("ambiguous")
+	choices := []Choice{{ID: "a"}, {ID: "b"}}
+
+	tests := []struct {
+		name         string
+		terminal     bool
+		interactive  bool
+		env          string
+		confirmGated bool
+	}{
+		{name: "not a terminal", terminal: false, interactive: true},
+		{name: "not requested", terminal: true},
+		{name: "env disabled", terminal: true, env: "0"},
+		{name: "confirm-gated command", terminal: true, env: "1", confirmGated: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stubInteractivePrompt(t, test.terminal, func(string, []string) (int, error) {
+				t.Fatal("unexpected prompt")
+				return 0, nil
+			})
+			interactive = test.interactive
+			t.Setenv(interactiveEnvVar, test.env)
+			if err := ValidateInteractiveFlag(test.confirmGated); err != nil {
+				t.Fatalf("ValidateInteractiveFlag() error: %v", err)
+			}
+
+			if _, err := SelectAmbiguous("Select one:", choices, ambiguousErr); !errors.Is(err, ambiguousErr) {
+				t.Fatalf("expected ambiguous error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSelectAmbiguous_PromptsWhenEnvOptsIn(t *testing.T) {
+	stubInteractivePrompt(t, true, func(string, []string) (int, error) {
+		return 0, nil
+	})
+	interactive = false
+	t.Setenv(interactiveEnvVar, "1")
+
+	got, err := SelectAmbiguous("Select one:", []Choice{{ID: "a"}, {ID: "b"}}, errors.New("ambiguous"))
+	if err != nil {
+		t.Fatalf("SelectAmbiguous() error: %v", err)
+	}
+	if got != "a" {
+		t.Fatalf("expected a, got %q", got)
+	}
+}
+
+func TestValidateInteractiveFlagRejectsConfirmGatedCommands(t *testing.T) {
+	stubInteractivePrompt(t, true, nil)
+
+	if err := ValidateInteractiveFlag(true); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	if InteractiveEnabled() {
+		t.Fatal("expected prompts to stay disabled for a --confirm command")
+	}
+}
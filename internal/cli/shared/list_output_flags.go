@@ -0,0 +1,37 @@
+package shared
+
+import "strings"
+
+// ValidateListOutputFlags rejects --count, --filter, --sort-by, and --sort-desc
+// before any request is made when the selected command does not print a list,
+// or when --count is combined with an output format it cannot annotate.
+// listCommand reports whether the command binds --paginate or --limit; format
+// is the command's --output value, or empty when it has none.
+func ValidateListOutputFlags(listCommand bool, format string) error {
+	if outputSortDesc && strings.TrimSpace(outputSortBy) == "" {
+		return UsageError("--sort-desc requires --sort-by")
+	}
+	if !listCommand {
+		for _, check := range []struct {
+			name string
+			set  bool
+		}{
+			{"--count", outputCount},
+			{"--filter", len(outputFilters) > 0},
+			{"--sort-by", strings.TrimSpace(outputSortBy) != ""},
+		} {
+			if check.set {
+				return UsageErrorf("%s is only valid for list commands", check.name)
+			}
+		}
+		return nil
+	}
+	if outputCount {
+		switch NormalizeOutputFormat(format) {
+		case "", "json", "table", "markdown":
+		default:
+			return UsageError("--count is only valid with json, table, or markdown output")
+		}
+	}
+	return nil
+}
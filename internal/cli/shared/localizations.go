@@ -68,6 +68,33 @@ type appInfoLocalizationClient interface {
 	UpdateAppInfoLocalization(context.Context, string, asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error)
 }
 
+// FetchVersionLocalizations returns every localization of an App Store
+// version, following pagination.
+func FetchVersionLocalizations(ctx context.Context, client *asc.Client, versionID string) ([]asc.Resource[asc.AppStoreVersionLocalizationAttributes], error) {
+	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	if firstPage == nil || firstPage.Links.Next == "" {
+		if firstPage == nil {
+			return nil, nil
+		}
+		return firstPage.Data, nil
+	}
+
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	typed, ok := paginated.(*asc.AppStoreVersionLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pagination response type")
+	}
+	return typed.Data, nil
+}
+
 func NormalizeLocalizationType(value string) (string, error) {
 	normalized := strings.ToLower(strings.TrimSpace(value))
 	switch normalized {
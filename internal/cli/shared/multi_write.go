@@ -0,0 +1,197 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileWrite describes one file written by WriteFilesNoSymlink.
+type FileWrite struct {
+	Path string
+	Data []byte
+	Perm os.FileMode
+}
+
+type stagedFileWrite struct {
+	target    string
+	temp      string
+	backup    string
+	committed bool
+}
+
+// WriteFilesNoSymlink writes a set of files as a unit: either every file is in
+// place when it returns nil, or none of the destinations were changed.
+//
+// All files are first staged to temp files next to their destinations. They
+// are then moved into place one by one; if any move fails, files already moved
+// are removed and any originals they replaced are restored from backups.
+// Symlink destinations are always refused. When overwrite is false, existing
+// destinations are an error and are never replaced.
+func WriteFilesNoSymlink(files []FileWrite, overwrite bool, tempPattern string, backupPattern string) (err error) {
+	seen := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		if file.Path == "" {
+			return fmt.Errorf("output path is required")
+		}
+		cleaned := filepath.Clean(file.Path)
+		if _, ok := seen[cleaned]; ok {
+			return fmt.Errorf("output path %q is listed more than once", file.Path)
+		}
+		seen[cleaned] = struct{}{}
+
+		if info, statErr := os.Lstat(file.Path); statErr == nil {
+			if info.Mode()&os.ModeSymlink != 0 {
+				return fmt.Errorf("refusing to overwrite symlink %q", file.Path)
+			}
+			if info.IsDir() {
+				return fmt.Errorf("output path %q is a directory", file.Path)
+			}
+			if !overwrite {
+				return fmt.Errorf("output file already exists: %s: %w", file.Path, os.ErrExist)
+			}
+		} else if !errors.Is(statErr, os.ErrNotExist) {
+			return statErr
+		}
+	}
+
+	staged := make([]*stagedFileWrite, 0, len(files))
+	defer func() {
+		if err != nil {
+			rollbackStagedFiles(staged)
+			return
+		}
+		for _, item := range staged {
+			if item.backup != "" {
+				_ = os.Remove(item.backup)
+			}
+		}
+	}()
+
+	for _, file := range files {
+		item, stageErr := stageFileWrite(file, tempPattern)
+		if item != nil {
+			staged = append(staged, item)
+		}
+		if stageErr != nil {
+			return stageErr
+		}
+	}
+
+	for _, item := range staged {
+		if err := commitStagedFile(item, overwrite, backupPattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func stageFileWrite(file FileWrite, tempPattern string) (*stagedFileWrite, error) {
+	dir := filepath.Dir(file.Path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	tempFile, err := createTempFileNoFollowWithPerm(dir, tempPattern, file.Perm)
+	if err != nil {
+		return nil, err
+	}
+	item := &stagedFileWrite{target: file.Path, temp: tempFile.Name()}
+
+	if _, err := tempFile.Write(file.Data); err != nil {
+		_ = tempFile.Close()
+		return item, err
+	}
+	if err := tempFile.Sync(); err != nil {
+		_ = tempFile.Close()
+		return item, err
+	}
+	return item, tempFile.Close()
+}
+
+func commitStagedFile(item *stagedFileWrite, overwrite bool, backupPattern string) error {
+	if !overwrite {
+		// Link fails if the destination appeared since validation, so a file
+		// created concurrently is never clobbered.
+		if err := os.Link(item.temp, item.target); err != nil {
+			if errors.Is(err, os.ErrExist) {
+				return fmt.Errorf("output file already exists: %w", err)
+			}
+			if _, statErr := os.Lstat(item.target); !errors.Is(statErr, os.ErrNotExist) {
+				return err
+			}
+			if err := os.Rename(item.temp, item.target); err != nil {
+				return err
+			}
+		} else {
+			_ = os.Remove(item.temp)
+		}
+		item.temp = ""
+		item.committed = true
+		return nil
+	}
+
+	info, err := os.Lstat(item.target)
+	switch {
+	case err == nil:
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("refusing to overwrite symlink %q", item.target)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("output path %q is a directory", item.target)
+		}
+		backupPath, err := reserveBackupPath(filepath.Dir(item.target), backupPattern)
+		if err != nil {
+			return err
+		}
+		if err := os.Rename(item.target, backupPath); err != nil {
+			return err
+		}
+		item.backup = backupPath
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+
+	if err := os.Rename(item.temp, item.target); err != nil {
+		if item.backup != "" {
+			if restoreErr := os.Rename(item.backup, item.target); restoreErr == nil {
+				item.backup = ""
+			}
+		}
+		return err
+	}
+	item.temp = ""
+	item.committed = true
+	return nil
+}
+
+func rollbackStagedFiles(staged []*stagedFileWrite) {
+	for i := len(staged) - 1; i >= 0; i-- {
+		item := staged[i]
+		if item.temp != "" {
+			_ = os.Remove(item.temp)
+		}
+		if item.committed {
+			_ = os.Remove(item.target)
+		}
+		if item.backup != "" {
+			_ = os.Rename(item.backup, item.target)
+		}
+	}
+}
+
+func reserveBackupPath(dir string, backupPattern string) (string, error) {
+	backupFile, err := os.CreateTemp(dir, backupPattern)
+	if err != nil {
+		return "", err
+	}
+	backupPath := backupFile.Name()
+	if err := backupFile.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Remove(backupPath); err != nil {
+		return "", err
+	}
+	return backupPath, nil
+}
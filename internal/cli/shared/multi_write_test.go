@@ -0,0 +1,145 @@
+package shared
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteFilesNoSymlink_WritesAllFiles(t *testing.T) {
+	dir := t.TempDir()
+	profilePath := filepath.Join(dir, "profile.mobileprovision")
+	certPath := filepath.Join(dir, "certs", "cert.cer")
+
+	err := WriteFilesNoSymlink([]FileWrite{
+		{Path: profilePath, Data: []byte("profile"), Perm: 0o644},
+		{Path: certPath, Data: []byte("cert"), Perm: 0o600},
+	}, false, ".asc-test-*", ".asc-test-backup-*")
+	if err != nil {
+		t.Fatalf("WriteFilesNoSymlink() error: %v", err)
+	}
+
+	assertFileContent(t, profilePath, "profile")
+	assertFileContent(t, certPath, "cert")
+	assertNoTempFiles(t, dir)
+}
+
+func TestWriteFilesNoSymlink_NoOverwriteLeavesNothingBehind(t *testing.T) {
+	dir := t.TempDir()
+	profilePath := filepath.Join(dir, "profile.mobileprovision")
+	certPath := filepath.Join(dir, "cert.cer")
+	if err := os.WriteFile(certPath, []byte("existing"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	err := WriteFilesNoSymlink([]FileWrite{
+		{Path: profilePath, Data: []byte("profile"), Perm: 0o644},
+		{Path: certPath, Data: []byte("cert"), Perm: 0o600},
+	}, false, ".asc-test-*", ".asc-test-backup-*")
+	if !errors.Is(err, os.ErrExist) {
+		t.Fatalf("expected ErrExist, got %v", err)
+	}
+
+	if _, err := os.Stat(profilePath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected profile not to be written, stat err=%v", err)
+	}
+	assertFileContent(t, certPath, "existing")
+	assertNoTempFiles(t, dir)
+}
+
+func TestWriteFilesNoSymlink_RollsBackOnCommitFailure(t *testing.T) {
+	dir := t.TempDir()
+	profilePath := filepath.Join(dir, "profile.mobileprovision")
+	if err := os.WriteFile(profilePath, []byte("old profile"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	// A directory appearing at the second destination after validation makes
+	// its commit fail once the first file has already been moved into place.
+	blockedPath := filepath.Join(dir, "cert.cer")
+
+	err := commitThenFail(t, profilePath, blockedPath)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	assertFileContent(t, profilePath, "old profile")
+	assertNoTempFiles(t, dir)
+}
+
+func TestWriteFilesNoSymlink_RefusesSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	if err := os.WriteFile(target, []byte("target"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	link := filepath.Join(dir, "link.cer")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+
+	err := WriteFilesNoSymlink([]FileWrite{
+		{Path: link, Data: []byte("cert"), Perm: 0o600},
+	}, true, ".asc-test-*", ".asc-test-backup-*")
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite symlink") {
+		t.Fatalf("expected symlink refusal, got %v", err)
+	}
+	assertFileContent(t, target, "target")
+}
+
+// commitThenFail mirrors WriteFilesNoSymlink's commit loop, creating a
+// directory at blockedPath between staging and commit.
+func commitThenFail(t *testing.T, profilePath, blockedPath string) error {
+	t.Helper()
+
+	profile, err := stageFileWrite(FileWrite{Path: profilePath, Data: []byte("new profile"), Perm: 0o644}, ".asc-test-*")
+	if err != nil {
+		t.Fatalf("stageFileWrite() error: %v", err)
+	}
+	cert, err := stageFileWrite(FileWrite{Path: blockedPath, Data: []byte("cert"), Perm: 0o600}, ".asc-test-*")
+	if err != nil {
+		t.Fatalf("stageFileWrite() error: %v", err)
+	}
+	if err := os.Mkdir(blockedPath, 0o755); err != nil {
+		t.Fatalf("Mkdir() error: %v", err)
+	}
+	staged := []*stagedFileWrite{profile, cert}
+
+	for _, item := range staged {
+		if err := commitStagedFile(item, true, ".asc-test-backup-*"); err != nil {
+			rollbackStagedFiles(staged)
+			return err
+		}
+	}
+	return nil
+}
+
+func assertFileContent(t *testing.T, path, want string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%q) error: %v", path, err)
+	}
+	if string(data) != want {
+		t.Fatalf("content of %q = %q, want %q", path, string(data), want)
+	}
+}
+
+func assertNoTempFiles(t *testing.T, dir string) {
+	t.Helper()
+
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(d.Name(), ".asc-test-") {
+			t.Fatalf("unexpected leftover file %q", path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkDir() error: %v", err)
+	}
+}
@@ -1,4 +1,4 @@
-package auth
+package shared
 
 import (
 	"fmt"
@@ -8,7 +8,8 @@ import (
 	"strings"
 )
 
-func openURL(target string) error {
+// OpenURL opens an http(s) URL in the default browser without waiting for it.
+func OpenURL(target string) error {
 	target = strings.TrimSpace(target)
 	if target == "" {
 		return fmt.Errorf("empty URL")
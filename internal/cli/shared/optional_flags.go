@@ -18,3 +18,17 @@ func ParseOptionalBoolFlag(name, raw string) (*bool, error) {
 	}
 	return &value, nil
 }
+
+// ResolveFlagAlias returns whichever of a flag and its alias was set. Setting
+// both to different values is a usage error.
+func ResolveFlagAlias(name, value, aliasName, aliasValue string) (string, error) {
+	value = strings.TrimSpace(value)
+	aliasValue = strings.TrimSpace(aliasValue)
+	if value != "" && aliasValue != "" && value != aliasValue {
+		return "", UsageErrorf("%s and %s are mutually exclusive", name, aliasName)
+	}
+	if value != "" {
+		return value, nil
+	}
+	return aliasValue, nil
+}
@@ -0,0 +1,65 @@
+package shared
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// outputColumns is shared by every command's --columns flag, like outputSelect.
+var outputColumns string
+
+func bindColumnsFlag(fs *flag.FlagSet) *string {
+	fs.StringVar(&outputColumns, "columns", "", "Comma-separated table/csv columns to render, in order (e.g. id,state,version)")
+	return &outputColumns
+}
+
+// ValidateColumnsFlags rejects --columns before any request is made when the
+// output format is not table or csv or, for list commands, when a name matches
+// no column that any table output renders. Names that only some tables have
+// are still checked at render time. format is the command's --output value, or
+// empty when it has none.
+func ValidateColumnsFlags(listCommand bool, format string) error {
+	if strings.TrimSpace(outputColumns) == "" {
+		return nil
+	}
+	if format != "" {
+		if err := validateColumnsFormat(NormalizeOutputFormat(format)); err != nil {
+			return UsageError(err.Error())
+		}
+	}
+	if !listCommand {
+		return nil
+	}
+	for _, name := range SplitCSV(outputColumns) {
+		if !asc.IsKnownTableColumn(name) {
+			return UsageErrorf("--columns: unknown column %q", name)
+		}
+	}
+	return nil
+}
+
+func validateColumnsFormat(format string) error {
+	if strings.TrimSpace(outputColumns) != "" && format != "table" && format != "csv" {
+		return fmt.Errorf("--columns is only valid with table or csv output")
+	}
+	return nil
+}
+
+// withOutputColumns runs render with table and CSV output limited to --columns.
+func withOutputColumns(render func() error) error {
+	var renderErr error
+	err := asc.WithTableColumns(SplitCSV(outputColumns), func() error {
+		renderErr = render()
+		return renderErr
+	})
+	if renderErr != nil {
+		return renderErr
+	}
+	if err != nil {
+		return fmt.Errorf("--columns: %w", err)
+	}
+	return nil
+}
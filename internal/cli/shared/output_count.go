@@ -0,0 +1,130 @@
+package shared
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// outputCount is shared by every command's --count flag, like outputSelect.
+var outputCount bool
+
+func bindCountFlag(fs *flag.FlagSet) *bool {
+	fs.BoolVar(&outputCount, "count", false, `Print a {"count":N,"pages":P} summary after list output (a footer line for table/markdown)`)
+	return &outputCount
+}
+
+// countSummary is the trailing line printed by --count.
+type countSummary struct {
+	Count int `json:"count"`
+	Pages int `json:"pages"`
+}
+
+// fetchedPages totals pages across pagination loops while --count is set.
+var fetchedPages struct {
+	mu    sync.Mutex
+	total int
+}
+
+// pageCounter records one pagination loop's page count for --count and
+// forwards updates to the progress observer, if any. Each loop gets its own
+// counter, so concurrent loops do not overwrite each other's progress.
+type pageCounter struct {
+	next  asc.PageObserver
+	pages int
+}
+
+func newPageCounter(next asc.PageObserver) *pageCounter {
+	return &pageCounter{next: next}
+}
+
+// resetFetchedPages clears the page totals at the start of a run.
+func resetFetchedPages() {
+	fetchedPages.mu.Lock()
+	fetchedPages.total = 0
+	fetchedPages.mu.Unlock()
+}
+
+func (c *pageCounter) PageFetched(pages, items int) {
+	c.pages = pages
+	if c.next != nil {
+		c.next.PageFetched(pages, items)
+	}
+}
+
+func (c *pageCounter) PaginationDone() {
+	fetchedPages.mu.Lock()
+	fetchedPages.total += c.pages
+	fetchedPages.mu.Unlock()
+	c.pages = 0
+	if c.next != nil {
+		c.next.PaginationDone()
+	}
+}
+
+// buildCountSummary validates --count for format and counts the items in
+// data's Data slice. It returns nil when --count is not set.
+func buildCountSummary(data any, format string) (*countSummary, error) {
+	if !outputCount {
+		return nil, nil
+	}
+	switch format {
+	case "json", "table", "markdown":
+	default:
+		return nil, UsageError("--count is only valid with json, table, or markdown output")
+	}
+
+	items, ok := listItemCount(data)
+	if !ok {
+		return nil, UsageError("--count is only valid for list output")
+	}
+
+	fetchedPages.mu.Lock()
+	pages := fetchedPages.total
+	fetchedPages.mu.Unlock()
+	if pages == 0 {
+		pages = 1
+	}
+	return &countSummary{Count: items, Pages: pages}, nil
+}
+
+// listItemCount returns the length of data's Data field when it is a slice.
+func listItemCount(data any) (int, bool) {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return 0, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return 0, false
+	}
+	field := value.FieldByName("Data")
+	if !field.IsValid() || field.Kind() != reflect.Slice {
+		return 0, false
+	}
+	return field.Len(), true
+}
+
+// printCountSummary writes the --count summary after the rendered items.
+func printCountSummary(summary *countSummary, format string) error {
+	if summary == nil {
+		return nil
+	}
+	if format == "json" {
+		encoded, err := json.Marshal(summary)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(os.Stdout, "%s\n", encoded)
+		return err
+	}
+	_, err := fmt.Fprintf(os.Stdout, "\ncount: %d, pages: %d\n", summary.Count, summary.Pages)
+	return err
+}
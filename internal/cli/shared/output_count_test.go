@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestBuildCountSummary(t *testing.T) {
+	outputCount = true
+	t.Cleanup(func() { outputCount = false })
+
+	counter := newPageCounter(nil)
+	for _, pages := range []int{1, 2, 3} {
+		counter.PageFetched(pages, pages*10)
+	}
+	counter.PaginationDone()
+
+	summary, err := buildCountSummary(&asc.AppsResponse{Data: make([]asc.Resource[asc.AppAttributes], 4)}, "json")
+	if err != nil {
+		t.Fatalf("buildCountSummary() error: %v", err)
+	}
+	if summary.Count != 4 || summary.Pages != 3 {
+		t.Fatalf("expected count 4 across 3 pages, got %+v", summary)
+	}
+
+	if _, err := buildCountSummary(&asc.AppResponse{}, "json"); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected list output error, got %v", err)
+	}
+	if _, err := buildCountSummary(&asc.AppsResponse{}, "csv"); err == nil {
+		t.Fatal("expected csv to be rejected")
+	}
+
+	outputCount = false
+	if summary, err := buildCountSummary(&asc.AppResponse{}, "yaml"); err != nil || summary != nil {
+		t.Fatalf("expected no summary when --count is unset, got %+v, %v", summary, err)
+	}
+}
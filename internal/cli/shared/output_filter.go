@@ -0,0 +1,163 @@
+package shared
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// outputFilters is shared by every command's --filter flag, like outputSelect.
+var outputFilters filterFlag
+
+func bindFilterFlag(fs *flag.FlagSet) *filterFlag {
+	// Var keeps the current value as the default, so clear filters from any
+	// previously parsed command tree.
+	outputFilters = nil
+	fs.Var(&outputFilters, "filter", "Keep list items whose JSON field matches: path=value, path!=value, or path~=substring (repeatable, all must match)")
+	return &outputFilters
+}
+
+// outputFilter is one parsed --filter predicate.
+type outputFilter struct {
+	path  string
+	op    string
+	value string
+}
+
+// filterFlag collects repeated --filter values.
+type filterFlag []outputFilter
+
+func (f *filterFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(*f))
+	for _, filter := range *f {
+		parts = append(parts, filter.path+filter.op+filter.value)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f *filterFlag) Set(value string) error {
+	filter, err := parseOutputFilter(value)
+	if err != nil {
+		return err
+	}
+	*f = append(*f, filter)
+	return nil
+}
+
+// parseOutputFilter splits path<op>value at the first operator. "!=" and "~="
+// are checked before "=" so their "=" is not taken as plain equality.
+func parseOutputFilter(raw string) (outputFilter, error) {
+	for i := range len(raw) {
+		for _, op := range []string{"!=", "~=", "="} {
+			if !strings.HasPrefix(raw[i:], op) {
+				continue
+			}
+			path := strings.TrimSpace(raw[:i])
+			if path == "" {
+				return outputFilter{}, fmt.Errorf("missing field path in %q", raw)
+			}
+			return outputFilter{path: path, op: op, value: raw[i+len(op):]}, nil
+		}
+	}
+	return outputFilter{}, fmt.Errorf("expected path=value, path!=value, or path~=value, got %q", raw)
+}
+
+// matches reports whether item's JSON satisfies the filter. Missing fields
+// compare as an empty value.
+func (f outputFilter) matches(item json.RawMessage) bool {
+	actual := ""
+	if value, err := selectJSONPath(item, f.path); err == nil {
+		actual = filterValueText(value)
+	}
+	switch f.op {
+	case "!=":
+		return actual != f.value
+	case "~=":
+		return strings.Contains(actual, f.value)
+	default:
+		return actual == f.value
+	}
+}
+
+// filterValueText renders strings unquoted, null as empty, and anything else
+// as compact JSON so numbers and booleans compare by their literal text.
+func filterValueText(value json.RawMessage) string {
+	var text string
+	if json.Unmarshal(value, &text) == nil {
+		return text
+	}
+	trimmed := strings.TrimSpace(string(value))
+	if trimmed == "null" {
+		return ""
+	}
+	return trimmed
+}
+
+// applyOutputFilters returns a copy of data whose Data slice only holds items
+// matching every --filter. data is returned unchanged when no filter is set.
+func applyOutputFilters(data any) (any, error) {
+	if len(outputFilters) == 0 {
+		return data, nil
+	}
+	return transformListData(data, "--filter", filterDataItems)
+}
+
+func filterDataItems(items reflect.Value) (reflect.Value, error) {
+	filtered := reflect.MakeSlice(items.Type(), 0, items.Len())
+	for i := range items.Len() {
+		item := items.Index(i)
+		raw, err := json.Marshal(item.Interface())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("--filter: %w", err)
+		}
+		keep := true
+		for _, filter := range outputFilters {
+			if !filter.matches(raw) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			filtered = reflect.Append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// transformListData copies data and replaces its Data slice with the result of
+// fn, leaving the caller's response untouched.
+func transformListData(data any, flagName string, fn func(items reflect.Value) (reflect.Value, error)) (any, error) {
+	value := reflect.ValueOf(data)
+	isPointer := value.Kind() == reflect.Pointer
+	if isPointer {
+		if value.IsNil() {
+			return nil, UsageErrorf("%s is only valid for list output", flagName)
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, UsageErrorf("%s is only valid for list output", flagName)
+	}
+
+	copied := reflect.New(value.Type())
+	copied.Elem().Set(value)
+	field := copied.Elem().FieldByName("Data")
+	if !field.IsValid() || field.Kind() != reflect.Slice || !field.CanSet() {
+		return nil, UsageErrorf("%s is only valid for list output", flagName)
+	}
+	items, err := fn(field)
+	if err != nil {
+		return nil, err
+	}
+	field.Set(items)
+
+	if isPointer {
+		return copied.Interface(), nil
+	}
+	return copied.Elem().Interface(), nil
+}
@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseOutputFilter(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    outputFilter
+		wantErr string
+	}{
+		{raw: "attributes.processingState=VALID", want: outputFilter{path: "attributes.processingState", op: "=", value: "VALID"}},
+		{raw: "attributes.processingState!=VALID", want: outputFilter{path: "attributes.processingState", op: "!=", value: "VALID"}},
+		{raw: "attributes.name~=Beta", want: outputFilter{path: "attributes.name", op: "~=", value: "Beta"}},
+		{raw: "attributes.version=1=2", want: outputFilter{path: "attributes.version", op: "=", value: "1=2"}},
+		{raw: "attributes.name=", want: outputFilter{path: "attributes.name", op: "=", value: ""}},
+		{raw: "=VALID", wantErr: "missing field path"},
+		{raw: "attributes.name", wantErr: "expected path=value"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.raw, func(t *testing.T) {
+			got, err := parseOutputFilter(test.raw)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("expected %+v, got %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestOutputFilterMatches(t *testing.T) {
+	item := json.RawMessage(`{"id":"b1","attributes":{"processingState":"VALID","expired":false,"size":12,"note":null}}`)
+
+	tests := []struct {
+		raw  string
+		want bool
+	}{
+		{raw: "attributes.processingState=VALID", want: true},
+		{raw: "attributes.processingState!=VALID", want: false},
+		{raw: "attributes.processingState~=VAL", want: true},
+		{raw: "attributes.expired=false", want: true},
+		{raw: "attributes.size=12", want: true},
+		{raw: "attributes.note=", want: true},
+		{raw: "attributes.missing=", want: true},
+		{raw: "attributes.missing!=x", want: true},
+		{raw: "attributes.missing=x", want: false},
+	}
+
+	for _, test := range tests {
+		filter, err := parseOutputFilter(test.raw)
+		if err != nil {
+			t.Fatalf("parse %q: %v", test.raw, err)
+		}
+		if got := filter.matches(item); got != test.want {
+			t.Fatalf("%q: matches = %v, want %v", test.raw, got, test.want)
+		}
+	}
+}
+
+func TestApplyOutputFiltersKeepsOriginalData(t *testing.T) {
+	outputFilters = filterFlag{
+		{path: "attributes.name", op: "~=", value: "Beta"},
+		{path: "id", op: "!=", value: "app-2"},
+	}
+	t.Cleanup(func() { outputFilters = nil })
+
+	original := &asc.AppsResponse{Data: []asc.Resource[asc.AppAttributes]{
+		{ID: "app-1", Attributes: asc.AppAttributes{Name: "Beta One"}},
+		{ID: "app-2", Attributes: asc.AppAttributes{Name: "Beta Two"}},
+		{ID: "app-3", Attributes: asc.AppAttributes{Name: "Release"}},
+	}}
+
+	filtered, err := applyOutputFilters(original)
+	if err != nil {
+		t.Fatalf("applyOutputFilters() error: %v", err)
+	}
+	apps := filtered.(*asc.AppsResponse)
+	if len(apps.Data) != 1 || apps.Data[0].ID != "app-1" {
+		t.Fatalf("expected only app-1, got %+v", apps.Data)
+	}
+	if len(original.Data) != 3 {
+		t.Fatalf("expected original data to be untouched, got %d items", len(original.Data))
+	}
+
+	if _, err := applyOutputFilters(&asc.AppResponse{}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected list output error, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// outputRaw is shared by every get and list command's --raw flag; only the
+// flagset that is actually parsed can set it, so a single variable is
+// sufficient.
+var outputRaw bool
+
+// printedRawResponses counts response bodies written by --raw in this run.
+var printedRawResponses struct {
+	mu    sync.Mutex
+	count int
+}
+
+// rawFlagCommands are the flagset names, by last word, that accept --raw.
+// Other commands reshape, combine, or write data, so there is no single
+// response body to print.
+var rawFlagCommands = []string{"get", "list"}
+
+func bindRawFlag(fs *flag.FlagSet) *bool {
+	fields := strings.Fields(fs.Name())
+	if len(fields) == 0 || !slices.Contains(rawFlagCommands, fields[len(fields)-1]) {
+		return nil
+	}
+	fs.BoolVar(&outputRaw, "raw", false, "Print the API response body exactly as returned instead of formatted output (one page per line, NDJSON with --paginate)")
+	return &outputRaw
+}
+
+// RawOutput reports whether --raw is set for this run.
+func RawOutput() bool {
+	return outputRaw
+}
+
+// resetRawOutput drops response bodies left over from a previous run.
+func resetRawOutput() {
+	asc.SetRawResponseCapture(false)
+	printedRawResponses.mu.Lock()
+	printedRawResponses.count = 0
+	printedRawResponses.mu.Unlock()
+}
+
+// ValidateRawFlags rejects --raw with output formats and shaping flags that
+// cannot apply to unparsed response bodies. format is the command's --output
+// value, or empty when it has none.
+func ValidateRawFlags(format string) error {
+	if !outputRaw {
+		return nil
+	}
+	switch {
+	case format != "" && NormalizeOutputFormat(format) != "json":
+		return UsageError("--raw requires --output json")
+	case strings.TrimSpace(outputSelect) != "":
+		return UsageError("--raw cannot be combined with --select")
+	case len(outputFilters) > 0:
+		return UsageError("--raw cannot be combined with --filter")
+	case strings.TrimSpace(outputSortBy) != "":
+		return UsageError("--raw cannot be combined with --sort-by")
+	case strings.TrimSpace(outputColumns) != "":
+		return UsageError("--raw cannot be combined with --columns")
+	case outputCount:
+		return UsageError("--raw cannot be combined with --count")
+	}
+	return nil
+}
+
+// applyRawResponseCapture records response bodies while --raw is set.
+func applyRawResponseCapture() {
+	asc.SetRawResponseCapture(outputRaw && !quiet)
+}
+
+// rawPaginateOptions streams each page of a command's --paginate loop when
+// --raw is set.
+func rawPaginateOptions() []asc.PaginateOption {
+	if !outputRaw || quiet {
+		return nil
+	}
+	return []asc.PaginateOption{asc.WithRawPages(printRawResponse)}
+}
+
+// printRawResponse writes body on its own line. Bodies spanning several lines
+// are compacted so paginated output stays one JSON document per line.
+func printRawResponse(body []byte) {
+	line := bytes.TrimSpace(body)
+	if bytes.ContainsAny(line, "\r\n") {
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, line); err == nil {
+			line = compacted.Bytes()
+		}
+	}
+
+	printedRawResponses.mu.Lock()
+	defer printedRawResponses.mu.Unlock()
+	printedRawResponses.count++
+	_, _ = fmt.Fprintf(os.Stdout, "%s\n", line)
+}
+
+// rawOutputHandled reports whether --raw already printed this command's
+// output, so the formatted rendering must be skipped.
+func rawOutputHandled() (bool, error) {
+	if !outputRaw {
+		return false, nil
+	}
+	if quiet {
+		return true, nil
+	}
+	printedRawResponses.mu.Lock()
+	count := printedRawResponses.count
+	printedRawResponses.mu.Unlock()
+	if count > 0 {
+		// A --paginate loop already streamed its pages.
+		return true, nil
+	}
+	body := asc.TakeLastRawResponse()
+	if body == nil {
+		return false, fmt.Errorf("--raw is only valid for commands that fetch data from the App Store Connect API")
+	}
+	printRawResponse(body)
+	return true, nil
+}
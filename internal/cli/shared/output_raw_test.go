@@ -0,0 +1,92 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestPrintRawResponseKeepsCompactBodiesAndCompactsMultiline(t *testing.T) {
+	t.Cleanup(func() { outputRaw = false })
+	outputRaw = true
+	resetRawOutput()
+
+	stdout, _ := captureOutput(t, func() {
+		printRawResponse([]byte(`{"data":[],"unknownField":1}`))
+		printRawResponse([]byte("{\n  \"data\": [\n    {\"id\": \"1\"}\n  ]\n}\n"))
+	})
+
+	want := `{"data":[],"unknownField":1}` + "\n" + `{"data":[{"id":"1"}]}` + "\n"
+	if stdout != want {
+		t.Fatalf("expected %q, got %q", want, stdout)
+	}
+	handled, err := rawOutputHandled()
+	if err != nil || !handled {
+		t.Fatalf("expected raw output to be handled, got handled=%v err=%v", handled, err)
+	}
+}
+
+func TestRawOutputHandledRequiresAPIResponse(t *testing.T) {
+	t.Cleanup(func() { outputRaw = false })
+	outputRaw = true
+	resetRawOutput()
+
+	_, err := rawOutputHandled()
+	if err == nil || !strings.Contains(err.Error(), "--raw is only valid") {
+		t.Fatalf("expected --raw error, got %v", err)
+	}
+}
+
+func TestValidateRawFlagsRejectsOutputShaping(t *testing.T) {
+	t.Cleanup(func() {
+		outputRaw = false
+		outputSelect = ""
+	})
+	outputRaw = true
+	outputSelect = "data.0.id"
+
+	var err error
+	_, stderr := captureOutput(t, func() {
+		err = ValidateRawFlags("json")
+	})
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	if !strings.Contains(stderr, "--raw cannot be combined with --select") {
+		t.Fatalf("expected --select conflict message, got %q", stderr)
+	}
+}
+
+func TestValidateRawFlagsRequiresJSONOutput(t *testing.T) {
+	t.Cleanup(func() { outputRaw = false })
+	outputRaw = true
+
+	var err error
+	_, stderr := captureOutput(t, func() {
+		err = ValidateRawFlags("table")
+	})
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	if !strings.Contains(stderr, "--raw requires --output json") {
+		t.Fatalf("expected --output conflict message, got %q", stderr)
+	}
+}
+
+func TestBindRawFlagOnlyOnGetAndListCommands(t *testing.T) {
+	for name, want := range map[string]bool{
+		"apps list":          true,
+		"apps get":           true,
+		"status":             false,
+		"builds expire":      false,
+		"publish appstore":   false,
+		"review submissions": false,
+	} {
+		fs := flag.NewFlagSet(name, flag.ContinueOnError)
+		bindRawFlag(fs)
+		if got := fs.Lookup("raw") != nil; got != want {
+			t.Errorf("%s: expected --raw bound=%v, got %v", name, want, got)
+		}
+	}
+}
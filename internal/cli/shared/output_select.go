@@ -0,0 +1,111 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// outputSelect is shared by every command's --select flag; only the flagset
+// that is actually parsed can set it, so a single variable is sufficient.
+var outputSelect string
+
+func bindSelectFlag(fs *flag.FlagSet) *string {
+	fs.StringVar(&outputSelect, "select", "", "Print only the JSON value at a dot path (e.g. data.0.attributes.name)")
+	return &outputSelect
+}
+
+// printSelectedJSON prints the value at path within data's JSON encoding.
+// Strings print raw so they can be used directly in shell scripts.
+func printSelectedJSON(data any, path string, pretty bool) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("--select: %w", err)
+	}
+
+	value, err := selectJSONPath(raw, path)
+	if err != nil {
+		return err
+	}
+
+	var text string
+	if json.Unmarshal(value, &text) == nil {
+		_, err := fmt.Fprintln(os.Stdout, text)
+		return err
+	}
+
+	var buf bytes.Buffer
+	if pretty {
+		err = json.Indent(&buf, value, "", "  ")
+	} else {
+		err = json.Compact(&buf, value)
+	}
+	if err != nil {
+		return fmt.Errorf("--select: %w", err)
+	}
+	buf.WriteByte('\n')
+	_, err = os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+// selectJSONPath walks a dot-separated path of object keys and array indexes,
+// returning the raw JSON so field order and number formatting are preserved.
+func selectJSONPath(doc json.RawMessage, path string) (json.RawMessage, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("--select path is empty")
+	}
+
+	current := doc
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		resolved := strings.Join(segments[:i], ".")
+		switch firstJSONByte(current) {
+		case '{':
+			var node map[string]json.RawMessage
+			if err := json.Unmarshal(current, &node); err != nil {
+				return nil, fmt.Errorf("--select %q: %w", path, err)
+			}
+			next, ok := node[segment]
+			if !ok {
+				return nil, fmt.Errorf("--select %q: no field %q%s", path, segment, selectPathContext(resolved))
+			}
+			current = next
+		case '[':
+			var node []json.RawMessage
+			if err := json.Unmarshal(current, &node); err != nil {
+				return nil, fmt.Errorf("--select %q: %w", path, err)
+			}
+			index, err := strconv.Atoi(segment)
+			if err != nil {
+				return nil, fmt.Errorf("--select %q: expected array index at %q%s", path, segment, selectPathContext(resolved))
+			}
+			if index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("--select %q: index %d out of range (length %d)%s", path, index, len(node), selectPathContext(resolved))
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("--select %q: cannot select %q from a scalar value%s", path, segment, selectPathContext(resolved))
+		}
+	}
+	return current, nil
+}
+
+func firstJSONByte(raw json.RawMessage) byte {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return 0
+	}
+	return trimmed[0]
+}
+
+func selectPathContext(resolved string) string {
+	if resolved == "" {
+		return ""
+	}
+	return " under " + strconv.Quote(resolved)
+}
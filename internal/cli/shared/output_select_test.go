@@ -0,0 +1,79 @@
+package shared
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSelectJSONPath(t *testing.T) {
+	doc := json.RawMessage(`{"data":[{"id":"v1","attributes":{"versionString":"1.2.3"}}],"meta":{"total":1}}`)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr string
+	}{
+		{name: "nested field with index", path: "data.0.attributes.versionString", want: `"1.2.3"`},
+		{name: "object", path: "meta", want: `{"total":1}`},
+		{name: "missing field", path: "data.0.attributes.name", wantErr: `no field "name" under "data.0.attributes"`},
+		{name: "index out of range", path: "data.3", wantErr: `index 3 out of range (length 1) under "data"`},
+		{name: "non-numeric index", path: "data.first", wantErr: `expected array index at "first" under "data"`},
+		{name: "scalar traversal", path: "meta.total.value", wantErr: `cannot select "value" from a scalar value under "meta.total"`},
+		{name: "empty path", path: "  ", wantErr: "--select path is empty"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := selectJSONPath(doc, tc.path)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPrintOutput_SelectPrintsExtractedValue(t *testing.T) {
+	outputSelect = "data.attributes"
+	t.Cleanup(func() { outputSelect = "" })
+
+	data := map[string]any{"data": map[string]any{"attributes": map[string]any{"name": "Demo", "count": 12345678901}}}
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(data, "json", false); err != nil {
+			t.Fatalf("PrintOutput() error = %v", err)
+		}
+	})
+	if stdout != "{\"count\":12345678901,\"name\":\"Demo\"}\n" {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+
+	outputSelect = "data.attributes.name"
+	stdout, _ = captureOutput(t, func() {
+		if err := PrintOutput(data, "json", false); err != nil {
+			t.Fatalf("PrintOutput() error = %v", err)
+		}
+	})
+	if stdout != "Demo\n" {
+		t.Fatalf("expected raw string output, got %q", stdout)
+	}
+}
+
+func TestPrintOutput_SelectRejectsNonJSONFormats(t *testing.T) {
+	outputSelect = "data"
+	t.Cleanup(func() { outputSelect = "" })
+
+	err := PrintOutput(map[string]any{"data": 1}, "table", false)
+	if err == nil || !strings.Contains(err.Error(), "--select is only valid with JSON output") {
+		t.Fatalf("expected select format error, got %v", err)
+	}
+}
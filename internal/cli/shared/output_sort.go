@@ -0,0 +1,157 @@
+package shared
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// outputSortBy and outputSortDesc are shared by every command's --sort-by and
+// --sort-desc flags, like outputSelect.
+var (
+	outputSortBy   string
+	outputSortDesc bool
+)
+
+func bindSortFlags(fs *flag.FlagSet) (*string, *bool) {
+	fs.StringVar(&outputSortBy, "sort-by", "", "Sort list items client-side by a JSON field path (e.g. attributes.uploadedDate)")
+	fs.BoolVar(&outputSortDesc, "sort-desc", false, "Reverse the --sort-by order")
+	return &outputSortBy, &outputSortDesc
+}
+
+// sortKey is one item's comparable value. Numbers and dates compare by value;
+// everything else compares as text.
+type sortKey struct {
+	missing bool
+	kind    int
+	number  float64
+	when    time.Time
+	text    string
+}
+
+const (
+	sortKindNumber = iota
+	sortKindTime
+	sortKindText
+)
+
+// applyOutputSort returns a copy of data whose Data slice is ordered by
+// --sort-by. Items missing the field (or with an empty value) always sort last.
+func applyOutputSort(data any) (any, error) {
+	path := strings.TrimSpace(outputSortBy)
+	if path == "" {
+		if outputSortDesc {
+			return nil, UsageError("--sort-desc requires --sort-by")
+		}
+		return data, nil
+	}
+	return transformListData(data, "--sort-by", func(items reflect.Value) (reflect.Value, error) {
+		return sortDataItems(items, path, outputSortDesc)
+	})
+}
+
+func sortDataItems(items reflect.Value, path string, desc bool) (reflect.Value, error) {
+	type keyedItem struct {
+		key  sortKey
+		item reflect.Value
+	}
+
+	keyed := make([]keyedItem, items.Len())
+	for i := range items.Len() {
+		item := items.Index(i)
+		raw, err := json.Marshal(item.Interface())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("--sort-by: %w", err)
+		}
+		keyed[i] = keyedItem{key: sortKeyFor(raw, path), item: item}
+	}
+
+	sort.SliceStable(keyed, func(i, j int) bool {
+		a, b := keyed[i].key, keyed[j].key
+		if a.missing || b.missing {
+			return !a.missing && b.missing
+		}
+		cmp := compareSortKeys(a, b)
+		if desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+
+	sorted := reflect.MakeSlice(items.Type(), 0, len(keyed))
+	for _, entry := range keyed {
+		sorted = reflect.Append(sorted, entry.item)
+	}
+	return sorted, nil
+}
+
+func sortKeyFor(item json.RawMessage, path string) sortKey {
+	value, err := selectJSONPath(item, path)
+	if err != nil {
+		return sortKey{missing: true}
+	}
+	text := strings.TrimSpace(string(value))
+	if text == "null" {
+		return sortKey{missing: true}
+	}
+
+	var str string
+	if json.Unmarshal(value, &str) == nil {
+		if str == "" {
+			return sortKey{missing: true}
+		}
+		if when, ok := parseSortTime(str); ok {
+			return sortKey{kind: sortKindTime, when: when, text: str}
+		}
+		return sortKey{kind: sortKindText, text: str}
+	}
+	if number, err := strconv.ParseFloat(text, 64); err == nil {
+		return sortKey{kind: sortKindNumber, number: number, text: text}
+	}
+	return sortKey{kind: sortKindText, text: text}
+}
+
+// sortTimeLayouts covers RFC3339 plus the "+0000" offsets and plain dates the
+// App Store Connect API also returns.
+var sortTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02",
+}
+
+func parseSortTime(value string) (time.Time, bool) {
+	for _, layout := range sortTimeLayouts {
+		if when, err := time.Parse(layout, value); err == nil {
+			return when, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// compareSortKeys orders keys of the same kind by value; mixed kinds fall
+// back to kind order so the result stays deterministic.
+func compareSortKeys(a, b sortKey) int {
+	if a.kind != b.kind {
+		return a.kind - b.kind
+	}
+	switch a.kind {
+	case sortKindNumber:
+		switch {
+		case a.number < b.number:
+			return -1
+		case a.number > b.number:
+			return 1
+		}
+		return 0
+	case sortKindTime:
+		return a.when.Compare(b.when)
+	default:
+		return strings.Compare(a.text, b.text)
+	}
+}
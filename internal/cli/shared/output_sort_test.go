@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"errors"
+	"flag"
+	"slices"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func sortedBuildIDs(t *testing.T, builds []asc.Resource[asc.BuildAttributes]) []string {
+	t.Helper()
+
+	sorted, err := applyOutputSort(&asc.BuildsResponse{Data: builds})
+	if err != nil {
+		t.Fatalf("applyOutputSort() error: %v", err)
+	}
+	data := sorted.(*asc.BuildsResponse).Data
+	ids := make([]string, 0, len(data))
+	for _, item := range data {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func TestApplyOutputSortByType(t *testing.T) {
+	t.Cleanup(func() {
+		outputSortBy = ""
+		outputSortDesc = false
+	})
+
+	builds := []asc.Resource[asc.BuildAttributes]{
+		{ID: "b-mid", Attributes: asc.BuildAttributes{Version: "10", UploadedDate: "2026-03-01T12:00:00-08:00"}},
+		{ID: "b-new", Attributes: asc.BuildAttributes{Version: "9", UploadedDate: "2026-03-02T00:00:00Z"}},
+		{ID: "b-none", Attributes: asc.BuildAttributes{Version: "11"}},
+		{ID: "b-old", Attributes: asc.BuildAttributes{Version: "100", UploadedDate: "2026-02-28T23:00:00Z"}},
+	}
+
+	outputSortBy = "attributes.uploadedDate"
+	if got, want := sortedBuildIDs(t, builds), []string{"b-old", "b-mid", "b-new", "b-none"}; !slices.Equal(got, want) {
+		t.Fatalf("date ascending: expected %v, got %v", want, got)
+	}
+
+	outputSortDesc = true
+	if got, want := sortedBuildIDs(t, builds), []string{"b-new", "b-mid", "b-old", "b-none"}; !slices.Equal(got, want) {
+		t.Fatalf("date descending: expected %v, got %v", want, got)
+	}
+
+	outputSortDesc = false
+	outputSortBy = "attributes.version"
+	if got, want := sortedBuildIDs(t, builds), []string{"b-mid", "b-old", "b-none", "b-new"}; !slices.Equal(got, want) {
+		t.Fatalf("string ascending: expected %v, got %v", want, got)
+	}
+}
+
+func TestApplyOutputSortNumbers(t *testing.T) {
+	for _, tc := range []struct {
+		raw  string
+		want float64
+	}{{raw: `{"n":10}`, want: 10}, {raw: `{"n":9.5}`, want: 9.5}} {
+		key := sortKeyFor([]byte(tc.raw), "n")
+		if key.kind != sortKindNumber || key.number != tc.want {
+			t.Fatalf("%s: expected number key %v, got %+v", tc.raw, tc.want, key)
+		}
+	}
+	if compareSortKeys(sortKeyFor([]byte(`{"n":9}`), "n"), sortKeyFor([]byte(`{"n":10}`), "n")) >= 0 {
+		t.Fatal("expected 9 to sort before 10 numerically")
+	}
+}
+
+func TestApplyOutputSortDescRequiresSortBy(t *testing.T) {
+	outputSortDesc = true
+	t.Cleanup(func() { outputSortDesc = false })
+
+	if _, err := applyOutputSort(&asc.BuildsResponse{}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected --sort-desc error, got %v", err)
+	}
+}
+
+func TestSortKeyParsesAPIDateFormats(t *testing.T) {
+	for _, value := range []string{"2026-03-01T12:00:00Z", "2026-03-01T12:00:00.000+0000", "2026-03-01T12:00:00+0000", "2026-03-01"} {
+		key := sortKeyFor([]byte(`{"d":"`+value+`"}`), "d")
+		if key.kind != sortKindTime {
+			t.Fatalf("expected %q to sort as a date, got %+v", value, key)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// pendingResumeCursor holds the validated --resume-cursor URL until the
+// command's --paginate loop claims it through PaginateOptions.
+var pendingResumeCursor struct {
+	mu      sync.Mutex
+	loaded  bool
+	nextURL string
+}
+
+// applyPaginationCursor reads and validates --resume-cursor once per run, so a
+// bad cursor file fails before any request is made.
+func applyPaginationCursor() error {
+	pendingResumeCursor.mu.Lock()
+	defer pendingResumeCursor.mu.Unlock()
+	if pendingResumeCursor.loaded {
+		return nil
+	}
+
+	path := strings.TrimSpace(resumeCursorPath)
+	if path == "" {
+		return nil
+	}
+	cursor, err := readPaginationCursor(path)
+	if err != nil {
+		return fmt.Errorf("--resume-cursor: %w", err)
+	}
+	if cursor != "" {
+		if err := validateNextURL(cursor); err != nil {
+			return fmt.Errorf("--resume-cursor %s: %w", path, err)
+		}
+	}
+	pendingResumeCursor.nextURL = cursor
+	pendingResumeCursor.loaded = true
+	return nil
+}
+
+// resetPaginationCursor clears any resume cursor left over from a previous run.
+func resetPaginationCursor() {
+	pendingResumeCursor.mu.Lock()
+	defer pendingResumeCursor.mu.Unlock()
+	pendingResumeCursor.loaded = false
+	pendingResumeCursor.nextURL = ""
+}
+
+// paginationCursorOptions returns the --resume-cursor and --save-cursor options
+// for a command's --paginate loop. The resume cursor is handed to the first
+// loop only.
+func paginationCursorOptions() []asc.PaginateOption {
+	var opts []asc.PaginateOption
+
+	pendingResumeCursor.mu.Lock()
+	if pendingResumeCursor.nextURL != "" {
+		opts = append(opts, asc.WithResumeCursor(pendingResumeCursor.nextURL))
+		pendingResumeCursor.nextURL = ""
+	}
+	pendingResumeCursor.mu.Unlock()
+
+	if path := strings.TrimSpace(saveCursorPath); path != "" {
+		opts = append(opts, asc.WithCursorSaver(func(next string) error {
+			return writePaginationCursor(path, next)
+		}))
+	}
+	return opts
+}
+
+// readPaginationCursor returns the saved next URL. A missing or empty file
+// means there is nothing to resume, so pagination starts from the first page.
+func readPaginationCursor(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// writePaginationCursor replaces the cursor file with next, or removes it once
+// pagination has reached the last page.
+func writePaginationCursor(path, next string) error {
+	if next == "" {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	return WriteFilesNoSymlink([]FileWrite{{Path: path, Data: []byte(next + "\n"), Perm: 0o600}}, true, ".asc-cursor-*", ".asc-cursor-backup-*")
+}
@@ -0,0 +1,22 @@
+package shared
+
+import "strings"
+
+// ValidatePaginationFlags rejects the root pagination flags when the selected
+// command is not running a --paginate loop, since they would otherwise be
+// silently ignored. paginate reports whether the command's --paginate is set.
+func ValidatePaginationFlags(paginate bool) error {
+	if maxItems < 0 {
+		return UsageError("--max-items must be >= 0")
+	}
+	if maxItems > 0 && !paginate {
+		return UsageError("--max-items requires --paginate")
+	}
+	if strings.TrimSpace(resumeCursorPath) != "" && !paginate {
+		return UsageError("--resume-cursor requires --paginate")
+	}
+	if strings.TrimSpace(saveCursorPath) != "" && !paginate {
+		return UsageError("--save-cursor requires --paginate")
+	}
+	return nil
+}
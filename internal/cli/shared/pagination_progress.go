@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// paginationProgressEnabled reports whether pagination progress should be
+// written to stderr. An explicit --progress wins; otherwise it follows the
+// spinner gate so piped or CI runs stay silent.
+func paginationProgressEnabled() bool {
+	if progress.IsSet() {
+		return progress.Value()
+	}
+	return SpinnerEnabled()
+}
+
+// PaginateOptions returns the options for a command's own --paginate loop:
+// --max-items, --progress, --count, --raw, --resume-cursor, and --save-cursor
+// apply to that loop only, never to internal lookups made on the command's
+// behalf. Call it once per loop.
+func PaginateOptions() []asc.PaginateOption {
+	opts := paginationCursorOptions()
+	opts = append(opts, rawPaginateOptions()...)
+	if maxItems > 0 {
+		opts = append(opts, asc.WithMaxItems(maxItems))
+	}
+
+	var observer asc.PageObserver
+	if paginationProgressEnabled() {
+		observer = newPaginationProgress(os.Stderr)
+	}
+	if outputCount {
+		observer = newPageCounter(observer)
+	}
+	if observer != nil {
+		opts = append(opts, asc.WithPageObserver(observer))
+	}
+	return opts
+}
+
+// paginationProgress renders a single updating "fetched N items" line.
+type paginationProgress struct {
+	w      io.Writer
+	mu     sync.Mutex
+	maxLen int
+}
+
+func newPaginationProgress(w io.Writer) *paginationProgress {
+	return &paginationProgress{w: w}
+}
+
+func (p *paginationProgress) PageFetched(pages, items int) {
+	line := fmt.Sprintf("fetched %d %s across %d %s...", items, pluralize(items, "item", "items"), pages, pluralize(pages, "page", "pages"))
+	curLen := utf8.RuneCountInString(line)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxLen > curLen {
+		line += strings.Repeat(" ", p.maxLen-curLen)
+	} else {
+		p.maxLen = curLen
+	}
+	_, _ = io.WriteString(p.w, "\r"+line)
+}
+
+func (p *paginationProgress) PaginationDone() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxLen == 0 {
+		return
+	}
+	_, _ = io.WriteString(p.w, "\n")
+	p.maxLen = 0
+}
+
+func pluralize(count int, singular, plural string) string {
+	if count == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPaginationProgress_RendersSingleUpdatingLine(t *testing.T) {
+	var buf bytes.Buffer
+	p := newPaginationProgress(&buf)
+
+	p.PageFetched(1, 200)
+	p.PageFetched(2, 201)
+	p.PaginationDone()
+
+	want := "\rfetched 200 items across 1 page..." +
+		"\rfetched 201 items across 2 pages..." +
+		"\n"
+	if buf.String() != want {
+		t.Fatalf("expected %q, got %q", want, buf.String())
+	}
+
+	buf.Reset()
+	p.PaginationDone()
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output when nothing was rendered, got %q", buf.String())
+	}
+}
+
+func TestPaginationProgressEnabled(t *testing.T) {
+	prevProgress := progress
+	prevIsTerminal := isTerminal
+	prevNoProgress := noProgress
+	t.Cleanup(func() {
+		progress = prevProgress
+		isTerminal = prevIsTerminal
+		noProgress = prevNoProgress
+	})
+	t.Setenv(spinnerDisabledEnvVar, "")
+	t.Setenv("ASC_DEBUG", "")
+	t.Setenv("ASC_RETRY_LOG", "")
+	noProgress = false
+
+	progress = OptionalBool{}
+	isTerminal = func(int) bool { return false }
+	if paginationProgressEnabled() {
+		t.Fatal("expected progress to be off by default when not interactive")
+	}
+
+	if err := progress.Set("true"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	if !paginationProgressEnabled() {
+		t.Fatal("expected explicit --progress to enable progress without a TTY")
+	}
+
+	isTerminal = func(int) bool { return true }
+	if err := progress.Set("false"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	if paginationProgressEnabled() {
+		t.Fatal("expected --progress=false to disable progress on a TTY")
+	}
+}
+
+func TestPaginateOptions_NoneWithoutProgressOrCount(t *testing.T) {
+	prevProgress := progress
+	prevIsTerminal := isTerminal
+	prevCount := outputCount
+	t.Cleanup(func() {
+		progress = prevProgress
+		isTerminal = prevIsTerminal
+		outputCount = prevCount
+	})
+	t.Setenv(spinnerDisabledEnvVar, "")
+	progress = OptionalBool{}
+	isTerminal = func(int) bool { return false }
+	outputCount = false
+
+	if opts := PaginateOptions(); len(opts) != 0 {
+		t.Fatalf("expected no pagination options, got %d", len(opts))
+	}
+
+	outputCount = true
+	if opts := PaginateOptions(); len(opts) != 1 {
+		t.Fatalf("expected a page observer option with --count, got %d", len(opts))
+	}
+}
@@ -5,14 +5,27 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 )
 
-// WriteProfileFile writes provisioning profile data to disk securely.
+// SigningFilePerm is the default mode for provisioning profiles and
+// certificates written to disk; they should not be readable by other users.
+const SigningFilePerm os.FileMode = 0o600
+
+// WriteProfileFile writes provisioning profile data to disk securely using
+// SigningFilePerm.
 func WriteProfileFile(path string, content []byte) error {
+	return WriteProfileFileWithPerm(path, content, SigningFilePerm)
+}
+
+// WriteProfileFileWithPerm writes provisioning profile data to disk securely
+// with the given permissions (subject to umask).
+func WriteProfileFileWithPerm(path string, content []byte, perm os.FileMode) error {
 	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return err
 	}
-	file, err := OpenNewFileNoFollow(path, 0o644)
+	file, err := OpenNewFileNoFollow(path, perm)
 	if err != nil {
 		if errors.Is(err, os.ErrExist) {
 			return fmt.Errorf("output file already exists: %w", err)
@@ -26,3 +39,21 @@ func WriteProfileFile(path string, content []byte) error {
 	}
 	return file.Sync()
 }
+
+// ParseFileMode parses an octal permission string such as "600" or "0644".
+// The mode must keep the file readable and writable by its owner.
+func ParseFileMode(value string) (os.FileMode, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(value), "0o")
+	if trimmed == "" {
+		return SigningFilePerm, nil
+	}
+	parsed, err := strconv.ParseUint(trimmed, 8, 32)
+	if err != nil || parsed > 0o777 {
+		return 0, fmt.Errorf("invalid file mode %q (use octal, e.g. 0600)", value)
+	}
+	mode := os.FileMode(parsed)
+	if mode&0o600 != 0o600 {
+		return 0, fmt.Errorf("file mode %q must allow owner read and write", value)
+	}
+	return mode, nil
+}
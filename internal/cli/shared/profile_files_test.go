@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseFileMode(t *testing.T) {
+	tests := []struct {
+		value string
+		want  os.FileMode
+	}{
+		{value: "", want: SigningFilePerm},
+		{value: "600", want: 0o600},
+		{value: "0644", want: 0o644},
+		{value: "0o640", want: 0o640},
+	}
+	for _, test := range tests {
+		got, err := ParseFileMode(test.value)
+		if err != nil {
+			t.Fatalf("ParseFileMode(%q) error: %v", test.value, err)
+		}
+		if got != test.want {
+			t.Fatalf("ParseFileMode(%q) = %o, want %o", test.value, got, test.want)
+		}
+	}
+}
+
+func TestParseFileModeRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr string
+	}{
+		{value: "rw-------", wantErr: "invalid file mode"},
+		{value: "0888", wantErr: "invalid file mode"},
+		{value: "1777", wantErr: "invalid file mode"},
+		{value: "0400", wantErr: "owner read and write"},
+	}
+	for _, test := range tests {
+		_, err := ParseFileMode(test.value)
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Fatalf("ParseFileMode(%q) error = %v, want %q", test.value, err, test.wantErr)
+		}
+	}
+}
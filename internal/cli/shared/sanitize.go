@@ -17,3 +17,31 @@ func SanitizeTerminal(input string) string {
 	}
 	return b.String()
 }
+
+// SanitizeFilenamePart reduces value to a single safe filename component made of
+// ASCII letters, digits, '.', '-' and '_'. It returns "" when nothing usable
+// remains, so callers can fall back to another value.
+func SanitizeFilenamePart(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.Grow(len(trimmed))
+	for _, r := range trimmed {
+		isASCIIAlpha := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+		switch {
+		case isASCIIAlpha || isDigit || r == '.' || r == '-' || r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	sanitized := strings.TrimSpace(b.String())
+	sanitized = strings.Trim(sanitized, "._-")
+	if sanitized == "" || sanitized == "." || sanitized == ".." {
+		return ""
+	}
+	return sanitized
+}
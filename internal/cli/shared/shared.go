@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"text/tabwriter"
@@ -31,14 +32,18 @@ var (
 const (
 	privateKeyEnvVar       = "ASC_PRIVATE_KEY"
 	privateKeyBase64EnvVar = "ASC_PRIVATE_KEY_B64"
-	profileEnvVar          = "ASC_PROFILE"
-	strictAuthEnvVar       = "ASC_STRICT_AUTH"
-	defaultOutputEnvVar    = "ASC_DEFAULT_OUTPUT"
+	// privateKeyBase64MemEnvVar holds base64 key material that is decoded in
+	// memory and never written to disk.
+	privateKeyBase64MemEnvVar = "ASC_PRIVATE_KEY_BASE64"
+	profileEnvVar             = "ASC_PROFILE"
+	strictAuthEnvVar          = "ASC_STRICT_AUTH"
+	defaultOutputEnvVar       = "ASC_DEFAULT_OUTPUT"
 )
 
 const (
-	PrivateKeyEnvVar       = privateKeyEnvVar
-	PrivateKeyBase64EnvVar = privateKeyBase64EnvVar
+	PrivateKeyEnvVar          = privateKeyEnvVar
+	PrivateKeyBase64EnvVar    = privateKeyBase64EnvVar
+	PrivateKeyBase64MemEnvVar = privateKeyBase64MemEnvVar
 )
 
 var ErrMissingAuth = errors.New("missing authentication")
@@ -60,17 +65,29 @@ var (
 	privateKeyTempPath  string
 	privateKeyTempPaths []string
 	selectedProfile     string
+	privateKeyBase64    string
 	strictAuth          bool
 	retryLog            OptionalBool
 	debug               OptionalBool
 	apiDebug            OptionalBool
+	progress            OptionalBool
+	rateLimit           float64
+	baseURL             baseURLFlag
+	verbose             verboseFlag
+	noColor             bool
+	noTruncate          bool
+	quiet               bool
+	maxItems            int
+	saveCursorPath      string
+	resumeCursorPath    string
 
 	getCredentialsWithSourceFn = auth.GetCredentialsWithSource
 )
 
 var (
-	isTerminal = term.IsTerminal
-	noProgress bool
+	isTerminal   = term.IsTerminal
+	terminalSize = term.GetSize
+	noProgress   bool
 )
 
 // BindRootFlags registers root-level flags that affect shared CLI behavior.
@@ -80,15 +97,107 @@ func BindRootFlags(fs *flag.FlagSet) {
 	retryLog.EnableBoolFlag()
 	debug.EnableBoolFlag()
 	apiDebug.EnableBoolFlag()
+	progress = OptionalBool{}
+	progress.EnableBoolFlag()
+	baseURL = baseURLFlag{}
+	asc.SetBaseURLOverride("")
+	verbose = 0
+	asc.SetTimeoutOverride(0)
+	resetPaginationCursor()
+	resetRawOutput()
+	interactiveDisabled = false
 
 	fs.StringVar(&selectedProfile, "profile", "", "Use named authentication profile")
+	fs.StringVar(&privateKeyBase64, "private-key-base64", "", "Base64-encoded .p8 private key, decoded in memory; used with ASC_KEY_ID/ASC_ISSUER_ID instead of stored credentials")
 	fs.BoolVar(&strictAuth, "strict-auth", false, "Fail when credentials are resolved from multiple sources")
 	fs.Var(&retryLog, "retry-log", "Enable retry logging to stderr (overrides ASC_RETRY_LOG/config when set)")
 	fs.Var(&debug, "debug", "Enable debug logging to stderr")
 	fs.Var(&apiDebug, "api-debug", "Enable HTTP debug logging to stderr (redacts sensitive values)")
+	fs.Var(&verbose, "verbose", "Log each API request, status, and timing to stderr; --verbose=2 also logs headers and response bodies")
+	fs.Var(&verbose, "v", "Shorthand for --verbose")
+	fs.Float64Var(&rateLimit, "rate-limit", 0, "Maximum API requests per second (0 = unlimited)")
+	fs.Func("timeout", "Request timeout as a Go duration, e.g. 90s or 5m (overrides ASC_TIMEOUT; default 30s)", setTimeoutOverride)
+	fs.Var(&baseURL, "base-url", "App Store Connect API base URL, e.g. a mock server (overrides ASC_BASE_URL; https only)")
+	fs.BoolVar(&noColor, "no-color", false, "Disable colored table output (also honored via NO_COLOR)")
+	fs.BoolVar(&noTruncate, "no-truncate", false, "Do not wrap table cells to the terminal width")
+	fs.BoolVar(&interactive, "interactive", false, "Prompt to pick one match when --app or --version is ambiguous (or ASC_INTERACTIVE=1; never with --confirm)")
+	fs.BoolVar(&quiet, "quiet", false, "Suppress normal output; errors are still printed to stderr")
+	fs.Var(&progress, "progress", "Report pagination progress on stderr (default: only when interactive)")
+	fs.IntVar(&maxItems, "max-items", 0, "Stop --paginate once at least N items are fetched; links.next marks the rest (0 = unlimited)")
+	fs.StringVar(&saveCursorPath, "save-cursor", "", "Write the next-page URL to this file after each --paginate page (removed once pagination completes)")
+	fs.StringVar(&resumeCursorPath, "resume-cursor", "", "Resume --paginate from the next-page URL saved by --save-cursor (missing file starts from the first page)")
 	BindCIFlags(fs)
 }
 
+// baseURLFlag applies --base-url as soon as it is parsed, so --next validation
+// (which runs before any client is built) sees the host requests will use.
+type baseURLFlag struct {
+	value string
+}
+
+func (f *baseURLFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.value
+}
+
+func (f *baseURLFlag) Set(value string) error {
+	normalized, err := asc.NormalizeBaseURL(value)
+	if err != nil {
+		return err
+	}
+	f.value = normalized
+	asc.SetBaseURLOverride(normalized)
+	return nil
+}
+
+// setTimeoutOverride applies --timeout at parse time so every
+// ContextWithTimeout call and client built afterwards uses it.
+func setTimeoutOverride(value string) error {
+	timeout, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("must be a duration like 90s or 5m")
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("must be greater than 0")
+	}
+	asc.SetTimeoutOverride(timeout)
+	return nil
+}
+
+// verboseFlag is a bool-style flag that also accepts a level, so both
+// --verbose and --verbose=2 work.
+type verboseFlag int
+
+func (f *verboseFlag) String() string {
+	if f == nil || *f == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(*f))
+}
+
+func (f *verboseFlag) Set(value string) error {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true":
+		*f = asc.VerboseRequests
+		return nil
+	case "false":
+		*f = 0
+		return nil
+	}
+	level, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || level < 0 || level > asc.VerboseBodies {
+		return fmt.Errorf("must be true, false, or a level from 0 to %d", asc.VerboseBodies)
+	}
+	*f = verboseFlag(level)
+	return nil
+}
+
+func (f *verboseFlag) IsBoolFlag() bool {
+	return true
+}
+
 // SelectedProfile returns the current profile override.
 func SelectedProfile() string {
 	return selectedProfile
@@ -103,6 +212,16 @@ func ProgressEnabled() bool {
 	return isTerminal(int(os.Stderr.Fd()))
 }
 
+// Quiet reports whether --quiet suppressed normal command output.
+func Quiet() bool {
+	return quiet
+}
+
+// SetQuiet sets output suppression (tests only).
+func SetQuiet(value bool) {
+	quiet = value
+}
+
 // SetNoProgress sets progress suppression (tests only).
 func SetNoProgress(value bool) {
 	noProgress = value
@@ -151,6 +270,42 @@ func RenderSection(title string, headers []string, rows [][]string, markdown boo
 	fmt.Fprintln(os.Stdout)
 }
 
+// tableColorEnabled reports whether table output on stdout should be colored.
+func tableColorEnabled() bool {
+	if noColor {
+		return false
+	}
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	if strings.EqualFold(os.Getenv("TERM"), "dumb") {
+		return false
+	}
+	return isTerminal(int(os.Stdout.Fd()))
+}
+
+// defaultTableWidth is used for table output when stdout is not a terminal
+// and COLUMNS is unset.
+const defaultTableWidth = 80
+
+// tableMaxWidth returns the width table output should fit, or 0 when
+// --no-truncate disables wrapping.
+func tableMaxWidth() int {
+	if noTruncate {
+		return 0
+	}
+	fd := int(os.Stdout.Fd())
+	if isTerminal(fd) {
+		if width, _, err := terminalSize(fd); err == nil && width > 0 {
+			return width
+		}
+	}
+	if columns, err := strconv.Atoi(strings.TrimSpace(os.Getenv("COLUMNS"))); err == nil && columns > 0 {
+		return columns
+	}
+	return defaultTableWidth
+}
+
 func supportsANSI() bool {
 	if _, ok := os.LookupEnv("NO_COLOR"); ok {
 		return false
@@ -251,13 +406,21 @@ type envCredentials struct {
 	keyID    string
 	issuerID string
 	keyPath  string
+	keyPEM   string
 	complete bool
 }
 
 // OutputFlags stores pointers to output-related flag values.
 type OutputFlags struct {
-	Output *string
-	Pretty *bool
+	Output   *string
+	Pretty   *bool
+	Select   *string
+	Columns  *string
+	Count    *bool
+	Filter   *filterFlag
+	SortBy   *string
+	SortDesc *bool
+	Raw      *bool
 }
 
 // MetadataOutputFlags stores pointers to metadata output-related flag values.
@@ -282,29 +445,39 @@ type credentialSource struct {
 func resolveEnvCredentials() (envCredentials, error) {
 	keyID := strings.TrimSpace(os.Getenv("ASC_KEY_ID"))
 	issuerID := strings.TrimSpace(os.Getenv("ASC_ISSUER_ID"))
+	keyPEM, err := resolvePrivateKeyBase64()
+	if err != nil {
+		return envCredentials{}, err
+	}
 	hasKeyPathEnv := strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_PATH")) != "" ||
 		strings.TrimSpace(os.Getenv(privateKeyEnvVar)) != "" ||
 		strings.TrimSpace(os.Getenv(privateKeyBase64EnvVar)) != ""
 
-	if keyID == "" && issuerID == "" && !hasKeyPathEnv {
+	if keyID == "" && issuerID == "" && !hasKeyPathEnv && keyPEM == "" {
 		return envCredentials{}, nil
 	}
 
-	keyPath, err := resolvePrivateKeyPath()
-	if err != nil {
-		return envCredentials{}, err
-	}
-
 	creds := envCredentials{
 		keyID:    keyID,
 		issuerID: issuerID,
-		keyPath:  keyPath,
+		keyPEM:   keyPEM,
 	}
-	creds.complete = keyID != "" && issuerID != "" && keyPath != ""
+	if keyPEM == "" {
+		keyPath, err := resolvePrivateKeyPath()
+		if err != nil {
+			return envCredentials{}, err
+		}
+		creds.keyPath = keyPath
+	}
+	creds.complete = keyID != "" && issuerID != "" && (creds.keyPath != "" || creds.keyPEM != "")
 	return creds, nil
 }
 
 func resolveCredentials() (resolvedCredentials, error) {
+	if strings.TrimSpace(privateKeyBase64) != "" {
+		return resolvePrivateKeyBase64FlagCredentials()
+	}
+
 	var actualKeyID, actualIssuerID, actualKeyPath, actualKeyPEM string
 	profile := resolveProfileName()
 	var envCreds envCredentials
@@ -326,6 +499,7 @@ func resolveCredentials() (resolvedCredentials, error) {
 				keyID:    envCreds.keyID,
 				issuerID: envCreds.issuerID,
 				keyPath:  envCreds.keyPath,
+				keyPEM:   envCreds.keyPEM,
 			}, nil
 		}
 	}
@@ -370,9 +544,14 @@ func resolveCredentials() (resolvedCredentials, error) {
 			actualIssuerID = envCreds.issuerID
 			sources.issuerID = "env"
 		}
-		if actualKeyPath == "" && actualKeyPEM == "" && envCreds.keyPath != "" {
-			actualKeyPath = envCreds.keyPath
-			sources.keyMaterial = "env"
+		if actualKeyPath == "" && actualKeyPEM == "" {
+			if envCreds.keyPEM != "" {
+				actualKeyPEM = envCreds.keyPEM
+				sources.keyMaterial = "env"
+			} else if envCreds.keyPath != "" {
+				actualKeyPath = envCreds.keyPath
+				sources.keyMaterial = "env"
+			}
 		}
 	}
 
@@ -394,21 +573,60 @@ func resolveCredentials() (resolvedCredentials, error) {
 	}, nil
 }
 
-func getASCClient() (*asc.Client, error) {
+// resolvePrivateKeyBase64FlagCredentials resolves credentials when
+// --private-key-base64 is passed. The explicit key always wins over stored
+// keychain/config credentials, so it is paired with ASC_KEY_ID and
+// ASC_ISSUER_ID rather than with a stored key's identifiers.
+func resolvePrivateKeyBase64FlagCredentials() (resolvedCredentials, error) {
+	if profile := resolveProfileName(); profile != "" {
+		return resolvedCredentials{}, UsageErrorf("--private-key-base64 cannot be combined with profile %q", profile)
+	}
+	keyPEM, err := resolvePrivateKeyBase64()
+	if err != nil {
+		return resolvedCredentials{}, UsageError(err.Error())
+	}
+	keyID := strings.TrimSpace(os.Getenv("ASC_KEY_ID"))
+	issuerID := strings.TrimSpace(os.Getenv("ASC_ISSUER_ID"))
+	if keyID == "" || issuerID == "" {
+		return resolvedCredentials{}, missingAuthError{msg: "missing authentication. --private-key-base64 requires ASC_KEY_ID and ASC_ISSUER_ID"}
+	}
+	return resolvedCredentials{
+		keyID:    keyID,
+		issuerID: issuerID,
+		keyPEM:   keyPEM,
+	}, nil
+}
+
+func getASCClient(opts ...asc.ClientOption) (*asc.Client, error) {
+	if rateLimit < 0 {
+		return nil, UsageError("--rate-limit must be >= 0")
+	}
+	if _, err := asc.ResolveBaseURL(); err != nil {
+		return nil, UsageError(err.Error())
+	}
 	resolved, err := resolveCredentials()
 	if err != nil {
 		return nil, err
 	}
 	ApplyRootLoggingOverrides()
+	resetFetchedPages()
+	applyRawResponseCapture()
+	if err := applyPaginationCursor(); err != nil {
+		return nil, err
+	}
+	if rateLimit > 0 {
+		opts = append([]asc.ClientOption{asc.WithRequestsPerSecond(rateLimit)}, opts...)
+	}
 	if strings.TrimSpace(resolved.keyPEM) != "" {
-		return asc.NewClientFromPEM(resolved.keyID, resolved.issuerID, resolved.keyPEM)
+		return asc.NewClientFromPEM(resolved.keyID, resolved.issuerID, resolved.keyPEM, opts...)
 	}
-	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
+	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath, opts...)
 }
 
 // ApplyRootLoggingOverrides applies root-level logging flag overrides
-// (--retry-log, --debug, --api-debug) into the shared ASC runtime.
+// (--retry-log, --debug, --api-debug, --verbose) into the shared ASC runtime.
 func ApplyRootLoggingOverrides() {
+	asc.SetVerboseLevel(int(verbose))
 	if retryLog.IsSet() {
 		value := retryLog.Value()
 		asc.SetRetryLogOverride(&value)
@@ -473,6 +691,32 @@ func resolvePrivateKeyPath() (string, error) {
 	return "", nil
 }
 
+// resolvePrivateKeyBase64 decodes --private-key-base64 or ASC_PRIVATE_KEY_BASE64
+// in memory and returns the PEM, or "" when neither is set.
+func resolvePrivateKeyBase64() (string, error) {
+	source := "--private-key-base64"
+	value := strings.TrimSpace(privateKeyBase64)
+	if value == "" {
+		source = privateKeyBase64MemEnvVar
+		value = strings.TrimSpace(os.Getenv(privateKeyBase64MemEnvVar))
+	}
+	if value == "" {
+		return "", nil
+	}
+	if strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_PATH")) != "" {
+		return "", fmt.Errorf("%s and ASC_PRIVATE_KEY_PATH are mutually exclusive", source)
+	}
+
+	decoded, err := decodeBase64Secret(value)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", source, err)
+	}
+	if _, err := auth.LoadPrivateKeyFromPEM(decoded); err != nil {
+		return "", fmt.Errorf("%s is not a valid PKCS8 ECDSA private key: %w", source, err)
+	}
+	return string(decoded), nil
+}
+
 func decodeBase64Secret(value string) ([]byte, error) {
 	compact := strings.Join(strings.Fields(value), "")
 	if compact == "" {
@@ -574,23 +818,114 @@ func printOutput(data any, format string, pretty bool) error {
 	if err != nil {
 		return err
 	}
+	if handled, err := rawOutputHandled(); handled || err != nil {
+		return err
+	}
+	if err := validateSelectFormat(format); err != nil {
+		return err
+	}
+	if err := validateColumnsFormat(format); err != nil {
+		return err
+	}
+	data, err = applyOutputFilters(data)
+	if err != nil {
+		return err
+	}
+	data, err = applyOutputSort(data)
+	if err != nil {
+		return err
+	}
+	summary, err := buildCountSummary(data, format)
+	if err != nil {
+		return err
+	}
+	if quiet {
+		return nil
+	}
+	if err := renderOutput(data, format, pretty); err != nil {
+		return err
+	}
+	return printCountSummary(summary, format)
+}
+
+func renderOutput(data any, format string, pretty bool) error {
 	switch format {
 	case "json":
 		return printJSONOutput(data, pretty)
 	case "markdown":
 		return asc.PrintMarkdown(data)
 	case "table":
-		return asc.PrintTable(data)
+		asc.SetTableColor(tableColorEnabled())
+		asc.SetTableMaxWidth(tableMaxWidth())
+		return withOutputColumns(func() error { return asc.PrintTable(data) })
+	case "yaml":
+		return asc.PrintYAML(data)
+	case "csv":
+		return withOutputColumns(func() error { return asc.PrintCSV(data) })
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
 }
 
-func printOutputWithRenderers(data any, format string, pretty bool, tableRenderer, markdownRenderer func() error) error {
-	format, err := validateOutputFormat(format, pretty)
+// FormatRenderer renders a command-specific output format, such as status's
+// slack, alongside the standard formats.
+type FormatRenderer struct {
+	Format string
+	Render func() error
+}
+
+func printOutputWithRenderers(data any, format string, pretty bool, tableRenderer, markdownRenderer func() error, extra ...FormatRenderer) error {
+	allowed := []string{"json", "table", "markdown", "yaml", "csv"}
+	for _, renderer := range extra {
+		allowed = append(allowed, renderer.Format)
+	}
+	format, err := validateOutputFormatAllowed(format, pretty, allowed...)
+	if err != nil {
+		return err
+	}
+	if handled, err := rawOutputHandled(); handled || err != nil {
+		return err
+	}
+	if err := validateSelectFormat(format); err != nil {
+		return err
+	}
+	if err := validateColumnsFormat(format); err != nil {
+		return err
+	}
+	if (len(outputFilters) > 0 || strings.TrimSpace(outputSortBy) != "") && format != "json" && format != "yaml" {
+		// Custom renderers close over the original data.
+		return UsageError("--filter and --sort-by are only supported with json or yaml output for this command")
+	}
+	data, err = applyOutputFilters(data)
+	if err != nil {
+		return err
+	}
+	data, err = applyOutputSort(data)
+	if err != nil {
+		return err
+	}
+	summary, err := buildCountSummary(data, format)
 	if err != nil {
 		return err
 	}
+	if quiet {
+		return nil
+	}
+	if err := renderOutputWithRenderers(data, format, pretty, tableRenderer, markdownRenderer, extra); err != nil {
+		return err
+	}
+	return printCountSummary(summary, format)
+}
+
+func renderOutputWithRenderers(data any, format string, pretty bool, tableRenderer, markdownRenderer func() error, extra []FormatRenderer) error {
+	for _, renderer := range extra {
+		if NormalizeOutputFormat(renderer.Format) == format {
+			if renderer.Render == nil {
+				return fmt.Errorf("%s renderer is required", format)
+			}
+			return renderer.Render()
+		}
+	}
 	switch format {
 	case "json":
 		return printJSONOutput(data, pretty)
@@ -598,24 +933,43 @@ func printOutputWithRenderers(data any, format string, pretty bool, tableRendere
 		if tableRenderer == nil {
 			return fmt.Errorf("table renderer is required")
 		}
-		return tableRenderer()
+		asc.SetTableColor(tableColorEnabled())
+		asc.SetTableMaxWidth(tableMaxWidth())
+		return withOutputColumns(tableRenderer)
 	case "markdown":
 		if markdownRenderer == nil {
 			return fmt.Errorf("markdown renderer is required")
 		}
 		return markdownRenderer()
+	case "yaml":
+		return asc.PrintYAML(data)
+	case "csv":
+		if tableRenderer == nil {
+			return fmt.Errorf("table renderer is required")
+		}
+		return withOutputColumns(func() error { return asc.WithCSVTables(tableRenderer) })
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
 }
 
 func printJSONOutput(data any, pretty bool) error {
+	if strings.TrimSpace(outputSelect) != "" {
+		return printSelectedJSON(data, outputSelect, pretty)
+	}
 	if pretty {
 		return asc.PrintPrettyJSON(data)
 	}
 	return asc.PrintJSON(data)
 }
 
+func validateSelectFormat(format string) error {
+	if strings.TrimSpace(outputSelect) != "" && format != "json" {
+		return fmt.Errorf("--select is only valid with JSON output")
+	}
+	return nil
+}
+
 // NormalizeOutputFormat lowercases format and canonicalizes aliases.
 func NormalizeOutputFormat(format string) string {
 	switch strings.ToLower(strings.TrimSpace(format)) {
@@ -627,7 +981,7 @@ func NormalizeOutputFormat(format string) string {
 }
 
 func validateOutputFormat(format string, pretty bool) (string, error) {
-	return validateOutputFormatAllowed(format, pretty, "json", "table", "markdown")
+	return validateOutputFormatAllowed(format, pretty, "json", "table", "markdown", "yaml", "csv")
 }
 
 func validateOutputFormatAllowed(format string, pretty bool, allowed ...string) (string, error) {
@@ -647,13 +1001,21 @@ func validateOutputFormatAllowed(format string, pretty bool, allowed ...string)
 	if _, ok := allowedSet[normalized]; !ok {
 		return "", fmt.Errorf("unsupported format: %s", normalized)
 	}
-	if pretty && normalized != "json" {
+	// YAML is always human-readable, so --pretty is accepted as a no-op.
+	if pretty && normalized != "json" && normalized != "yaml" {
 		return "", fmt.Errorf("--pretty is only valid with JSON output")
 	}
 	return normalized, nil
 }
 
 func printStreamPage(data any) error {
+	if outputRaw {
+		// Stream the page's unparsed body instead of the decoded one.
+		if body := asc.TakeLastRawResponse(); body != nil {
+			printRawResponse(body)
+		}
+		return nil
+	}
 	return asc.PrintJSON(data)
 }
 
@@ -708,7 +1070,7 @@ var (
 
 // DefaultOutputFormat returns the default output format for CLI commands.
 // It checks the ASC_DEFAULT_OUTPUT environment variable first, falling back to "json".
-// Valid values are "json", "table", "markdown", and "md".
+// Valid values are "json", "table", "markdown", "md", "yaml", and "csv".
 func DefaultOutputFormat() string {
 	defaultOutputOnce.Do(func() {
 		defaultOutputValue = resolveDefaultOutput()
@@ -723,24 +1085,55 @@ func resolveDefaultOutput() string {
 	}
 	normalized := strings.ToLower(env)
 	switch normalized {
-	case "json", "table", "markdown", "md":
+	case "json", "table", "markdown", "md", "yaml", "csv":
 		return normalized
 	default:
-		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, or md); using json\n", defaultOutputEnvVar, env)
+		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, md, yaml, or csv); using json\n", defaultOutputEnvVar, env)
 		return "json"
 	}
 }
 
-// BindOutputFlagsWith registers a custom output-format flag and --pretty.
+// BindOutputFlagsWith registers a custom output-format flag, --pretty, and --select.
 func BindOutputFlagsWith(fs *flag.FlagSet, flagName, defaultValue, usage string) OutputFlags {
 	name := strings.TrimSpace(flagName)
 	if name == "" {
 		name = "output"
 	}
+	output := defaultValue
+	fs.Var((*outputFormatValue)(&output), name, usage)
+	sortBy, sortDesc := bindSortFlags(fs)
 	return OutputFlags{
-		Output: fs.String(name, defaultValue, usage),
-		Pretty: BindPrettyJSONFlag(fs),
+		Output:   &output,
+		Pretty:   BindPrettyJSONFlag(fs),
+		Select:   bindSelectFlag(fs),
+		Columns:  bindColumnsFlag(fs),
+		Count:    bindCountFlag(fs),
+		Filter:   bindFilterFlag(fs),
+		SortBy:   sortBy,
+		SortDesc: sortDesc,
+		Raw:      bindRawFlag(fs),
+	}
+}
+
+// outputFormatValue is the flag.Value behind output-format flags. Its type
+// tells them apart from commands whose --output is a file or directory path.
+type outputFormatValue string
+
+func (v *outputFormatValue) String() string { return string(*v) }
+
+func (v *outputFormatValue) Set(value string) error {
+	*v = outputFormatValue(value)
+	return nil
+}
+
+// IsOutputFormatFlag reports whether f was registered by BindOutputFlagsWith
+// as an output-format flag.
+func IsOutputFormatFlag(f *flag.Flag) bool {
+	if f == nil {
+		return false
 	}
+	_, ok := f.Value.(*outputFormatValue)
+	return ok
 }
 
 // BindPrettyJSONFlag registers a --pretty flag for JSON rendering.
@@ -748,14 +1141,15 @@ func BindPrettyJSONFlag(fs *flag.FlagSet) *bool {
 	return fs.Bool("pretty", false, "Pretty-print JSON output")
 }
 
-// BindOutputFlags registers --output and --pretty flags on the provided flagset.
+// BindOutputFlags registers --output, --pretty, --select, --count, --filter,
+// --sort-by, --sort-desc, and --raw flags on the provided flagset.
 func BindOutputFlags(fs *flag.FlagSet) OutputFlags {
-	return BindOutputFlagsWith(fs, "output", DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	return BindOutputFlagsWith(fs, "output", DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, csv")
 }
 
 // BindMetadataOutputFlags registers --output-format and --pretty flags on the provided flagset.
 func BindMetadataOutputFlags(fs *flag.FlagSet) MetadataOutputFlags {
-	output := BindOutputFlagsWith(fs, "output-format", "json", "Output format for metadata: json (default), table, markdown")
+	output := BindOutputFlagsWith(fs, "output-format", "json", "Output format for metadata: json (default), table, markdown, yaml, csv")
 	return MetadataOutputFlags{
 		OutputFormat: output.Output,
 		Pretty:       output.Pretty,
@@ -819,16 +1213,27 @@ func splitCSVUpper(value string) []string {
 }
 
 func validateNextURL(next string) error {
-	next = strings.TrimSpace(next)
-	if next == "" {
+	return validateASCURL("--next", next)
+}
+
+// validateASCURL checks that value is an HTTPS URL on the App Store Connect API
+// host requests are sent to (see asc.ResolveBaseURL); label names the input in
+// error messages.
+func validateASCURL(label, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
 		return nil
 	}
-	parsed, err := url.Parse(next)
+	parsed, err := url.Parse(value)
 	if err != nil {
-		return fmt.Errorf("--next must be a valid URL: %w", err)
+		return fmt.Errorf("%s must be a valid URL: %w", label, err)
 	}
-	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
-		return fmt.Errorf("--next must be an App Store Connect URL")
+	baseHost, err := asc.ResolveBaseHost()
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "https" || parsed.Host != baseHost {
+		return fmt.Errorf("%s must be an App Store Connect URL", label)
 	}
 	return nil
 }
@@ -846,13 +1251,18 @@ func validateSort(value string, allowed ...string) error {
 
 // Exported wrappers for shared helpers.
 func GetASCClient() (*asc.Client, error) {
+	return GetASCClientWithOptions()
+}
+
+// GetASCClientWithOptions resolves credentials and builds a client with the given options.
+func GetASCClientWithOptions(opts ...asc.ClientOption) (*asc.Client, error) {
 	// Auth resolution can block on macOS keychain prompts. Show a subtle spinner on stderr
 	// (interactive runs only) so the CLI doesn’t look “stuck”.
 	const authSpinnerDelay = 200 * time.Millisecond
 	var client *asc.Client
 	err := WithSpinnerDelayed("", authSpinnerDelay, func() error {
 		var innerErr error
-		client, innerErr = getASCClient()
+		client, innerErr = getASCClient(opts...)
 		return innerErr
 	})
 	return client, err
@@ -870,8 +1280,8 @@ func PrintOutput(data any, format string, pretty bool) error {
 	return printOutput(data, format, pretty)
 }
 
-func PrintOutputWithRenderers(data any, format string, pretty bool, tableRenderer, markdownRenderer func() error) error {
-	return printOutputWithRenderers(data, format, pretty, tableRenderer, markdownRenderer)
+func PrintOutputWithRenderers(data any, format string, pretty bool, tableRenderer, markdownRenderer func() error, extra ...FormatRenderer) error {
+	return printOutputWithRenderers(data, format, pretty, tableRenderer, markdownRenderer, extra...)
 }
 
 func ValidateOutputFormat(format string, pretty bool) (string, error) {
@@ -914,6 +1324,10 @@ func ValidateNextURL(next string) error {
 	return validateNextURL(next)
 }
 
+func ValidateASCURL(label, value string) error {
+	return validateASCURL(label, value)
+}
+
 func ValidateSort(value string, allowed ...string) error {
 	return validateSort(value, allowed...)
 }
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -16,7 +17,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
 )
@@ -287,7 +290,11 @@ func TestValidateOutputFormat(t *testing.T) {
 		{name: "json allows pretty", input: "json", pretty: true, wantFormat: "json"},
 		{name: "md alias", input: "md", pretty: false, wantFormat: "markdown"},
 		{name: "table pretty rejected", input: "table", pretty: true, wantErr: "--pretty is only valid with JSON output"},
-		{name: "unsupported rejected", input: "yaml", pretty: false, wantErr: "unsupported format: yaml"},
+		{name: "yaml supported", input: "YAML", pretty: false, wantFormat: "yaml"},
+		{name: "yaml ignores pretty", input: "yaml", pretty: true, wantFormat: "yaml"},
+		{name: "csv supported", input: "csv", pretty: false, wantFormat: "csv"},
+		{name: "csv pretty rejected", input: "csv", pretty: true, wantErr: "--pretty is only valid with JSON output"},
+		{name: "unsupported rejected", input: "xml", pretty: false, wantErr: "unsupported format: xml"},
 	}
 
 	for _, tc := range tests {
@@ -376,6 +383,43 @@ func TestPrintOutputWithRenderers_JSONPath(t *testing.T) {
 	}
 }
 
+func TestPrintOutputWithRenderers_YAMLPath(t *testing.T) {
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutputWithRenderers(
+			map[string]string{"status": "ok"},
+			"yaml",
+			true,
+			func() error { t.Fatal("table renderer should not run"); return nil },
+			func() error { t.Fatal("markdown renderer should not run"); return nil },
+		); err != nil {
+			t.Fatalf("PrintOutputWithRenderers() error = %v", err)
+		}
+	})
+	if stdout != "status: ok\n" {
+		t.Fatalf("expected YAML output, got %q", stdout)
+	}
+}
+
+func TestPrintOutputWithRenderers_CSVUsesTableRenderer(t *testing.T) {
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutputWithRenderers(
+			map[string]string{"status": "ok"},
+			"csv",
+			false,
+			func() error {
+				asc.RenderTable([]string{"Status", "Note"}, [][]string{{"ok", "a, b"}})
+				return nil
+			},
+			func() error { t.Fatal("markdown renderer should not run"); return nil },
+		); err != nil {
+			t.Fatalf("PrintOutputWithRenderers() error = %v", err)
+		}
+	})
+	if stdout != "Status,Note\nok,\"a, b\"\n" {
+		t.Fatalf("expected CSV output, got %q", stdout)
+	}
+}
+
 func TestPrintOutputWithRenderers_JSONPrettyPath(t *testing.T) {
 	stdout, _ := captureOutput(t, func() {
 		if err := PrintOutputWithRenderers(
@@ -498,6 +542,81 @@ func TestValidateNextURL_ValidAppStoreConnectURL(t *testing.T) {
 	}
 }
 
+func TestValidateNextURL_FollowsConfiguredBaseURL(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "https://asc-mock.example.com")
+
+	if err := validateNextURL("https://asc-mock.example.com/v1/apps?cursor=abc"); err != nil {
+		t.Fatalf("validateNextURL() error = %v", err)
+	}
+	err := validateNextURL("https://api.appstoreconnect.apple.com/v1/apps?cursor=abc")
+	if err == nil || !strings.Contains(err.Error(), "--next must be an App Store Connect URL") {
+		t.Fatalf("expected default host to be rejected when ASC_BASE_URL is set, got %v", err)
+	}
+}
+
+func TestBaseURLFlagOverridesEnvAndRejectsInsecureURL(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "https://env.example.com")
+	t.Cleanup(func() { asc.SetBaseURLOverride("") })
+
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	BindRootFlags(fs)
+	if err := fs.Parse([]string{"--base-url", "https://localhost:8443/"}); err != nil {
+		t.Fatalf("parse root flags: %v", err)
+	}
+	if err := validateNextURL("https://localhost:8443/v1/apps?cursor=abc"); err != nil {
+		t.Fatalf("validateNextURL() error = %v", err)
+	}
+	if err := validateNextURL("https://env.example.com/v1/apps?cursor=abc"); err == nil {
+		t.Fatal("expected ASC_BASE_URL host to be rejected when --base-url is set")
+	}
+
+	fs = flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	BindRootFlags(fs)
+	err := fs.Parse([]string{"--base-url", "http://localhost:8080"})
+	if err == nil || !strings.Contains(err.Error(), "base-url") {
+		t.Fatalf("expected --base-url parse error, got %v", err)
+	}
+	if got, err := asc.ResolveBaseURL(); err != nil || got != "https://env.example.com" {
+		t.Fatalf("expected rejected --base-url to leave ASC_BASE_URL in effect, got %q", got)
+	}
+}
+
+func TestTimeoutFlagFeedsContextWithTimeout(t *testing.T) {
+	t.Setenv("ASC_TIMEOUT", "17s")
+	t.Cleanup(func() { asc.SetTimeoutOverride(0) })
+
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	BindRootFlags(fs)
+	if err := fs.Parse([]string{"--timeout", "5m"}); err != nil {
+		t.Fatalf("parse root flags: %v", err)
+	}
+
+	ctx, cancel := ContextWithTimeout(context.Background())
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("expected context deadline")
+	}
+	if remaining := time.Until(deadline); remaining < 4*time.Minute || remaining > 5*time.Minute {
+		t.Fatalf("expected ~5m deadline from --timeout, got %s", remaining)
+	}
+
+	for _, value := range []string{"soon", "0s", "-1m"} {
+		fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		BindRootFlags(fs)
+		if err := fs.Parse([]string{"--timeout", value}); err == nil {
+			t.Fatalf("expected --timeout %q to be rejected", value)
+		}
+	}
+	if got := asc.ResolveTimeout(); got != 17*time.Second {
+		t.Fatalf("expected rebinding root flags to clear --timeout, got %s", got)
+	}
+}
+
 func TestValidateNextURL_RejectsMalformedHost(t *testing.T) {
 	tests := []string{
 		"http://localhost:80:80/v1/apps?cursor=abc",
@@ -664,6 +783,156 @@ func TestResolvePrivateKeyPathInvalidBase64(t *testing.T) {
 	}
 }
 
+func TestResolveCredentialsFromPrivateKeyBase64InMemory(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_KEY_ID", "ENVKEY")
+	t.Setenv("ASC_ISSUER_ID", "ENVISS")
+	t.Setenv("ASC_PROFILE", "")
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	t.Setenv("ASC_PRIVATE_KEY_BASE64", base64.StdEncoding.EncodeToString(keyData))
+
+	creds, err := resolveCredentials()
+	if err != nil {
+		t.Fatalf("resolveCredentials() error: %v", err)
+	}
+	if creds.keyPEM != string(keyData) {
+		t.Fatalf("expected decoded PEM, got %q", creds.keyPEM)
+	}
+	if creds.keyPath != "" {
+		t.Fatalf("expected no key path, got %q", creds.keyPath)
+	}
+	if privateKeyTempPath != "" {
+		t.Fatalf("expected no temp key file, got %q", privateKeyTempPath)
+	}
+}
+
+func TestResolvePrivateKeyBase64FlagOverridesEnv(t *testing.T) {
+	resetPrivateKeyTemp(t)
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	t.Setenv("ASC_PRIVATE_KEY_BASE64", "not-base64")
+	privateKeyBase64 = base64.StdEncoding.EncodeToString(keyData)
+
+	pemValue, err := resolvePrivateKeyBase64()
+	if err != nil {
+		t.Fatalf("resolvePrivateKeyBase64() error: %v", err)
+	}
+	if pemValue != string(keyData) {
+		t.Fatalf("expected flag PEM, got %q", pemValue)
+	}
+}
+
+func TestResolveCredentialsPrivateKeyBase64FlagWinsOverStored(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "FLAGKEY")
+	t.Setenv("ASC_ISSUER_ID", "FLAGISS")
+
+	previousProfile := selectedProfile
+	selectedProfile = ""
+	t.Cleanup(func() { selectedProfile = previousProfile })
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	privateKeyBase64 = base64.StdEncoding.EncodeToString(keyData)
+
+	previous := getCredentialsWithSourceFn
+	getCredentialsWithSourceFn = func(string) (*config.Config, string, error) {
+		return &config.Config{
+			KeyID:          "STOREDKEY",
+			IssuerID:       "STOREDISS",
+			PrivateKeyPath: "/stored/AuthKey.p8",
+		}, "keychain", nil
+	}
+	t.Cleanup(func() { getCredentialsWithSourceFn = previous })
+
+	creds, err := resolveCredentials()
+	if err != nil {
+		t.Fatalf("resolveCredentials() error: %v", err)
+	}
+	if creds.keyID != "FLAGKEY" || creds.issuerID != "FLAGISS" {
+		t.Fatalf("expected env identifiers with flag key, got %+v", creds)
+	}
+	if creds.keyPEM != string(keyData) || creds.keyPath != "" {
+		t.Fatalf("expected flag key material, got %+v", creds)
+	}
+}
+
+func TestResolveCredentialsPrivateKeyBase64FlagRequiresKeyIDs(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+
+	previousProfile := selectedProfile
+	selectedProfile = ""
+	t.Cleanup(func() { selectedProfile = previousProfile })
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	privateKeyBase64 = base64.StdEncoding.EncodeToString(keyData)
+
+	_, err = resolveCredentials()
+	if !errors.Is(err, ErrMissingAuth) || !strings.Contains(err.Error(), "requires ASC_KEY_ID and ASC_ISSUER_ID") {
+		t.Fatalf("expected missing auth error, got %v", err)
+	}
+}
+
+func TestResolveCredentialsPrivateKeyBase64FlagRejectsProfile(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_PROFILE", "")
+
+	previousProfile := selectedProfile
+	selectedProfile = "work"
+	t.Cleanup(func() { selectedProfile = previousProfile })
+	privateKeyBase64 = base64.StdEncoding.EncodeToString([]byte("key-data"))
+
+	_, err := resolveCredentials()
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
+
+func TestResolvePrivateKeyBase64RejectsPathCombination(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "/tmp/AuthKey.p8")
+	privateKeyBase64 = base64.StdEncoding.EncodeToString([]byte("key-data"))
+
+	_, err := resolvePrivateKeyBase64()
+	if err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Fatalf("expected mutually exclusive error, got %v", err)
+	}
+}
+
+func TestResolvePrivateKeyBase64RejectsInvalidKey(t *testing.T) {
+	resetPrivateKeyTemp(t)
+	t.Setenv("ASC_PRIVATE_KEY_BASE64", base64.StdEncoding.EncodeToString([]byte("key-data")))
+
+	_, err := resolvePrivateKeyBase64()
+	if err == nil || !strings.Contains(err.Error(), "ASC_PRIVATE_KEY_BASE64 is not a valid PKCS8 ECDSA private key") {
+		t.Fatalf("expected invalid key error, got %v", err)
+	}
+}
+
 func TestCheckMixedCredentialSourcesWarns(t *testing.T) {
 	previousStrict := strictAuth
 	strictAuth = false
@@ -1062,9 +1331,13 @@ func resetPrivateKeyTemp(t *testing.T) {
 	})
 	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
 	t.Setenv("ASC_PRIVATE_KEY_B64", "")
+	t.Setenv("ASC_PRIVATE_KEY_BASE64", "")
 	t.Setenv("ASC_PRIVATE_KEY", "")
 	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
 	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	previousBase64 := privateKeyBase64
+	privateKeyBase64 = ""
+	t.Cleanup(func() { privateKeyBase64 = previousBase64 })
 }
 
 func writeECDSAPEM(t *testing.T, path string) {
@@ -1135,3 +1408,108 @@ func TestSetNoProgress(t *testing.T) {
 		t.Fatal("expected noProgress to be false after SetNoProgress(false)")
 	}
 }
+
+func TestTableColorEnabled(t *testing.T) {
+	prevNoColor := noColor
+	prevIsTerminal := isTerminal
+	t.Cleanup(func() {
+		noColor = prevNoColor
+		isTerminal = prevIsTerminal
+	})
+
+	tests := []struct {
+		name     string
+		tty      bool
+		noColor  bool
+		envColor bool
+		want     bool
+	}{
+		{name: "tty", tty: true, want: true},
+		{name: "not a tty", tty: false, want: false},
+		{name: "--no-color", tty: true, noColor: true, want: false},
+		{name: "NO_COLOR", tty: true, envColor: true, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("TERM", "xterm-256color")
+			if tc.envColor {
+				t.Setenv("NO_COLOR", "1")
+			} else {
+				t.Setenv("NO_COLOR", "")
+				os.Unsetenv("NO_COLOR")
+			}
+			isTerminal = func(int) bool { return tc.tty }
+			noColor = tc.noColor
+
+			if got := tableColorEnabled(); got != tc.want {
+				t.Fatalf("tableColorEnabled() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableMaxWidth(t *testing.T) {
+	prevNoTruncate := noTruncate
+	prevIsTerminal := isTerminal
+	prevTerminalSize := terminalSize
+	t.Cleanup(func() {
+		noTruncate = prevNoTruncate
+		isTerminal = prevIsTerminal
+		terminalSize = prevTerminalSize
+	})
+	terminalSize = func(int) (int, int, error) { return 132, 40, nil }
+
+	tests := []struct {
+		name       string
+		tty        bool
+		columns    string
+		noTruncate bool
+		want       int
+	}{
+		{name: "tty uses terminal width", tty: true, want: 132},
+		{name: "not a tty falls back to 80", want: 80},
+		{name: "not a tty honors COLUMNS", columns: "100", want: 100},
+		{name: "invalid COLUMNS falls back to 80", columns: "wide", want: 80},
+		{name: "--no-truncate disables wrapping", tty: true, noTruncate: true, want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("COLUMNS", tc.columns)
+			isTerminal = func(int) bool { return tc.tty }
+			noTruncate = tc.noTruncate
+
+			if got := tableMaxWidth(); got != tc.want {
+				t.Fatalf("tableMaxWidth() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPrintOutput_QuietSuppressesOutput(t *testing.T) {
+	SetQuiet(true)
+	t.Cleanup(func() { SetQuiet(false) })
+
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(map[string]string{"status": "ok"}, "json", false); err != nil {
+			t.Fatalf("PrintOutput() error = %v", err)
+		}
+		if err := PrintOutputWithRenderers(
+			map[string]string{"status": "ok"},
+			"table",
+			false,
+			func() error { t.Fatal("table renderer should not run"); return nil },
+			nil,
+		); err != nil {
+			t.Fatalf("PrintOutputWithRenderers() error = %v", err)
+		}
+	})
+	if stdout != "" {
+		t.Fatalf("expected no output in quiet mode, got %q", stdout)
+	}
+
+	if err := PrintOutput(map[string]string{}, "xml", false); err == nil {
+		t.Fatal("expected format validation to still run in quiet mode")
+	}
+}
@@ -135,18 +135,25 @@ type FetchFunc func(ctx context.Context) (asc.PaginatedResponse, error)
 
 // PaginateWithSpinner fetches all pages with a spinner on stderr.
 // It wraps both the initial fetch and the pagination loop so the spinner
-// is visible even for single-page results.
-func PaginateWithSpinner(ctx context.Context, fetch FetchFunc, next asc.PaginateFunc) (asc.PaginatedResponse, error) {
+// is visible even for single-page results. opts are passed to asc.PaginateAll.
+func PaginateWithSpinner(ctx context.Context, fetch FetchFunc, next asc.PaginateFunc, opts ...asc.PaginateOption) (asc.PaginatedResponse, error) {
 	var result asc.PaginatedResponse
-	err := WithSpinner("", func() error {
+	run := func() error {
 		firstPage, fetchErr := fetch(ctx)
 		if fetchErr != nil {
 			return fetchErr
 		}
 		var paginateErr error
-		result, paginateErr = asc.PaginateAll(ctx, firstPage, next)
+		result, paginateErr = asc.PaginateAll(ctx, firstPage, next, opts...)
 		return paginateErr
-	})
+	}
+	// The pagination progress line already owns stderr; don't draw a spinner over it.
+	var err error
+	if paginationProgressEnabled() {
+		err = run()
+	} else {
+		err = WithSpinner("", run)
+	}
 	return result, err
 }
 
@@ -3,7 +3,6 @@ package signing
 import (
 	"context"
 	"encoding/base64"
-	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -28,7 +27,7 @@ func SigningFetchCommand() *ffcli.Command {
 	certType := fs.String("certificate-type", "", "Certificate type filter (optional)")
 	outputPath := fs.String("output", "./signing", "Output directory for signing files")
 	createMissing := fs.Bool("create-missing", false, "Create missing profiles")
-	output := shared.BindOutputFlagsWith(fs, "format", "json", "Output format for metadata: json (default), table, markdown")
+	output := shared.BindOutputFlagsWith(fs, "format", "json", "Output format for metadata: json (default), table, markdown, yaml, csv")
 
 	return &ffcli.Command{
 		Name:       "fetch",
@@ -130,11 +129,9 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("signing fetch: decode profile: %w", err)
 			}
-			if err := shared.WriteProfileFile(profilePath, profileContent); err != nil {
-				return fmt.Errorf("signing fetch: write profile: %w", err)
-			}
-			result.ProfileFile = profilePath
+			files := []shared.FileWrite{{Path: profilePath, Data: profileContent, Perm: shared.SigningFilePerm}}
 
+			var certPaths []string
 			for _, cert := range certs.Data {
 				certName := safeFileName(cert.Attributes.SerialNumber, cert.ID)
 				certPath := filepath.Join(outputDir, certName+".cer")
@@ -142,12 +139,18 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("signing fetch: decode certificate: %w", err)
 				}
-				if err := writeBinaryFile(certPath, certContent); err != nil {
-					return fmt.Errorf("signing fetch: write certificate: %w", err)
-				}
-				result.CertificateFiles = append(result.CertificateFiles, certPath)
+				files = append(files, shared.FileWrite{Path: certPath, Data: certContent, Perm: shared.SigningFilePerm})
+				certPaths = append(certPaths, certPath)
 			}
 
+			// Write the profile and its certificates together so a failure
+			// never leaves a profile without the certificates it references.
+			if err := writeSigningFiles(files); err != nil {
+				return fmt.Errorf("signing fetch: write files: %w", err)
+			}
+			result.ProfileFile = profilePath
+			result.CertificateFiles = certPaths
+
 			return shared.PrintOutput(result, *output.Output, *output.Pretty)
 		},
 	}
@@ -314,20 +317,8 @@ func decodeBase64Content(label, content string) ([]byte, error) {
 	return data, nil
 }
 
-func writeBinaryFile(path string, data []byte) error {
-	file, err := shared.OpenNewFileNoFollow(path, 0o600)
-	if err != nil {
-		if errors.Is(err, os.ErrExist) {
-			return fmt.Errorf("output file already exists: %w", err)
-		}
-		return err
-	}
-	defer file.Close()
-
-	if _, err := file.Write(data); err != nil {
-		return err
-	}
-	return file.Sync()
+func writeSigningFiles(files []shared.FileWrite) error {
+	return shared.WriteFilesNoSymlink(files, false, ".asc-signing-*", ".asc-signing-backup-*")
 }
 
 func extractIDs[T any](items []asc.Resource[T]) []string {
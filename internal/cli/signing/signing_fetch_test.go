@@ -135,15 +135,16 @@ func TestSigningFetchWriteFiles_NoOverwrite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("decode profile error: %v", err)
 	}
-	if err := shared.WriteProfileFile(profilePath, profileData); err != nil {
-		t.Fatalf("writeProfileFile error: %v", err)
-	}
 	certData, err := decodeBase64Content("certificate", certContent)
 	if err != nil {
 		t.Fatalf("decode certificate error: %v", err)
 	}
-	if err := writeBinaryFile(certPath, certData); err != nil {
-		t.Fatalf("writeBinaryFile error: %v", err)
+	files := []shared.FileWrite{
+		{Path: profilePath, Data: profileData, Perm: 0o644},
+		{Path: certPath, Data: certData, Perm: 0o600},
+	}
+	if err := writeSigningFiles(files); err != nil {
+		t.Fatalf("writeSigningFiles error: %v", err)
 	}
 
 	if data, err := os.ReadFile(profilePath); err != nil {
@@ -164,8 +165,8 @@ func TestSigningFetchWriteFiles_NoOverwrite(t *testing.T) {
 		t.Fatalf("expected ErrExist, got %v", err)
 	}
 
-	if err := writeBinaryFile(certPath, certData); err == nil {
-		t.Fatal("expected error when overwriting certificate file")
+	if err := writeSigningFiles(files); err == nil {
+		t.Fatal("expected error when overwriting signing files")
 	} else if !errors.Is(err, os.ErrExist) {
 		t.Fatalf("expected ErrExist, got %v", err)
 	}
@@ -0,0 +1,107 @@
+package status
+
+import "strings"
+
+// stateExplanations maps App Store Connect state values shown by the dashboard
+// to one-line guidance for --explain. The same value can appear in more than
+// one section (for example WAITING_FOR_REVIEW for versions and submissions),
+// so each line reads correctly wherever it is shown.
+var stateExplanations = map[string]string{
+	// Build processing.
+	"PROCESSING": "Apple is still processing the upload; it cannot be tested or submitted yet.",
+	"VALID":      "Processing finished; the build can be tested or attached to a version.",
+	"INVALID":    "Processing rejected the build; check the email from App Store Connect and upload a new build.",
+	"FAILED":     "Processing failed; upload a new build.",
+
+	// TestFlight external testing.
+	"PROCESSING_EXCEPTION":        "Processing hit an error on Apple's side; upload a new build.",
+	"MISSING_EXPORT_COMPLIANCE":   "Answer the export compliance questions before the build can be tested.",
+	"READY_FOR_BETA_TESTING":      "Available to internal testers; add it to an external group to start beta review.",
+	"IN_BETA_TESTING":             "Available to external testers.",
+	"EXPIRED":                     "The build passed its 90-day TestFlight limit; upload a new build.",
+	"READY_FOR_BETA_SUBMISSION":   "Submit the build for beta review to reach external testers.",
+	"IN_EXPORT_COMPLIANCE_REVIEW": "Apple is reviewing the export compliance documentation.",
+	"WAITING_FOR_BETA_REVIEW":     "Queued for TestFlight beta review.",
+	"IN_BETA_REVIEW":              "TestFlight beta review is in progress.",
+	"BETA_REJECTED":               "Beta review rejected the build; fix the issues and resubmit.",
+	"BETA_APPROVED":               "Beta review approved the build for external testers.",
+	"APPROVED":                    "Beta review approved the build for external testers.",
+
+	// App Store versions and review submissions.
+	"PREPARE_FOR_SUBMISSION":        "Editable draft; finish metadata, attach a build, and submit for review.",
+	"READY_FOR_REVIEW":              "Ready to submit; nothing has been sent to App Review yet.",
+	"WAITING_FOR_REVIEW":            "Submitted and queued for App Review; you can still cancel the submission.",
+	"IN_REVIEW":                     "App Review is looking at it now; changes are locked until a decision.",
+	"UNRESOLVED_ISSUES":             "App Review found problems; reply in the Resolution Center or fix and resubmit.",
+	"CANCELING":                     "The submission is being withdrawn from review.",
+	"COMPLETING":                    "Review decisions are being applied.",
+	"COMPLETE":                      "Finished; nothing left to do for this item.",
+	"WAITING_FOR_EXPORT_COMPLIANCE": "Answer the export compliance questions so the version can enter review.",
+	"PENDING_DEVELOPER_RELEASE":     "Approved and waiting for you to release it.",
+	"PENDING_APPLE_RELEASE":         "Approved and waiting for Apple to release it (for example, a scheduled release date).",
+	"ACCEPTED":                      "Approved by App Review.",
+	"PROCESSING_FOR_APP_STORE":      "Approved and being prepared for the App Store; it will go live shortly.",
+	"PROCESSING_FOR_DISTRIBUTION":   "Approved and being prepared for distribution; it will go live shortly.",
+	"READY_FOR_SALE":                "Live on the App Store.",
+	"READY_FOR_DISTRIBUTION":        "Live and available for distribution.",
+	"REJECTED":                      "App Review rejected it; read the Resolution Center, fix the issues, and resubmit.",
+	"METADATA_REJECTED":             "App Review rejected the metadata only; edit it and resubmit without a new build.",
+	"INVALID_BINARY":                "The attached build is invalid; attach a different build.",
+	"DEVELOPER_REJECTED":            "You pulled this version from review; it is editable again.",
+	"REMOVED_FROM_SALE":             "Apple removed the app from sale.",
+	"DEVELOPER_REMOVED_FROM_SALE":   "You removed the app from sale.",
+	"REPLACED_WITH_NEW_VERSION":     "A newer version replaced this one before release.",
+	"NOT_APPLICABLE":                "No state applies to this item.",
+
+	// Phased release.
+	"INACTIVE": "Phased release is configured but starts when the version goes live.",
+	"ACTIVE":   "Rolling out to a growing share of automatic-update users over 7 days.",
+	"PAUSED":   "The rollout is paused; resume it or release to everyone.",
+}
+
+// explainState returns the guidance for a state value, or "" when unknown.
+func explainState(state string) string {
+	return stateExplanations[strings.ToUpper(strings.TrimSpace(state))]
+}
+
+// buildExplanations returns guidance for every known state shown in resp,
+// keyed by the state value.
+func buildExplanations(resp *dashboardResponse) map[string]string {
+	states := make([]string, 0)
+	if resp.Builds != nil && resp.Builds.Latest != nil {
+		states = append(states, resp.Builds.Latest.ProcessingState)
+	}
+	if resp.TestFlight != nil {
+		states = append(states, resp.TestFlight.BetaReviewState, resp.TestFlight.ExternalBuildState)
+	}
+	if resp.AppStore != nil {
+		states = append(states, resp.AppStore.State)
+	}
+	if resp.Review != nil {
+		states = append(states, resp.Review.State)
+	}
+	if resp.PhasedRelease != nil {
+		states = append(states, resp.PhasedRelease.State)
+	}
+
+	explanations := make(map[string]string)
+	for _, state := range states {
+		if explanation := explainState(state); explanation != "" {
+			explanations[strings.TrimSpace(state)] = explanation
+		}
+	}
+	if len(explanations) == 0 {
+		return nil
+	}
+	return explanations
+}
+
+// explainedState renders a state like prefixedState, appending its guidance
+// when --explain populated explanations.
+func explainedState(value string, explanations map[string]string) string {
+	rendered := prefixedState(value)
+	if explanation := explanations[strings.TrimSpace(value)]; explanation != "" {
+		rendered += " - " + explanation
+	}
+	return rendered
+}
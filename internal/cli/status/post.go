@@ -0,0 +1,130 @@
+package status
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	// statusPostAttempts is the total number of POST attempts, retrying on 5xx
+	// responses and transport errors.
+	statusPostAttempts = 3
+	// statusPostMaxResponseBodyBytes caps how much of an error body is echoed.
+	statusPostMaxResponseBodyBytes = 4096
+)
+
+var statusPostHTTPClient = func() *http.Client {
+	return &http.Client{Timeout: asc.ResolveTimeout()}
+}
+
+// statusPostRetryDelay is the base backoff between attempts; it doubles after
+// each retry.
+var statusPostRetryDelay = time.Second
+
+// postHeaderFlag collects repeated --post-header "Name: value" values.
+type postHeaderFlag []postHeader
+
+type postHeader struct {
+	name  string
+	value string
+}
+
+func (f *postHeaderFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	names := make([]string, 0, len(*f))
+	for _, header := range *f {
+		names = append(names, header.name)
+	}
+	return strings.Join(names, ",")
+}
+
+func (f *postHeaderFlag) Set(value string) error {
+	name, headerValue, ok := strings.Cut(value, ":")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" || strings.ContainsAny(name, " \t") {
+		return fmt.Errorf("expected \"Name: value\", got %q", value)
+	}
+	*f = append(*f, postHeader{name: name, value: strings.TrimSpace(headerValue)})
+	return nil
+}
+
+func validatePostURL(rawURL string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" || parsed.User != nil {
+		return fmt.Errorf("--post-to must be a valid https URL")
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("--post-to must use https")
+	}
+	return nil
+}
+
+// postStatusPayload POSTs body as JSON to target and returns the final HTTP
+// status code. 5xx responses and transport errors are retried with backoff;
+// any other non-2xx response fails immediately.
+func postStatusPayload(ctx context.Context, target string, headers postHeaderFlag, body []byte) (int, error) {
+	client := statusPostHTTPClient()
+	delay := statusPostRetryDelay
+
+	var lastErr error
+	for attempt := 1; attempt <= statusPostAttempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return 0, ctx.Err()
+			case <-timer.C:
+			}
+			delay *= 2
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+		if err != nil {
+			return 0, fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for _, header := range headers {
+			req.Header.Set(header.name, header.value)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return 0, ctx.Err()
+			}
+			lastErr = fmt.Errorf("failed to send: %w", err)
+			continue
+		}
+		respBody, readErr := io.ReadAll(io.LimitReader(resp.Body, statusPostMaxResponseBodyBytes))
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode < 300:
+			return resp.StatusCode, nil
+		case resp.StatusCode >= 500:
+			lastErr = unexpectedPostResponse(resp.StatusCode, respBody, readErr)
+		default:
+			return resp.StatusCode, unexpectedPostResponse(resp.StatusCode, respBody, readErr)
+		}
+	}
+	return 0, fmt.Errorf("%w (after %d attempts)", lastErr, statusPostAttempts)
+}
+
+func unexpectedPostResponse(statusCode int, body []byte, readErr error) error {
+	message := strings.TrimSpace(string(body))
+	if readErr != nil || message == "" {
+		return fmt.Errorf("unexpected response %d", statusCode)
+	}
+	return fmt.Errorf("unexpected response %d: %s", statusCode, message)
+}
@@ -0,0 +1,212 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const outputFormatSlack = "slack"
+
+// slackMaxHeaderLength is Slack's limit for header block text.
+const slackMaxHeaderLength = 150
+
+// slackPayload is a Slack Block Kit message suitable for an incoming webhook.
+type slackPayload struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type   string      `json:"type"`
+	Text   *slackText  `json:"text,omitempty"`
+	Fields []slackText `json:"fields,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackField struct {
+	label string
+	value string
+}
+
+// printSlackPayload writes the Block Kit message to stdout as JSON.
+func printSlackPayload(payload slackPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(body))
+	return err
+}
+
+// buildSlackPayload maps the dashboard to a header block followed by one
+// section block per dashboard section, mirroring the table renderer.
+func buildSlackPayload(resp *dashboardResponse) slackPayload {
+	summary := resp.Summary
+	if summary.Health == "" {
+		summary = buildStatusSummary(resp)
+	}
+
+	title := "Release status"
+	if resp.App != nil {
+		if name := strings.TrimSpace(resp.App.Name); name != "" {
+			title = "Release status: " + name
+		}
+	}
+
+	payload := slackPayload{
+		Text: fmt.Sprintf("%s (%s)", title, shared.OrNA(summary.Health)),
+		Blocks: []slackBlock{{
+			Type: "header",
+			Text: &slackText{Type: "plain_text", Text: truncateSlackText(title, slackMaxHeaderLength)},
+		}},
+	}
+
+	payload.Blocks = append(payload.Blocks, slackSection("Summary", []slackField{
+		{"Health", fmt.Sprintf("%s %s", slackHealthEmoji(summary.Health), shared.OrNA(summary.Health))},
+		{"Next action", shared.OrNA(summary.NextAction)},
+	}))
+
+	if len(summary.Blockers) > 0 {
+		lines := make([]string, 0, len(summary.Blockers))
+		for _, blocker := range summary.Blockers {
+			lines = append(lines, "• "+slackEscape(blocker))
+		}
+		payload.Blocks = append(payload.Blocks, slackBlock{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: "*Needs attention*\n" + strings.Join(lines, "\n")},
+		})
+	}
+
+	if resp.App != nil {
+		payload.Blocks = append(payload.Blocks, slackSection("App", []slackField{
+			{"ID", resp.App.ID},
+			{"Bundle ID", shared.OrNA(resp.App.BundleID)},
+		}))
+	}
+
+	if resp.Builds != nil {
+		fields := []slackField{{"Latest", "none"}}
+		if latest := resp.Builds.Latest; latest != nil {
+			fields = []slackField{
+				{"Version", fmt.Sprintf("%s (%s)", shared.OrNA(latest.Version), shared.OrNA(latest.BuildNumber))},
+				{"Processing state", shared.OrNA(latest.ProcessingState)},
+				{"Uploaded", formatDateWithRelative(latest.UploadedDate)},
+				{"Platform", shared.OrNA(latest.Platform)},
+			}
+		}
+		payload.Blocks = append(payload.Blocks, slackSection("Builds", fields))
+	}
+
+	if resp.TestFlight != nil {
+		payload.Blocks = append(payload.Blocks, slackSection("TestFlight", []slackField{
+			{"Beta review state", shared.OrNA(resp.TestFlight.BetaReviewState)},
+			{"External build state", shared.OrNA(resp.TestFlight.ExternalBuildState)},
+			{"Submitted", formatDateWithRelative(resp.TestFlight.SubmittedDate)},
+		}))
+	}
+
+	if resp.AppStore != nil {
+		payload.Blocks = append(payload.Blocks, slackSection("App Store", []slackField{
+			{"Version", shared.OrNA(resp.AppStore.Version)},
+			{"State", shared.OrNA(resp.AppStore.State)},
+			{"Platform", shared.OrNA(resp.AppStore.Platform)},
+			{"Created", formatDateWithRelative(resp.AppStore.CreatedDate)},
+		}))
+	}
+
+	if resp.Submission != nil {
+		payload.Blocks = append(payload.Blocks, slackSection("Submission", []slackField{
+			{"In flight", fmt.Sprintf("%t", resp.Submission.InFlight)},
+			{"Blocking issues", fmt.Sprintf("%d", len(resp.Submission.BlockingIssues))},
+		}))
+	}
+
+	if resp.Review != nil {
+		payload.Blocks = append(payload.Blocks, slackSection("Review", []slackField{
+			{"State", shared.OrNA(resp.Review.State)},
+			{"Submitted", formatDateWithRelative(resp.Review.SubmittedDate)},
+			{"Platform", shared.OrNA(resp.Review.Platform)},
+		}))
+	}
+
+	if resp.PhasedRelease != nil {
+		fields := []slackField{{"Configured", fmt.Sprintf("%t", resp.PhasedRelease.Configured)}}
+		if resp.PhasedRelease.Configured {
+			fields = append(fields,
+				slackField{"State", shared.OrNA(resp.PhasedRelease.State)},
+				slackField{"Progress", phasedReleaseProgressBar(resp.PhasedRelease)},
+			)
+		}
+		payload.Blocks = append(payload.Blocks, slackSection("Phased Release", fields))
+	}
+
+	if resp.Links != nil {
+		links := make([]string, 0, 3)
+		for _, link := range []slackField{
+			{"App Store Connect", resp.Links.AppStoreConnect},
+			{"TestFlight", resp.Links.TestFlight},
+			{"Review", resp.Links.Review},
+		} {
+			if url := strings.TrimSpace(link.value); url != "" {
+				links = append(links, fmt.Sprintf("<%s|%s>", url, link.label))
+			}
+		}
+		if len(links) > 0 {
+			payload.Blocks = append(payload.Blocks, slackBlock{
+				Type: "section",
+				Text: &slackText{Type: "mrkdwn", Text: "*Links*\n" + strings.Join(links, " · ")},
+			})
+		}
+	}
+
+	return payload
+}
+
+// slackSection renders a titled section block with one escaped field per label.
+func slackSection(title string, fields []slackField) slackBlock {
+	texts := make([]slackText, 0, len(fields))
+	for _, field := range fields {
+		texts = append(texts, slackText{Type: "mrkdwn", Text: fmt.Sprintf("*%s*\n%s", field.label, slackEscape(field.value))})
+	}
+	return slackBlock{
+		Type:   "section",
+		Text:   &slackText{Type: "mrkdwn", Text: fmt.Sprintf("*%s*", title)},
+		Fields: texts,
+	}
+}
+
+func slackHealthEmoji(health string) string {
+	switch strings.ToLower(strings.TrimSpace(health)) {
+	case "green":
+		return ":large_green_circle:"
+	case "yellow":
+		return ":large_yellow_circle:"
+	case "red":
+		return ":red_circle:"
+	default:
+		return ":white_circle:"
+	}
+}
+
+// slackEscape escapes the control characters Slack reserves in mrkdwn text.
+func slackEscape(value string) string {
+	return slackEscaper.Replace(value)
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func truncateSlackText(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,64 @@
+package status
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildSlackPayload_HeaderAndSections(t *testing.T) {
+	resp := &dashboardResponse{
+		App: &statusApp{ID: "app-1", Name: "My App", BundleID: "com.example.myapp"},
+		Summary: statusSummary{
+			Health:     "red",
+			NextAction: "Fix blockers",
+			Blockers:   []string{"Build <42> failed & needs attention"},
+		},
+		AppStore: &appStoreSection{Version: "1.2.3", State: "REJECTED", Platform: "IOS"},
+		Links: &linksSection{
+			AppStoreConnect: "https://appstoreconnect.apple.com/apps/app-1/appstore",
+		},
+	}
+
+	payload := buildSlackPayload(resp)
+
+	if payload.Text != "Release status: My App (red)" {
+		t.Fatalf("unexpected fallback text %q", payload.Text)
+	}
+	if len(payload.Blocks) != 6 {
+		t.Fatalf("expected header, summary, blockers, app, app store and links blocks, got %d: %+v", len(payload.Blocks), payload.Blocks)
+	}
+	header := payload.Blocks[0]
+	if header.Type != "header" || header.Text == nil || header.Text.Type != "plain_text" || header.Text.Text != "Release status: My App" {
+		t.Fatalf("unexpected header block %+v", header)
+	}
+
+	summary := payload.Blocks[1]
+	if summary.Type != "section" || summary.Text.Text != "*Summary*" || len(summary.Fields) != 2 {
+		t.Fatalf("unexpected summary block %+v", summary)
+	}
+	if !strings.Contains(summary.Fields[0].Text, ":red_circle: red") {
+		t.Fatalf("expected health emoji in summary, got %q", summary.Fields[0].Text)
+	}
+
+	blockers := payload.Blocks[2]
+	if blockers.Text == nil || !strings.Contains(blockers.Text.Text, "Build &lt;42&gt; failed &amp; needs attention") {
+		t.Fatalf("expected escaped blocker text, got %+v", blockers.Text)
+	}
+
+	links := payload.Blocks[len(payload.Blocks)-1]
+	if links.Text == nil || links.Text.Text != "*Links*\n<https://appstoreconnect.apple.com/apps/app-1/appstore|App Store Connect>" {
+		t.Fatalf("unexpected links block %+v", links.Text)
+	}
+}
+
+func TestBuildSlackPayload_TruncatesLongHeader(t *testing.T) {
+	resp := &dashboardResponse{
+		App:     &statusApp{ID: "app-1", Name: strings.Repeat("a", 200)},
+		Summary: statusSummary{Health: "green"},
+	}
+
+	header := buildSlackPayload(resp).Blocks[0]
+	if got := len([]rune(header.Text.Text)); got != slackMaxHeaderLength {
+		t.Fatalf("expected header truncated to %d runes, got %d", slackMaxHeaderLength, got)
+	}
+}
@@ -5,10 +5,11 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -38,6 +39,7 @@ type dashboardResponse struct {
 	Review        *reviewSection        `json:"review,omitempty"`
 	PhasedRelease *phasedReleaseSection `json:"phasedRelease,omitempty"`
 	Links         *linksSection         `json:"links,omitempty"`
+	Explanations  map[string]string     `json:"explanations,omitempty"`
 }
 
 type statusApp struct {
@@ -107,13 +109,32 @@ type linksSection struct {
 	Review          string `json:"review"`
 }
 
+// buildLinksSection returns the App Store Connect web UI links for an app.
+func buildLinksSection(appID string) *linksSection {
+	link := func(section string) string {
+		url, err := shared.AppStoreConnectAppURL(appID, section)
+		if err != nil {
+			return ""
+		}
+		return url
+	}
+	return &linksSection{
+		AppStoreConnect: link(shared.AppSectionOverview),
+		TestFlight:      link(shared.AppSectionTestFlight),
+		Review:          link(shared.AppSectionReview),
+	}
+}
+
 type relationshipReference struct {
 	Data asc.ResourceData `json:"data"`
 }
 
+// defaultConcurrency is the default number of dashboard sections fetched in parallel.
+const defaultConcurrency = 3
+
 type sectionTask struct {
 	name string
-	run  func() error
+	run  func(ctx context.Context) error
 }
 
 var allowedIncludes = []string{
@@ -133,7 +154,13 @@ func StatusCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
 	include := fs.String("include", "", "Comma-separated sections: app,builds,testflight,appstore,submission,review,phased-release,links")
-	output := shared.BindOutputFlags(fs)
+	concurrency := fs.Int("concurrency", defaultConcurrency, "Maximum number of dashboard sections fetched in parallel (>= 1)")
+	postTo := fs.String("post-to", "", "POST the status payload to this https URL after printing (Slack Block Kit with --output slack, JSON otherwise)")
+	explain := fs.Bool("explain", false, "Add a one-line explanation to each state (explanations object in JSON)")
+	var postHeaders postHeaderFlag
+	fs.Var(&postHeaders, "post-header", "Extra header for --post-to as \"Name: value\" (repeatable)")
+	setOutput := shared.BindSetOutputFlag(fs)
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, csv, slack")
 
 	return &ffcli.Command{
 		Name:       "status",
@@ -144,10 +171,20 @@ func StatusCommand() *ffcli.Command {
 This command aggregates release signals into one deterministic payload for CI,
 agents, and human review.
 
+With --set-output, key results are appended to $GITHUB_OUTPUT as name=value
+step outputs: health, next_action, blocker_count, latest_build_id,
+latest_build_number, app_store_version, app_store_state, and review_state
+(sections that were not fetched are omitted).
+
 Examples:
   asc status --app "123456789"
   asc status --app "123456789" --include builds,testflight,submission
-  asc status --app "123456789" --output table`,
+  asc status --app "123456789" --output table
+  asc status --app "123456789" --output table --explain
+  asc status --app "123456789" --concurrency 1
+  asc status --app "123456789" --output slack --post-to "https://hooks.slack.com/services/..."
+  asc status --app "123456789" --post-to "https://hooks.example.com/release" --post-header "Authorization: Bearer $TOKEN"
+  asc status --app "123456789" --set-output`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -166,8 +203,28 @@ Examples:
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
+			if *concurrency < 1 {
+				return shared.UsageError("--concurrency must be >= 1")
+			}
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "json", "table", "markdown", "yaml", "csv", outputFormatSlack)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			target := strings.TrimSpace(*postTo)
+			if target != "" {
+				if err := validatePostURL(target); err != nil {
+					return shared.UsageError(err.Error())
+				}
+			} else if len(postHeaders) > 0 {
+				return shared.UsageError("--post-header requires --post-to")
+			}
+			githubOutputPath, err := shared.ResolveGitHubOutputPath(*setOutput)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
-			client, err := shared.GetASCClient()
+			// Dashboard sections fetch overlapping resources in parallel; share responses.
+			client, err := shared.GetASCClientWithOptions(asc.WithResponseCache())
 			if err != nil {
 				return fmt.Errorf("status: %w", err)
 			}
@@ -175,22 +232,81 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := collectDashboard(requestCtx, client, resolvedAppID, includes)
+			resp, err := collectDashboard(requestCtx, client, resolvedAppID, includes, *concurrency)
 			if err != nil {
 				return fmt.Errorf("status: %w", err)
 			}
+			if *explain {
+				resp.Explanations = buildExplanations(resp)
+			}
 
-			return shared.PrintOutputWithRenderers(
+			var payload any = resp
+			var slackMessage slackPayload
+			if normalizedOutput == outputFormatSlack {
+				slackMessage = buildSlackPayload(resp)
+				payload = slackMessage
+			}
+			if err := shared.PrintOutputWithRenderers(
 				resp,
-				*output.Output,
+				normalizedOutput,
 				*output.Pretty,
 				func() error { renderTable(resp); return nil },
 				func() error { renderMarkdown(resp); return nil },
-			)
+				shared.FormatRenderer{Format: outputFormatSlack, Render: func() error { return printSlackPayload(slackMessage) }},
+			); err != nil {
+				return err
+			}
+			if err := shared.WriteGitHubOutputs(githubOutputPath, statusGitHubOutputs(resp)); err != nil {
+				return fmt.Errorf("status: %w", err)
+			}
+			if target == "" {
+				return nil
+			}
+
+			body, err := json.Marshal(payload)
+			if err != nil {
+				return fmt.Errorf("status: --post-to: failed to marshal payload: %w", err)
+			}
+			statusCode, err := postStatusPayload(requestCtx, target, postHeaders, body)
+			if err != nil {
+				return fmt.Errorf("status: --post-to: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "Posted status payload: %d %s\n", statusCode, http.StatusText(statusCode))
+			return nil
 		},
 	}
 }
 
+// statusGitHubOutputs returns the step outputs written by --set-output.
+func statusGitHubOutputs(resp *dashboardResponse) []shared.GitHubOutput {
+	summary := resp.Summary
+	if summary.Health == "" {
+		summary = buildStatusSummary(resp)
+	}
+
+	outputs := []shared.GitHubOutput{
+		{Name: "health", Value: summary.Health},
+		{Name: "next_action", Value: summary.NextAction},
+		{Name: "blocker_count", Value: strconv.Itoa(len(summary.Blockers))},
+	}
+	if resp.Builds != nil && resp.Builds.Latest != nil {
+		outputs = append(outputs,
+			shared.GitHubOutput{Name: "latest_build_id", Value: resp.Builds.Latest.ID},
+			shared.GitHubOutput{Name: "latest_build_number", Value: resp.Builds.Latest.BuildNumber},
+		)
+	}
+	if resp.AppStore != nil {
+		outputs = append(outputs,
+			shared.GitHubOutput{Name: "app_store_version", Value: resp.AppStore.Version},
+			shared.GitHubOutput{Name: "app_store_state", Value: resp.AppStore.State},
+		)
+	}
+	if resp.Review != nil {
+		outputs = append(outputs, shared.GitHubOutput{Name: "review_state", Value: resp.Review.State})
+	}
+	return outputs
+}
+
 func parseInclude(value string) (includeSet, error) {
 	parts := shared.SplitCSV(strings.ToLower(strings.TrimSpace(value)))
 	if len(parts) == 0 {
@@ -233,7 +349,7 @@ func parseInclude(value string) (includeSet, error) {
 	return includes, nil
 }
 
-func collectDashboard(ctx context.Context, client *asc.Client, appID string, includes includeSet) (*dashboardResponse, error) {
+func collectDashboard(ctx context.Context, client *asc.Client, appID string, includes includeSet, concurrency int) (*dashboardResponse, error) {
 	resp := &dashboardResponse{}
 	if includes.app {
 		appResp, err := client.GetApp(ctx, appID)
@@ -248,11 +364,7 @@ func collectDashboard(ctx context.Context, client *asc.Client, appID string, inc
 	}
 
 	if includes.links {
-		resp.Links = &linksSection{
-			AppStoreConnect: fmt.Sprintf("https://appstoreconnect.apple.com/apps/%s", appID),
-			TestFlight:      fmt.Sprintf("https://appstoreconnect.apple.com/apps/%s/testflight/ios", appID),
-			Review:          fmt.Sprintf("https://appstoreconnect.apple.com/apps/%s/appstore/review", appID),
-		}
+		resp.Links = buildLinksSection(appID)
 	}
 
 	var tasks []sectionTask
@@ -260,7 +372,7 @@ func collectDashboard(ctx context.Context, client *asc.Client, appID string, inc
 	if includes.builds || includes.testflight {
 		tasks = append(tasks, sectionTask{
 			name: "builds/testflight",
-			run: func() error {
+			run: func(ctx context.Context) error {
 				return fillBuildsAndTestFlight(ctx, client, appID, includes, resp)
 			},
 		})
@@ -268,7 +380,7 @@ func collectDashboard(ctx context.Context, client *asc.Client, appID string, inc
 	if includes.appstore || includes.phasedRelease {
 		tasks = append(tasks, sectionTask{
 			name: "appstore/phased-release",
-			run: func() error {
+			run: func(ctx context.Context) error {
 				return fillAppStoreAndPhasedRelease(ctx, client, appID, includes, resp)
 			},
 		})
@@ -276,13 +388,13 @@ func collectDashboard(ctx context.Context, client *asc.Client, appID string, inc
 	if includes.submission || includes.review {
 		tasks = append(tasks, sectionTask{
 			name: "submission/review",
-			run: func() error {
+			run: func(ctx context.Context) error {
 				return fillSubmissionAndReview(ctx, client, appID, includes, resp)
 			},
 		})
 	}
 
-	if err := runTasks(tasks, 3); err != nil {
+	if err := runTasks(ctx, tasks, concurrency); err != nil {
 		return nil, err
 	}
 	resp.Summary = buildStatusSummary(resp)
@@ -290,40 +402,15 @@ func collectDashboard(ctx context.Context, client *asc.Client, appID string, inc
 	return resp, nil
 }
 
-func runTasks(tasks []sectionTask, limit int) error {
-	if len(tasks) == 0 {
+// runTasks runs the dashboard section tasks with at most limit in flight; the
+// first failure cancels the rest.
+func runTasks(ctx context.Context, tasks []sectionTask, limit int) error {
+	return shared.RunBounded(ctx, len(tasks), limit, func(ctx context.Context, index int) error {
+		if err := tasks[index].run(ctx); err != nil {
+			return fmt.Errorf("%s: %w", tasks[index].name, err)
+		}
 		return nil
-	}
-
-	if limit < 1 {
-		limit = 1
-	}
-
-	var wg sync.WaitGroup
-	sem := make(chan struct{}, limit)
-	errCh := make(chan error, len(tasks))
-
-	for _, task := range tasks {
-		current := task
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			sem <- struct{}{}
-			defer func() { <-sem }()
-
-			if err := current.run(); err != nil {
-				errCh <- fmt.Errorf("%s: %w", current.name, err)
-			}
-		}()
-	}
-
-	wg.Wait()
-	close(errCh)
-
-	for err := range errCh {
-		return err
-	}
-	return nil
+	})
 }
 
 func fillBuildsAndTestFlight(ctx context.Context, client *asc.Client, appID string, includes includeSet, resp *dashboardResponse) error {
@@ -859,7 +946,7 @@ func renderDashboard(resp *dashboardResponse, markdown bool) {
 				[]string{"latest.id", resp.Builds.Latest.ID},
 				[]string{"latest.version", shared.OrNA(resp.Builds.Latest.Version)},
 				[]string{"latest.buildNumber", shared.OrNA(resp.Builds.Latest.BuildNumber)},
-				[]string{"latest.processingState", prefixedState(resp.Builds.Latest.ProcessingState)},
+				[]string{"latest.processingState", explainedState(resp.Builds.Latest.ProcessingState, resp.Explanations)},
 				[]string{"latest.uploadedDate", formatDateWithRelative(resp.Builds.Latest.UploadedDate)},
 				[]string{"latest.platform", shared.OrNA(resp.Builds.Latest.Platform)},
 			)
@@ -870,8 +957,8 @@ func renderDashboard(resp *dashboardResponse, markdown bool) {
 	if resp.TestFlight != nil {
 		shared.RenderSection("TestFlight", []string{"field", "value"}, [][]string{
 			{"latestDistributedBuildId", shared.OrNA(resp.TestFlight.LatestDistributedBuildID)},
-			{"betaReviewState", prefixedState(resp.TestFlight.BetaReviewState)},
-			{"externalBuildState", prefixedState(resp.TestFlight.ExternalBuildState)},
+			{"betaReviewState", explainedState(resp.TestFlight.BetaReviewState, resp.Explanations)},
+			{"externalBuildState", explainedState(resp.TestFlight.ExternalBuildState, resp.Explanations)},
 			{"submittedDate", formatDateWithRelative(resp.TestFlight.SubmittedDate)},
 		}, markdown)
 	}
@@ -880,7 +967,7 @@ func renderDashboard(resp *dashboardResponse, markdown bool) {
 		shared.RenderSection("App Store", []string{"field", "value"}, [][]string{
 			{"versionId", shared.OrNA(resp.AppStore.VersionID)},
 			{"version", shared.OrNA(resp.AppStore.Version)},
-			{"state", prefixedState(resp.AppStore.State)},
+			{"state", explainedState(resp.AppStore.State, resp.Explanations)},
 			{"platform", shared.OrNA(resp.AppStore.Platform)},
 			{"createdDate", formatDateWithRelative(resp.AppStore.CreatedDate)},
 		}, markdown)
@@ -900,7 +987,7 @@ func renderDashboard(resp *dashboardResponse, markdown bool) {
 	if resp.Review != nil {
 		shared.RenderSection("Review", []string{"field", "value"}, [][]string{
 			{"latestSubmissionId", shared.OrNA(resp.Review.LatestSubmissionID)},
-			{"state", prefixedState(resp.Review.State)},
+			{"state", explainedState(resp.Review.State, resp.Explanations)},
 			{"submittedDate", formatDateWithRelative(resp.Review.SubmittedDate)},
 			{"platform", shared.OrNA(resp.Review.Platform)},
 		}, markdown)
@@ -914,7 +1001,7 @@ func renderDashboard(resp *dashboardResponse, markdown bool) {
 		shared.RenderSection("Phased Release", []string{"field", "value"}, [][]string{
 			{"configured", configured},
 			{"id", shared.OrNA(resp.PhasedRelease.ID)},
-			{"state", prefixedState(resp.PhasedRelease.State)},
+			{"state", explainedState(resp.PhasedRelease.State, resp.Explanations)},
 			{"startDate", formatDateWithRelative(resp.PhasedRelease.StartDate)},
 			{"currentDayNumber", fmt.Sprintf("%d", resp.PhasedRelease.CurrentDayNumber)},
 			{"totalPauseDuration", fmt.Sprintf("%d", resp.PhasedRelease.TotalPauseDuration)},
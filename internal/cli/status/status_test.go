@@ -1,6 +1,8 @@
 package status
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -304,3 +306,65 @@ func TestFormatDateWithRelative(t *testing.T) {
 		t.Fatalf("unexpected relative time output %q", got)
 	}
 }
+
+func TestRunTasks_RespectsConcurrencyLimit(t *testing.T) {
+	var running, peak atomic.Int32
+	tasks := make([]sectionTask, 0, 6)
+	for range 6 {
+		tasks = append(tasks, sectionTask{
+			name: "task",
+			run: func(ctx context.Context) error {
+				current := running.Add(1)
+				for {
+					previous := peak.Load()
+					if current <= previous || peak.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				running.Add(-1)
+				return nil
+			},
+		})
+	}
+
+	if err := runTasks(context.Background(), tasks, 2); err != nil {
+		t.Fatalf("runTasks error: %v", err)
+	}
+	if got := peak.Load(); got > 2 {
+		t.Fatalf("expected at most 2 concurrent tasks, got %d", got)
+	}
+}
+
+func TestBuildExplanations_CoversShownStates(t *testing.T) {
+	resp := &dashboardResponse{
+		Builds:   &buildsSection{Latest: &latestBuild{ProcessingState: "VALID"}},
+		AppStore: &appStoreSection{State: "WAITING_FOR_REVIEW"},
+		Review:   &reviewSection{State: "UNRESOLVED_ISSUES"},
+		PhasedRelease: &phasedReleaseSection{
+			State: "SOMETHING_NEW",
+		},
+	}
+
+	explanations := buildExplanations(resp)
+
+	for _, state := range []string{"VALID", "WAITING_FOR_REVIEW", "UNRESOLVED_ISSUES"} {
+		if explanations[state] == "" {
+			t.Fatalf("expected explanation for %s, got %v", state, explanations)
+		}
+	}
+	if _, ok := explanations["SOMETHING_NEW"]; ok {
+		t.Fatalf("did not expect explanation for unknown state, got %v", explanations)
+	}
+}
+
+func TestExplainedState(t *testing.T) {
+	explanations := map[string]string{"IN_REVIEW": "App Review is looking at it now."}
+
+	if got, want := explainedState("IN_REVIEW", explanations), "[~] IN_REVIEW - App Review is looking at it now."; got != want {
+		t.Fatalf("explainedState() = %q, want %q", got, want)
+	}
+	if got, want := explainedState("IN_REVIEW", nil), "[~] IN_REVIEW"; got != want {
+		t.Fatalf("explainedState() without explanations = %q, want %q", got, want)
+	}
+}
@@ -174,7 +174,7 @@ Examples:
 					pageCtx, pageCancel := shared.ContextWithTimeout(ctx)
 					defer pageCancel()
 					return client.GetSubscriptionPricePoints(pageCtx, id, asc.WithSubscriptionPricePointsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("subscriptions price-points list: %w", err)
 				}
@@ -306,7 +306,7 @@ Examples:
 					pageCtx, pageCancel := shared.ContextWithTimeout(ctx)
 					defer pageCancel()
 					return client.GetSubscriptionPricePointEqualizations(pageCtx, id, asc.WithSubscriptionPricePointsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if pErr != nil {
 					return fmt.Errorf("subscriptions price-points equalizations: %w", pErr)
 				}
@@ -99,7 +99,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionPromotionalOffers(ctx, id, asc.WithSubscriptionPromotionalOffersNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("subscriptions promotional-offers list: %w", err)
 				}
@@ -403,7 +403,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionPromotionalOfferPrices(ctx, id, asc.WithSubscriptionPromotionalOfferPricesNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("subscriptions promotional-offers prices: %w", err)
 				}
@@ -29,6 +29,7 @@ Examples:
   asc testflight beta-groups list --global --internal
   asc testflight beta-groups create --app "APP_ID" --name "Beta Testers"
   asc testflight beta-groups create --app "APP_ID" --name "Internal Testers" --internal
+  asc testflight beta-groups add-builds --group "GROUP_ID" --build "BUILD_ID"
   asc testflight beta-groups app get --group-id "GROUP_ID"
   asc testflight beta-groups beta-recruitment-criteria get --group-id "GROUP_ID"
   asc testflight beta-groups beta-recruitment-criterion-compatible-build-check get --group-id "GROUP_ID"`,
@@ -44,6 +45,7 @@ Examples:
 			BetaGroupsUpdateCommand(),
 			BetaGroupsAddTestersCommand(),
 			BetaGroupsRemoveTestersCommand(),
+			BetaGroupsAddBuildsCommand(),
 			BetaGroupsRelationshipsCommand(),
 			BetaGroupsDeleteCommand(),
 		},
@@ -146,6 +148,7 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return client.ListBetaGroups(ctx, asc.WithBetaGroupsNextURL(nextURL))
 						},
+						shared.PaginateOptions()...,
 					)
 					if err != nil {
 						return fmt.Errorf("beta-groups list: %w", err)
@@ -176,6 +179,7 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL))
 						},
+						shared.PaginateOptions()...,
 					)
 					if err != nil {
 						return fmt.Errorf("beta-groups list: %w", err)
@@ -194,7 +198,7 @@ Examples:
 					}
 					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL))
-					})
+					}, shared.PaginateOptions()...)
 					if err != nil {
 						return fmt.Errorf("beta-groups list: %w", err)
 					}
@@ -228,6 +232,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("beta-groups list: %w", err)
@@ -590,6 +595,61 @@ Examples:
 	}
 }
 
+// BetaGroupsAddBuildsCommand returns the beta groups add-builds subcommand.
+func BetaGroupsAddBuildsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("add-builds", flag.ExitOnError)
+
+	group := fs.String("group", "", "Beta group ID")
+	build := fs.String("build", "", "Build ID(s), comma-separated")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "add-builds",
+		ShortUsage: "asc testflight beta-groups add-builds --group \"GROUP_ID\" --build \"BUILD_ID[,BUILD_ID...]\"",
+		ShortHelp:  "Add builds to a beta group.",
+		LongHelp: `Add builds to a beta group.
+
+Examples:
+  asc testflight beta-groups add-builds --group "GROUP_ID" --build "BUILD_ID"
+  asc testflight beta-groups add-builds --group "GROUP_ID" --build "BUILD_ID1,BUILD_ID2"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			groupID := strings.TrimSpace(*group)
+			if groupID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --group is required")
+				return flag.ErrHelp
+			}
+
+			buildIDs := shared.SplitCSV(*build)
+			if len(buildIDs) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --build is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("beta-groups add-builds: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.AddBuildsToBetaGroup(requestCtx, groupID, buildIDs); err != nil {
+				return fmt.Errorf("beta-groups add-builds: failed to add builds: %w", err)
+			}
+
+			result := &asc.BetaGroupBuildsUpdateResult{
+				GroupID:  groupID,
+				BuildIDs: buildIDs,
+				Action:   "added",
+			}
+
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
 // BetaGroupsRemoveTestersCommand returns the beta groups remove-testers subcommand.
 func BetaGroupsRemoveTestersCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("remove-testers", flag.ExitOnError)
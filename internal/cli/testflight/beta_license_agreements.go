@@ -100,7 +100,7 @@ Examples:
 				}
 				agreements, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBetaLicenseAgreements(ctx, asc.WithBetaLicenseAgreementsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("beta-license-agreements list: %w", err)
 				}
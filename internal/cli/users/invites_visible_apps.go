@@ -104,7 +104,7 @@ Examples:
 
 				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetUserInvitationVisibleApps(ctx, idValue, asc.WithUserInvitationVisibleAppsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("users invites visible-apps list: %w", err)
 				}
@@ -109,7 +109,7 @@ Examples:
 
 				users, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetUsers(ctx, asc.WithUsersNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("users list: %w", err)
 				}
@@ -472,7 +472,7 @@ Examples:
 
 				invites, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetUserInvitations(ctx, asc.WithUserInvitationsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("users invites list: %w", err)
 				}
@@ -0,0 +1,214 @@
+package validate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+type validateAllOptions struct {
+	AppID     string
+	Version   string
+	VersionID string
+	Platform  string
+	Strict    bool
+	Output    string
+	Pretty    bool
+	Ignore    validation.IgnoreRules
+	// GitHubOutputPath receives step outputs when --set-output is enabled.
+	GitHubOutputPath string
+	// Concurrency bounds the parallel screenshot fetches of the App Store
+	// version checks and the per-product fetches of the IAP and subscription
+	// checks.
+	Concurrency int
+}
+
+// ValidateAllCommand returns the asc validate all subcommand.
+func ValidateAllCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("all", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	version := fs.String("version", "", "App Store version string")
+	versionID := fs.String("version-id", "", "App Store version ID")
+	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
+	concurrency := bindConcurrencyFlag(fs, "Maximum number of localizations, in-app purchases, or subscriptions fetched in parallel (>= 1)")
+	ignoreFile := bindIgnoreFileFlag(fs)
+	setOutput := shared.BindSetOutputFlag(fs)
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, junit, github")
+
+	return &ffcli.Command{
+		Name:       "all",
+		ShortUsage: "asc validate all --app \"APP_ID\" (--version-id \"VERSION_ID\" | --version \"VERSION\") [flags]",
+		ShortHelp:  "Run every validator and merge the results.",
+		LongHelp: `Run every validator and merge the results into one report.
+
+Runs the App Store version checks (asc validate), IAP checks (asc validate
+iap), and subscription checks (asc validate subscriptions), and reports each
+under its own section. The combined summary adds up every section, so the
+command exits with code 6 if any domain has blocking issues.
+
+Examples:
+  asc validate all --app "APP_ID" --version "1.0.0" --platform IOS
+  asc validate all --app "APP_ID" --version-id "VERSION_ID" --output table
+  asc validate all --app "APP_ID" --version-id "VERSION_ID" --strict --ignore-file .asc-validate-ignore
+  asc validate all --app "APP_ID" --version-id "VERSION_ID" --output junit > validate.xml
+  asc validate all --app "APP_ID" --version-id "VERSION_ID" --output github --set-output
+
+With --output junit or github, findings from every domain are reported in one
+test suite or annotation stream. With --set-output, ready, error_count,
+warning_count, and blocking_count for the combined summary are appended to
+$GITHUB_OUTPUT as name=value step outputs.`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedVersion := strings.TrimSpace(*version)
+			trimmedVersionID := strings.TrimSpace(*versionID)
+			if trimmedVersion == "" && trimmedVersionID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version or --version-id is required")
+				return flag.ErrHelp
+			}
+			if trimmedVersion != "" && trimmedVersionID != "" {
+				return shared.UsageError("--version and --version-id are mutually exclusive")
+			}
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "json", "table", "markdown", "yaml", outputFormatJUnit, outputFormatGitHub)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			githubOutputPath, err := shared.ResolveGitHubOutputPath(*setOutput)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			var normalizedPlatform string
+			if strings.TrimSpace(*platform) != "" {
+				value, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+				if err != nil {
+					return fmt.Errorf("validate all: %w", err)
+				}
+				normalizedPlatform = value
+			}
+
+			if *concurrency < 1 {
+				return shared.UsageError("--concurrency must be >= 1")
+			}
+
+			ignoreRules, err := loadIgnoreRules(*ignoreFile)
+			if err != nil {
+				return fmt.Errorf("validate all: %w", err)
+			}
+
+			return runValidateAll(ctx, validateAllOptions{
+				AppID:            resolvedAppID,
+				Version:          trimmedVersion,
+				VersionID:        trimmedVersionID,
+				Platform:         normalizedPlatform,
+				Strict:           *strict,
+				Output:           normalizedOutput,
+				Pretty:           *output.Pretty,
+				Ignore:           ignoreRules,
+				GitHubOutputPath: githubOutputPath,
+				Concurrency:      *concurrency,
+			})
+		},
+	}
+}
+
+func runValidateAll(ctx context.Context, opts validateAllOptions) error {
+	client, err := clientFactory()
+	if err != nil {
+		return fmt.Errorf("validate all: %w", err)
+	}
+
+	appStoreReport, err := buildAppStoreReport(ctx, client, validateOptions{
+		AppID:       opts.AppID,
+		Version:     opts.Version,
+		VersionID:   opts.VersionID,
+		Platform:    opts.Platform,
+		Strict:      opts.Strict,
+		Ignore:      opts.Ignore,
+		Concurrency: opts.Concurrency,
+	})
+	if err != nil {
+		return fmt.Errorf("validate all: app store: %w", err)
+	}
+
+	iapReport, err := ValidateIAPWithClient(ctx, client, IAPOptions{
+		AppID:       opts.AppID,
+		Strict:      opts.Strict,
+		Ignore:      opts.Ignore,
+		Concurrency: opts.Concurrency,
+	})
+	if err != nil {
+		return fmt.Errorf("validate all: iap: %w", err)
+	}
+
+	subscriptionsReport, err := ValidateSubscriptionsWithClient(ctx, client, SubscriptionsOptions{
+		AppID:       opts.AppID,
+		Strict:      opts.Strict,
+		Ignore:      opts.Ignore,
+		Concurrency: opts.Concurrency,
+	})
+	if err != nil {
+		return fmt.Errorf("validate all: subscriptions: %w", err)
+	}
+
+	report := validation.CombineReports(appStoreReport, iapReport, subscriptionsReport, opts.Strict)
+	switch opts.Output {
+	case outputFormatJUnit:
+		if err := printJUnitReport(flattenAllReport(report)); err != nil {
+			return fmt.Errorf("validate all: %w", err)
+		}
+	case outputFormatGitHub:
+		if err := printGitHubAnnotations(flattenAllReport(report).Checks, report.Strict); err != nil {
+			return fmt.Errorf("validate all: %w", err)
+		}
+	default:
+		if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+			return err
+		}
+	}
+
+	if err := shared.WriteGitHubOutputs(opts.GitHubOutputPath, summaryGitHubOutputs(report.Summary)); err != nil {
+		return fmt.Errorf("validate all: %w", err)
+	}
+
+	if report.Summary.Blocking > 0 {
+		return shared.NewBlockingIssuesError(fmt.Errorf("validate all: found %d blocking issue(s)", report.Summary.Blocking))
+	}
+
+	return nil
+}
+
+// flattenAllReport merges every domain's checks into one report so the JUnit
+// and GitHub formatters can render a combined run.
+func flattenAllReport(report validation.AllReport) validation.Report {
+	flat := validation.Report{
+		AppID:   report.AppID,
+		Summary: report.Summary,
+		Strict:  report.Strict,
+	}
+	if report.AppStore != nil {
+		flat.VersionID = report.AppStore.VersionID
+		flat.VersionString = report.AppStore.VersionString
+		flat.Platform = report.AppStore.Platform
+	}
+	for _, section := range report.Sections() {
+		flat.Checks = append(flat.Checks, section.Checks...)
+	}
+	return flat
+}
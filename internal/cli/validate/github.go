@@ -0,0 +1,93 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const outputFormatGitHub = "github"
+
+// printGitHubAnnotations writes one GitHub Actions workflow command per
+// finding so issues surface as annotations on the workflow run.
+func printGitHubAnnotations(checks []validation.CheckResult, strict bool) error {
+	return writeGitHubAnnotations(os.Stdout, checks, strict)
+}
+
+// writeGitHubAnnotations maps blocking findings to ::error, remaining warnings
+// to ::warning, and info findings to ::notice. Ignored checks are skipped.
+func writeGitHubAnnotations(w io.Writer, checks []validation.CheckResult, strict bool) error {
+	for _, check := range checks {
+		if check.Ignored {
+			continue
+		}
+		command := githubAnnotationCommand(check, strict)
+		if _, err := fmt.Fprintf(w, "::%s title=%s::%s\n",
+			command,
+			escapeGitHubProperty(check.ID),
+			escapeGitHubData(githubAnnotationMessage(check)),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func githubAnnotationCommand(check validation.CheckResult, strict bool) string {
+	if isBlockingCheck(check, strict) {
+		return "error"
+	}
+	if check.Severity == validation.SeverityWarning {
+		return "warning"
+	}
+	return "notice"
+}
+
+func githubAnnotationMessage(check validation.CheckResult) string {
+	var details []string
+	if check.Locale != "" {
+		details = append(details, "locale "+check.Locale)
+	}
+	if check.Field != "" {
+		details = append(details, "field "+check.Field)
+	}
+	if check.ProductID != "" {
+		details = append(details, "product "+check.ProductID)
+	} else if check.ResourceID != "" {
+		details = append(details, strings.TrimSpace(check.ResourceType+" "+check.ResourceID))
+	}
+
+	message := check.Message
+	if len(details) > 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(details, ", "))
+	}
+	if check.Remediation != "" {
+		message += "\n" + check.Remediation
+	}
+	return message
+}
+
+// summaryGitHubOutputs returns the step outputs written by --set-output.
+func summaryGitHubOutputs(summary validation.Summary) []shared.GitHubOutput {
+	return []shared.GitHubOutput{
+		{Name: "ready", Value: strconv.FormatBool(summary.Blocking == 0)},
+		{Name: "error_count", Value: strconv.Itoa(summary.Errors)},
+		{Name: "warning_count", Value: strconv.Itoa(summary.Warnings)},
+		{Name: "blocking_count", Value: strconv.Itoa(summary.Blocking)},
+	}
+}
+
+// escapeGitHubData escapes workflow command message data.
+func escapeGitHubData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+// escapeGitHubProperty escapes workflow command property values.
+func escapeGitHubProperty(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(value)
+}
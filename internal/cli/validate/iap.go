@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -13,11 +14,24 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
 )
 
+// IAPOptions selects what ValidateIAPWithClient checks.
+type IAPOptions struct {
+	AppID string
+	// ProductID limits validation to one IAP when set.
+	ProductID string
+	Strict    bool
+	Ignore    validation.IgnoreRules
+	// Concurrency bounds how many IAPs have their pricing, screenshot, and
+	// localizations fetched in parallel. Values below 1 check one at a time.
+	Concurrency int
+}
+
 type validateIAPOptions struct {
-	AppID  string
-	Strict bool
+	IAPOptions
 	Output string
 	Pretty bool
+	// GitHubOutputPath receives step outputs when --set-output is enabled.
+	GitHubOutputPath string
 }
 
 // ValidateIAPCommand returns the asc validate iap subcommand.
@@ -25,22 +39,37 @@ func ValidateIAPCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("iap", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	productID := fs.String("product-id", "", "Only validate the IAP with this product ID")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
-	output := shared.BindOutputFlags(fs)
+	concurrency := bindConcurrencyFlag(fs, "Maximum number of in-app purchases checked in parallel (>= 1)")
+	ignoreFile := bindIgnoreFileFlag(fs)
+	setOutput := shared.BindSetOutputFlag(fs)
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, csv, github")
 
 	return &ffcli.Command{
 		Name:       "iap",
 		ShortUsage: "asc validate iap --app \"APP_ID\" [flags]",
-		ShortHelp:  "Validate IAP review readiness (warning-only by default).",
+		ShortHelp:  "Validate IAP review readiness.",
 		LongHelp: `Validate review readiness for in-app purchases.
 
-This command is conservative: it emits warnings for IAPs that look unsubmitted or
-need action, but it does not block by default (use --strict for CI).
+IAPs that look unsubmitted, need action, lack a review screenshot while still
+needing review, or are not available in any territory produce warnings, which
+do not block by default (use --strict for CI). An IAP with no price set, or
+without a display name and description localization (including the app's
+primary locale), is a blocking error.
 
 Examples:
   asc validate iap --app "APP_ID"
+  asc validate iap --app "APP_ID" --product-id "com.example.pro"
   asc validate iap --app "APP_ID" --output table
-  asc validate iap --app "APP_ID" --strict`,
+  asc validate iap --app "APP_ID" --strict
+  asc validate iap --app "APP_ID" --concurrency 8
+  asc validate iap --app "APP_ID" --output github
+  asc validate iap --app "APP_ID" --set-output
+  asc validate iap --app "APP_ID" --ignore-file .asc-validate-ignore
+
+With --set-output, ready, error_count, warning_count, and blocking_count are
+appended to $GITHUB_OUTPUT as name=value step outputs.`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -50,11 +79,34 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "json", "table", "markdown", "yaml", "csv", outputFormatGitHub)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			githubOutputPath, err := shared.ResolveGitHubOutputPath(*setOutput)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if *concurrency < 1 {
+				return shared.UsageError("--concurrency must be >= 1")
+			}
+
+			ignoreRules, err := loadIgnoreRules(*ignoreFile)
+			if err != nil {
+				return fmt.Errorf("validate iap: %w", err)
+			}
+
 			return runValidateIAP(ctx, validateIAPOptions{
-				AppID:  resolvedAppID,
-				Strict: *strict,
-				Output: *output.Output,
-				Pretty: *output.Pretty,
+				IAPOptions: IAPOptions{
+					AppID:       resolvedAppID,
+					ProductID:   strings.TrimSpace(*productID),
+					Strict:      *strict,
+					Ignore:      ignoreRules,
+					Concurrency: *concurrency,
+				},
+				Output:           normalizedOutput,
+				Pretty:           *output.Pretty,
+				GitHubOutputPath: githubOutputPath,
 			})
 		},
 	}
@@ -66,12 +118,47 @@ func runValidateIAP(ctx context.Context, opts validateIAPOptions) error {
 		return fmt.Errorf("validate iap: %w", err)
 	}
 
+	report, err := ValidateIAPWithClient(ctx, client, opts.IAPOptions)
+	if err != nil {
+		return fmt.Errorf("validate iap: %w", err)
+	}
+
+	if opts.Output == outputFormatGitHub {
+		if err := printGitHubAnnotations(report.Checks, report.Strict); err != nil {
+			return fmt.Errorf("validate iap: %w", err)
+		}
+	} else if err := shared.PrintOutput(report, opts.Output, opts.Pretty); err != nil {
+		return err
+	}
+
+	if err := shared.WriteGitHubOutputs(opts.GitHubOutputPath, summaryGitHubOutputs(report.Summary)); err != nil {
+		return fmt.Errorf("validate iap: %w", err)
+	}
+
+	if report.Summary.Blocking > 0 {
+		return shared.NewBlockingIssuesError(fmt.Errorf("validate iap: found %d blocking issue(s)", report.Summary.Blocking))
+	}
+
+	return nil
+}
+
+// ValidateIAPWithClient fetches an app's in-app purchases with client and
+// returns their review-readiness report. Errors are not prefixed with a
+// command name so callers can add their own context.
+func ValidateIAPWithClient(ctx context.Context, client *asc.Client, opts IAPOptions) (*validation.IAPReport, error) {
+	appCtx, appCancel := shared.ContextWithTimeout(ctx)
+	appResp, err := client.GetApp(appCtx, opts.AppID)
+	appCancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app: %w", err)
+	}
+
 	firstCtx, firstCancel := shared.ContextWithTimeout(ctx)
 	defer firstCancel()
 
 	firstPage, err := client.GetInAppPurchasesV2(firstCtx, opts.AppID, asc.WithIAPLimit(200))
 	if err != nil {
-		return fmt.Errorf("validate iap: failed to fetch in-app purchases: %w", err)
+		return nil, fmt.Errorf("failed to fetch in-app purchases: %w", err)
 	}
 
 	paginated, err := asc.PaginateAll(ctx, firstPage, func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
@@ -80,17 +167,20 @@ func runValidateIAP(ctx context.Context, opts validateIAPOptions) error {
 		return client.GetInAppPurchasesV2(pageCtx, opts.AppID, asc.WithIAPNextURL(nextURL))
 	})
 	if err != nil {
-		return fmt.Errorf("validate iap: paginate in-app purchases: %w", err)
+		return nil, fmt.Errorf("paginate in-app purchases: %w", err)
 	}
 
 	resp, ok := paginated.(*asc.InAppPurchasesV2Response)
 	if !ok {
-		return fmt.Errorf("validate iap: unexpected in-app purchases response type %T", paginated)
+		return nil, fmt.Errorf("unexpected in-app purchases response type %T", paginated)
 	}
 
 	iaps := make([]validation.IAP, 0, len(resp.Data))
 	for _, item := range resp.Data {
 		attrs := item.Attributes
+		if opts.ProductID != "" && attrs.ProductID != opts.ProductID {
+			continue
+		}
 		iaps = append(iaps, validation.IAP{
 			ID:        item.ID,
 			Name:      attrs.Name,
@@ -100,17 +190,106 @@ func runValidateIAP(ctx context.Context, opts validateIAPOptions) error {
 		})
 	}
 
+	err = shared.RunBounded(ctx, len(iaps), opts.Concurrency, func(ctx context.Context, index int) error {
+		return loadIAPDetails(ctx, client, &iaps[index])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ProductID != "" && len(iaps) == 0 {
+		return nil, fmt.Errorf("no in-app purchase with product ID %q found for app %q", opts.ProductID, opts.AppID)
+	}
+
 	report := validation.ValidateIAP(validation.IAPInput{
-		AppID: opts.AppID,
-		IAPs:  iaps,
+		AppID:         opts.AppID,
+		PrimaryLocale: appResp.Data.Attributes.PrimaryLocale,
+		IAPs:          iaps,
 	}, opts.Strict)
+	report.Summary = opts.Ignore.Apply(report.Checks, opts.Strict)
+
+	return &report, nil
+}
 
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+// loadIAPDetails fills in the pricing, review screenshot, and localizations
+// of one IAP.
+func loadIAPDetails(ctx context.Context, client *asc.Client, iap *validation.IAP) error {
+	if err := loadIAPPricing(ctx, client, iap); err != nil {
 		return err
 	}
+	if validation.IAPRequiresReview(iap.State) {
+		screenshotCtx, screenshotCancel := shared.ContextWithTimeout(ctx)
+		screenshot, err := client.GetInAppPurchaseAppStoreReviewScreenshotRelationship(screenshotCtx, iap.ID)
+		screenshotCancel()
+		if err != nil && !asc.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch review screenshot for %s: %w", iap.ID, err)
+		}
+		iap.HasReviewScreenshot = err == nil && strings.TrimSpace(screenshot.Data.ID) != ""
+	}
 
-	if report.Summary.Blocking > 0 {
-		return shared.NewReportedError(fmt.Errorf("validate iap: found %d blocking issue(s)", report.Summary.Blocking))
+	localizationsCtx, localizationsCancel := shared.ContextWithTimeout(ctx)
+	firstPage, err := client.GetInAppPurchaseLocalizations(localizationsCtx, iap.ID, asc.WithIAPLocalizationsLimit(200))
+	localizationsCancel()
+	if err != nil {
+		return fmt.Errorf("failed to fetch localizations for %s: %w", iap.ID, err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		pageCtx, pageCancel := shared.ContextWithTimeout(ctx)
+		defer pageCancel()
+		return client.GetInAppPurchaseLocalizations(pageCtx, iap.ID, asc.WithIAPLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("paginate localizations for %s: %w", iap.ID, err)
+	}
+	localizations, ok := paginated.(*asc.InAppPurchaseLocalizationsResponse)
+	if !ok {
+		return fmt.Errorf("unexpected localizations response type %T", paginated)
+	}
+	for _, loc := range localizations.Data {
+		iap.Localizations = append(iap.Localizations, validation.ProductLocalization{
+			Locale:      loc.Attributes.Locale,
+			Name:        loc.Attributes.Name,
+			Description: loc.Attributes.Description,
+		})
+	}
+	return nil
+}
+
+// loadIAPPricing records whether an IAP has a manual price and at least one
+// available territory. Missing schedules or availabilities count as empty.
+func loadIAPPricing(ctx context.Context, client *asc.Client, iap *validation.IAP) error {
+	scheduleCtx, scheduleCancel := shared.ContextWithTimeout(ctx)
+	schedule, err := client.GetInAppPurchasePriceSchedule(scheduleCtx, iap.ID)
+	scheduleCancel()
+	switch {
+	case asc.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to fetch price schedule for %s: %w", iap.ID, err)
+	default:
+		pricesCtx, pricesCancel := shared.ContextWithTimeout(ctx)
+		prices, err := client.GetInAppPurchasePriceScheduleManualPrices(pricesCtx, schedule.Data.ID, asc.WithIAPPriceSchedulePricesLimit(1))
+		pricesCancel()
+		if err != nil && !asc.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch manual prices for %s: %w", iap.ID, err)
+		}
+		iap.HasPrice = err == nil && len(prices.Data) > 0
+	}
+
+	availabilityCtx, availabilityCancel := shared.ContextWithTimeout(ctx)
+	availability, err := client.GetInAppPurchaseAvailability(availabilityCtx, iap.ID)
+	availabilityCancel()
+	switch {
+	case asc.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to fetch availability for %s: %w", iap.ID, err)
+	default:
+		territoriesCtx, territoriesCancel := shared.ContextWithTimeout(ctx)
+		territories, err := client.GetInAppPurchaseAvailabilityAvailableTerritories(territoriesCtx, availability.Data.ID, asc.WithIAPAvailabilityTerritoriesLimit(1))
+		territoriesCancel()
+		if err != nil && !asc.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch available territories for %s: %w", iap.ID, err)
+		}
+		iap.HasAvailability = err == nil && len(territories.Data) > 0
 	}
 
 	return nil
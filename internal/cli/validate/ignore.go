@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"flag"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const ignoreFileUsage = "File of findings to ignore, one check:productID or check:* per line"
+
+func bindIgnoreFileFlag(fs *flag.FlagSet) *string {
+	return fs.String("ignore-file", "", ignoreFileUsage)
+}
+
+// loadIgnoreRules reads --ignore-file rules, or returns nil when it is unset.
+func loadIgnoreRules(filePath string) (validation.IgnoreRules, error) {
+	filePath = strings.TrimSpace(filePath)
+	if filePath == "" {
+		return nil, nil
+	}
+	return validation.LoadIgnoreFile(filePath)
+}
@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const outputFormatJUnit = "junit"
+
+// printJUnitReport writes the validation report to stdout as a JUnit test suite.
+func printJUnitReport(report validation.Report) error {
+	junit := buildJUnitReport(report, time.Now())
+	if _, err := junit.WriteTo(os.Stdout); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(os.Stdout)
+	return err
+}
+
+// buildJUnitReport maps each validation check to a test case. Errors (and
+// warnings under --strict) become failures so blocking issues surface in CI
+// test dashboards; non-blocking findings are recorded as passing cases.
+func buildJUnitReport(report validation.Report, timestamp time.Time) shared.JUnitReport {
+	cases := make([]shared.JUnitTestCase, 0, len(report.Checks))
+	for _, check := range report.Checks {
+		testCase := shared.JUnitTestCase{
+			Name:      junitCaseName(check),
+			Classname: "validate." + checkCategory(check.ID),
+		}
+		if isBlockingCheck(check, report.Strict) {
+			testCase.Failure = strings.ToUpper(string(check.Severity))
+			testCase.Message = check.Message
+			testCase.SystemErr = check.Remediation
+		} else {
+			testCase.SystemOut = strings.TrimSpace(check.Message + "\n" + check.Remediation)
+		}
+		cases = append(cases, testCase)
+	}
+
+	if len(cases) == 0 {
+		cases = append(cases, shared.JUnitTestCase{
+			Name:      "validate",
+			Classname: "validate",
+		})
+	}
+
+	return shared.JUnitReport{
+		Tests:     cases,
+		Timestamp: timestamp,
+		Name:      "asc validate",
+	}
+}
+
+func junitCaseName(check validation.CheckResult) string {
+	parts := []string{check.ID}
+	if check.Locale != "" {
+		parts = append(parts, check.Locale)
+	}
+	if check.ResourceID != "" {
+		parts = append(parts, check.ResourceID)
+	}
+	return strings.Join(parts, " ")
+}
+
+func checkCategory(id string) string {
+	category, _, _ := strings.Cut(id, ".")
+	if category == "" {
+		return "general"
+	}
+	return category
+}
+
+func isBlockingCheck(check validation.CheckResult, strict bool) bool {
+	if check.Ignored {
+		return false
+	}
+	switch check.Severity {
+	case validation.SeverityError:
+		return true
+	case validation.SeverityWarning:
+		return strict
+	default:
+		return false
+	}
+}
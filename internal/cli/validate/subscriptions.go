@@ -2,10 +2,12 @@ package validate
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -14,9 +16,21 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
 )
 
+// SubscriptionsOptions selects what ValidateSubscriptionsWithClient checks.
+type SubscriptionsOptions struct {
+	AppID string
+	// CheckPricing also fetches availability and prices for each subscription.
+	CheckPricing bool
+	Strict       bool
+	Ignore       validation.IgnoreRules
+	// Concurrency bounds how many subscriptions have their localizations,
+	// introductory offers, and pricing fetched in parallel. Values below 1
+	// check one at a time.
+	Concurrency int
+}
+
 type validateSubscriptionsOptions struct {
-	AppID  string
-	Strict bool
+	SubscriptionsOptions
 	Output string
 	Pretty bool
 }
@@ -26,22 +40,39 @@ func ValidateSubscriptionsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("subscriptions", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	checkPricing := fs.Bool("check-pricing", false, "Also warn about territories without a price or prices without a tier")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
+	concurrency := bindConcurrencyFlag(fs, "Maximum number of subscriptions checked in parallel (>= 1)")
+	ignoreFile := bindIgnoreFileFlag(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "subscriptions",
 		ShortUsage: "asc validate subscriptions --app \"APP_ID\" [flags]",
-		ShortHelp:  "Validate subscription review readiness (warning-only by default).",
+		ShortHelp:  "Validate subscription review readiness.",
 		LongHelp: `Validate review readiness for auto-renewable subscriptions.
 
-This command is conservative: it emits warnings for subscriptions that look
-unsubmitted or need action, but it does not block by default (use --strict for CI).
+Subscriptions that look unsubmitted or need action produce warnings, which do
+not block by default (use --strict for CI). A subscription without a display
+name and description localization (including the app's primary locale), or one
+stuck in MISSING_METADATA, is a blocking error; the finding names the likely
+missing piece when it can be inferred. A disabled billing grace period is
+reported as a warning, as are introductory offers that already ended and
+subscriptions whose localizations mention a free trial without a current
+introductory offer.
+
+With --check-pricing, each subscription's availability and price schedule are
+fetched as well, and a warning is reported when an available territory has no
+price or a price has no tier. These stay warnings because price strategy
+legitimately varies by territory.
 
 Examples:
   asc validate subscriptions --app "APP_ID"
   asc validate subscriptions --app "APP_ID" --output table
-  asc validate subscriptions --app "APP_ID" --strict`,
+  asc validate subscriptions --app "APP_ID" --strict
+  asc validate subscriptions --app "APP_ID" --check-pricing
+  asc validate subscriptions --app "APP_ID" --concurrency 8
+  asc validate subscriptions --app "APP_ID" --ignore-file .asc-validate-ignore`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -50,10 +81,23 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
+			if *concurrency < 1 {
+				return shared.UsageError("--concurrency must be >= 1")
+			}
+
+			ignoreRules, err := loadIgnoreRules(*ignoreFile)
+			if err != nil {
+				return fmt.Errorf("validate subscriptions: %w", err)
+			}
 
 			return runValidateSubscriptions(ctx, validateSubscriptionsOptions{
-				AppID:  resolvedAppID,
-				Strict: *strict,
+				SubscriptionsOptions: SubscriptionsOptions{
+					AppID:        resolvedAppID,
+					CheckPricing: *checkPricing,
+					Strict:       *strict,
+					Ignore:       ignoreRules,
+					Concurrency:  *concurrency,
+				},
 				Output: *output.Output,
 				Pretty: *output.Pretty,
 			})
@@ -67,11 +111,39 @@ func runValidateSubscriptions(ctx context.Context, opts validateSubscriptionsOpt
 		return fmt.Errorf("validate subscriptions: %w", err)
 	}
 
+	report, err := ValidateSubscriptionsWithClient(ctx, client, opts.SubscriptionsOptions)
+	if err != nil {
+		return fmt.Errorf("validate subscriptions: %w", err)
+	}
+
+	if err := shared.PrintOutput(report, opts.Output, opts.Pretty); err != nil {
+		return err
+	}
+
+	if report.Summary.Blocking > 0 {
+		return shared.NewBlockingIssuesError(fmt.Errorf("validate subscriptions: found %d blocking issue(s)", report.Summary.Blocking))
+	}
+
+	return nil
+}
+
+// ValidateSubscriptionsWithClient fetches an app's auto-renewable
+// subscriptions with client and returns their review-readiness report.
+// Errors are not prefixed with a command name so callers can add their own
+// context.
+func ValidateSubscriptionsWithClient(ctx context.Context, client *asc.Client, opts SubscriptionsOptions) (*validation.SubscriptionsReport, error) {
+	appCtx, appCancel := shared.ContextWithTimeout(ctx)
+	appResp, err := client.GetApp(appCtx, opts.AppID)
+	appCancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app: %w", err)
+	}
+
 	groupsCtx, groupsCancel := shared.ContextWithTimeout(ctx)
 	groupsResp, err := client.GetSubscriptionGroups(groupsCtx, opts.AppID, asc.WithSubscriptionGroupsLimit(200))
 	groupsCancel()
 	if err != nil {
-		return fmt.Errorf("validate subscriptions: failed to fetch subscription groups: %w", err)
+		return nil, fmt.Errorf("failed to fetch subscription groups: %w", err)
 	}
 
 	paginatedGroups, err := asc.PaginateAll(ctx, groupsResp, func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
@@ -80,12 +152,12 @@ func runValidateSubscriptions(ctx context.Context, opts validateSubscriptionsOpt
 		return client.GetSubscriptionGroups(pageCtx, opts.AppID, asc.WithSubscriptionGroupsNextURL(nextURL))
 	})
 	if err != nil {
-		return fmt.Errorf("validate subscriptions: paginate subscription groups: %w", err)
+		return nil, fmt.Errorf("paginate subscription groups: %w", err)
 	}
 
 	groups, ok := paginatedGroups.(*asc.SubscriptionGroupsResponse)
 	if !ok {
-		return fmt.Errorf("validate subscriptions: unexpected subscription groups response type %T", paginatedGroups)
+		return nil, fmt.Errorf("unexpected subscription groups response type %T", paginatedGroups)
 	}
 
 	subs := make([]validation.Subscription, 0)
@@ -99,7 +171,7 @@ func runValidateSubscriptions(ctx context.Context, opts validateSubscriptionsOpt
 		subsResp, err := client.GetSubscriptions(subsCtx, groupID, asc.WithSubscriptionsLimit(200))
 		subsCancel()
 		if err != nil {
-			return fmt.Errorf("validate subscriptions: failed to fetch subscriptions for group %s: %w", groupID, err)
+			return nil, fmt.Errorf("failed to fetch subscriptions for group %s: %w", groupID, err)
 		}
 
 		paginatedSubs, err := asc.PaginateAll(ctx, subsResp, func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
@@ -108,12 +180,12 @@ func runValidateSubscriptions(ctx context.Context, opts validateSubscriptionsOpt
 			return client.GetSubscriptions(pageCtx, groupID, asc.WithSubscriptionsNextURL(nextURL))
 		})
 		if err != nil {
-			return fmt.Errorf("validate subscriptions: paginate subscriptions: %w", err)
+			return nil, fmt.Errorf("paginate subscriptions: %w", err)
 		}
 
 		subsResult, ok := paginatedSubs.(*asc.SubscriptionsResponse)
 		if !ok {
-			return fmt.Errorf("validate subscriptions: unexpected subscriptions response type %T", paginatedSubs)
+			return nil, fmt.Errorf("unexpected subscriptions response type %T", paginatedSubs)
 		}
 
 		for _, sub := range subsResult.Data {
@@ -128,18 +200,237 @@ func runValidateSubscriptions(ctx context.Context, opts validateSubscriptionsOpt
 		}
 	}
 
+	err = shared.RunBounded(ctx, len(subs), opts.Concurrency, func(ctx context.Context, index int) error {
+		return loadSubscriptionDetails(ctx, client, &subs[index], opts.CheckPricing)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var gracePeriod *validation.SubscriptionGracePeriod
+	if len(subs) > 0 {
+		gracePeriodCtx, gracePeriodCancel := shared.ContextWithTimeout(ctx)
+		gracePeriodResp, err := client.GetAppSubscriptionGracePeriod(gracePeriodCtx, opts.AppID)
+		gracePeriodCancel()
+		switch {
+		case asc.IsNotFound(err):
+		case err != nil:
+			return nil, fmt.Errorf("failed to fetch subscription grace period: %w", err)
+		default:
+			gracePeriod = &validation.SubscriptionGracePeriod{
+				ID:    gracePeriodResp.Data.ID,
+				OptIn: gracePeriodResp.Data.Attributes.OptIn,
+			}
+		}
+	}
+
 	report := validation.ValidateSubscriptions(validation.SubscriptionsInput{
 		AppID:         opts.AppID,
+		PrimaryLocale: appResp.Data.Attributes.PrimaryLocale,
 		Subscriptions: subs,
+		GracePeriod:   gracePeriod,
+		AsOf:          time.Now(),
 	}, opts.Strict)
+	report.Summary = opts.Ignore.Apply(report.Checks, opts.Strict)
+
+	return &report, nil
+}
 
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+// loadSubscriptionDetails fills in the localizations, introductory offers,
+// and, with checkPricing, the pricing of one subscription.
+func loadSubscriptionDetails(ctx context.Context, client *asc.Client, sub *validation.Subscription, checkPricing bool) error {
+	if err := loadSubscriptionLocalizations(ctx, client, sub); err != nil {
 		return err
 	}
+	if err := loadSubscriptionIntroductoryOffers(ctx, client, sub); err != nil {
+		return err
+	}
+	if checkPricing {
+		return loadSubscriptionPricing(ctx, client, sub)
+	}
+	return nil
+}
 
-	if report.Summary.Blocking > 0 {
-		return shared.NewReportedError(fmt.Errorf("validate subscriptions: found %d blocking issue(s)", report.Summary.Blocking))
+// loadSubscriptionLocalizations records every localization of a subscription.
+func loadSubscriptionLocalizations(ctx context.Context, client *asc.Client, sub *validation.Subscription) error {
+	localizationsCtx, localizationsCancel := shared.ContextWithTimeout(ctx)
+	firstPage, err := client.GetSubscriptionLocalizations(localizationsCtx, sub.ID, asc.WithSubscriptionLocalizationsLimit(200))
+	localizationsCancel()
+	if err != nil {
+		return fmt.Errorf("failed to fetch localizations for %s: %w", sub.ID, err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		pageCtx, pageCancel := shared.ContextWithTimeout(ctx)
+		defer pageCancel()
+		return client.GetSubscriptionLocalizations(pageCtx, sub.ID, asc.WithSubscriptionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("paginate localizations for %s: %w", sub.ID, err)
 	}
+	localizations, ok := paginated.(*asc.SubscriptionLocalizationsResponse)
+	if !ok {
+		return fmt.Errorf("unexpected localizations response type %T", paginated)
+	}
+	for _, loc := range localizations.Data {
+		sub.Localizations = append(sub.Localizations, validation.ProductLocalization{
+			Locale:      loc.Attributes.Locale,
+			Name:        loc.Attributes.Name,
+			Description: loc.Attributes.Description,
+		})
+	}
+	return nil
+}
 
+// loadSubscriptionIntroductoryOffers records every introductory offer of a
+// subscription. A missing relationship counts as no offers.
+func loadSubscriptionIntroductoryOffers(ctx context.Context, client *asc.Client, sub *validation.Subscription) error {
+	offersCtx, offersCancel := shared.ContextWithTimeout(ctx)
+	firstPage, err := client.GetSubscriptionIntroductoryOffers(offersCtx, sub.ID, asc.WithSubscriptionIntroductoryOffersLimit(200))
+	offersCancel()
+	if asc.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch introductory offers for %s: %w", sub.ID, err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		pageCtx, pageCancel := shared.ContextWithTimeout(ctx)
+		defer pageCancel()
+		return client.GetSubscriptionIntroductoryOffers(pageCtx, sub.ID, asc.WithSubscriptionIntroductoryOffersNextURL(nextURL))
+	})
+	if err != nil {
+		return fmt.Errorf("paginate introductory offers for %s: %w", sub.ID, err)
+	}
+	offers, ok := paginated.(*asc.SubscriptionIntroductoryOffersResponse)
+	if !ok {
+		return fmt.Errorf("unexpected introductory offers response type %T", paginated)
+	}
+	for _, offer := range offers.Data {
+		sub.IntroductoryOffers = append(sub.IntroductoryOffers, validation.IntroductoryOffer{
+			ID:      offer.ID,
+			EndDate: offer.Attributes.EndDate,
+		})
+	}
 	return nil
 }
+
+// loadSubscriptionPricing records the territories a subscription is available
+// in and its scheduled price per territory. A missing availability counts as
+// no territories.
+func loadSubscriptionPricing(ctx context.Context, client *asc.Client, sub *validation.Subscription) error {
+	sub.PricingChecked = true
+
+	availabilityCtx, availabilityCancel := shared.ContextWithTimeout(ctx)
+	availability, err := client.GetSubscriptionAvailabilityForSubscription(availabilityCtx, sub.ID)
+	availabilityCancel()
+	switch {
+	case asc.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to fetch availability for %s: %w", sub.ID, err)
+	case strings.TrimSpace(availability.Data.ID) != "":
+		nextURL := ""
+		for {
+			opts := []asc.SubscriptionAvailabilityTerritoriesOption{asc.WithSubscriptionAvailabilityTerritoriesLimit(200)}
+			if nextURL != "" {
+				opts = []asc.SubscriptionAvailabilityTerritoriesOption{asc.WithSubscriptionAvailabilityTerritoriesNextURL(nextURL)}
+			}
+			territoriesCtx, territoriesCancel := shared.ContextWithTimeout(ctx)
+			territories, err := client.GetSubscriptionAvailabilityAvailableTerritories(territoriesCtx, availability.Data.ID, opts...)
+			territoriesCancel()
+			if err != nil {
+				return fmt.Errorf("failed to fetch available territories for %s: %w", sub.ID, err)
+			}
+			for _, territory := range territories.Data {
+				sub.Territories = append(sub.Territories, territory.ID)
+			}
+			nextURL = strings.TrimSpace(territories.Links.Next)
+			if nextURL == "" {
+				break
+			}
+		}
+	}
+
+	nextURL := ""
+	for {
+		opts := []asc.SubscriptionPricesOption{
+			asc.WithSubscriptionPricesInclude([]string{"subscriptionPricePoint", "territory"}),
+			asc.WithSubscriptionPricesPricePointFields([]string{"customerPrice"}),
+			asc.WithSubscriptionPricesLimit(200),
+		}
+		if nextURL != "" {
+			opts = []asc.SubscriptionPricesOption{asc.WithSubscriptionPricesNextURL(nextURL)}
+		}
+		pricesCtx, pricesCancel := shared.ContextWithTimeout(ctx)
+		prices, err := client.GetSubscriptionPrices(pricesCtx, sub.ID, opts...)
+		pricesCancel()
+		if err != nil {
+			if asc.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to fetch prices for %s: %w", sub.ID, err)
+		}
+
+		customerPrices, err := includedCustomerPrices(prices.Included)
+		if err != nil {
+			return fmt.Errorf("failed to parse price points for %s: %w", sub.ID, err)
+		}
+		for _, price := range prices.Data {
+			territoryID, pricePointID, err := subscriptionPriceRelationshipIDs(price.Relationships)
+			if err != nil {
+				return fmt.Errorf("failed to parse price %s for %s: %w", price.ID, sub.ID, err)
+			}
+			sub.Prices = append(sub.Prices, validation.SubscriptionTerritoryPrice{
+				Territory:     territoryID,
+				PricePointID:  pricePointID,
+				CustomerPrice: customerPrices[pricePointID],
+			})
+		}
+		nextURL = strings.TrimSpace(prices.Links.Next)
+		if nextURL == "" {
+			return nil
+		}
+	}
+}
+
+// includedCustomerPrices maps included subscription price point IDs to their
+// customer price.
+func includedCustomerPrices(raw json.RawMessage) (map[string]string, error) {
+	values := make(map[string]string)
+	if len(raw) == 0 {
+		return values, nil
+	}
+	var included []struct {
+		Type       string                               `json:"type"`
+		ID         string                               `json:"id"`
+		Attributes asc.SubscriptionPricePointAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return nil, err
+	}
+	for _, item := range included {
+		if item.Type == "subscriptionPricePoints" {
+			values[item.ID] = strings.TrimSpace(item.Attributes.CustomerPrice)
+		}
+	}
+	return values, nil
+}
+
+func subscriptionPriceRelationshipIDs(raw json.RawMessage) (territoryID, pricePointID string, err error) {
+	if len(raw) == 0 {
+		return "", "", nil
+	}
+	var rels struct {
+		Territory              *asc.Relationship `json:"territory"`
+		SubscriptionPricePoint *asc.Relationship `json:"subscriptionPricePoint"`
+	}
+	if err := json.Unmarshal(raw, &rels); err != nil {
+		return "", "", err
+	}
+	if rels.Territory != nil {
+		territoryID = strings.TrimSpace(rels.Territory.Data.ID)
+	}
+	if rels.SubscriptionPricePoint != nil {
+		pricePointID = strings.TrimSpace(rels.SubscriptionPricePoint.Data.ID)
+	}
+	return territoryID, pricePointID, nil
+}
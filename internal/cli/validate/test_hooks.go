@@ -2,7 +2,6 @@ package validate
 
 import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 // SetClientFactory replaces the ASC client factory for tests.
@@ -10,7 +9,7 @@ import (
 func SetClientFactory(fn func() (*asc.Client, error)) func() {
 	previous := clientFactory
 	if fn == nil {
-		clientFactory = shared.GetASCClient
+		clientFactory = defaultClientFactory
 	} else {
 		clientFactory = fn
 	}
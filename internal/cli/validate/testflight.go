@@ -20,6 +20,7 @@ type validateTestFlightOptions struct {
 	Strict  bool
 	Output  string
 	Pretty  bool
+	Ignore  validation.IgnoreRules
 }
 
 // ValidateTestFlightCommand returns the asc validate testflight subcommand.
@@ -29,6 +30,7 @@ func ValidateTestFlightCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	buildID := fs.String("build", "", "Build ID (required)")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
+	ignoreFile := bindIgnoreFileFlag(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -45,7 +47,8 @@ Checks:
 Examples:
   asc validate testflight --app "APP_ID" --build "BUILD_ID"
   asc validate testflight --app "APP_ID" --build "BUILD_ID" --output table
-  asc validate testflight --app "APP_ID" --build "BUILD_ID" --strict`,
+  asc validate testflight --app "APP_ID" --build "BUILD_ID" --strict
+  asc validate testflight --app "APP_ID" --build "BUILD_ID" --ignore-file .asc-validate-ignore`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -61,12 +64,18 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			ignoreRules, err := loadIgnoreRules(*ignoreFile)
+			if err != nil {
+				return fmt.Errorf("validate testflight: %w", err)
+			}
+
 			return runValidateTestFlight(ctx, validateTestFlightOptions{
 				AppID:   resolvedAppID,
 				BuildID: buildValue,
 				Strict:  *strict,
 				Output:  *output.Output,
 				Pretty:  *output.Pretty,
+				Ignore:  ignoreRules,
 			})
 		},
 	}
@@ -158,13 +167,14 @@ func runValidateTestFlight(ctx context.Context, opts validateTestFlightOptions)
 		BetaReviewDetails:      betaReviewDetails,
 		BetaBuildLocalizations: betaBuildLocalizations,
 	}, opts.Strict)
+	report.Summary = opts.Ignore.Apply(report.Checks, opts.Strict)
 
 	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
 		return err
 	}
 
 	if report.Summary.Blocking > 0 {
-		return shared.NewReportedError(fmt.Errorf("validate testflight: found %d blocking issue(s)", report.Summary.Blocking))
+		return shared.NewBlockingIssuesError(fmt.Errorf("validate testflight: found %d blocking issue(s)", report.Summary.Blocking))
 	}
 
 	return nil
@@ -19,12 +19,25 @@ type validateOptions struct {
 	Version   string
 	VersionID string
 	Platform  string
+	Locales   []string
 	Strict    bool
 	Output    string
 	Pretty    bool
+	Ignore    validation.IgnoreRules
+	// Concurrency bounds how many localizations have their screenshot sets
+	// fetched in parallel.
+	Concurrency int
+	// GitHubOutputPath receives step outputs when --set-output is enabled.
+	GitHubOutputPath string
 }
 
-var clientFactory = shared.GetASCClient
+var clientFactory = defaultClientFactory
+
+// defaultClientFactory builds a client that memoizes GET responses, since
+// validation reads the same app resources from several checks.
+func defaultClientFactory() (*asc.Client, error) {
+	return shared.GetASCClientWithOptions(asc.WithResponseCache())
+}
 
 // ValidateCommand returns the asc validate command.
 func ValidateCommand() *ffcli.Command {
@@ -34,8 +47,12 @@ func ValidateCommand() *ffcli.Command {
 	version := fs.String("version", "", "App Store version string")
 	versionID := fs.String("version-id", "", "App Store version ID")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	locales := fs.String("locales", "", "Only report localization findings for these locales (comma-separated, e.g. en-US,fr-FR)")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
-	output := shared.BindOutputFlags(fs)
+	concurrency := bindConcurrencyFlag(fs, "Maximum number of localizations whose screenshots are fetched in parallel (>= 1)")
+	ignoreFile := bindIgnoreFileFlag(fs)
+	setOutput := shared.BindSetOutputFlag(fs)
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, junit, github")
 
 	return &ffcli.Command{
 		Name:       "validate",
@@ -58,6 +75,15 @@ Examples:
   asc validate --app "APP_ID" --version "1.0.0" --platform IOS
   asc validate --app "APP_ID" --version-id "VERSION_ID" --platform IOS --output table
   asc validate --app "APP_ID" --version-id "VERSION_ID" --strict
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --output junit > validate.xml
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --output github
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --set-output
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --ignore-file .asc-validate-ignore
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --locales "en-US,fr-FR"
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --concurrency 8
+
+With --set-output, ready, error_count, warning_count, and blocking_count are
+appended to $GITHUB_OUTPUT as name=value step outputs.
 
 TestFlight:
   asc validate testflight --app "APP_ID" --build "BUILD_ID"
@@ -66,13 +92,17 @@ In-App Purchases:
   asc validate iap --app "APP_ID"
 
 Subscriptions:
-  asc validate subscriptions --app "APP_ID"`,
+  asc validate subscriptions --app "APP_ID"
+
+Everything:
+  asc validate all --app "APP_ID" --version-id "VERSION_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			ValidateTestFlightCommand(),
 			ValidateIAPCommand(),
 			ValidateSubscriptionsCommand(),
+			ValidateAllCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			if len(args) > 0 {
@@ -95,6 +125,15 @@ Subscriptions:
 				return flag.ErrHelp
 			}
 
+			normalizedOutput, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, "json", "table", "markdown", "yaml", outputFormatJUnit, outputFormatGitHub)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			githubOutputPath, err := shared.ResolveGitHubOutputPath(*setOutput)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
 			var normalizedPlatform string
 			if strings.TrimSpace(*platform) != "" {
 				value, err := shared.NormalizeAppStoreVersionPlatform(*platform)
@@ -104,14 +143,31 @@ Subscriptions:
 				normalizedPlatform = value
 			}
 
+			localeFilter, err := parseLocaleFilter(*locales)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if *concurrency < 1 {
+				return shared.UsageError("--concurrency must be >= 1")
+			}
+
+			ignoreRules, err := loadIgnoreRules(*ignoreFile)
+			if err != nil {
+				return fmt.Errorf("validate: %w", err)
+			}
+
 			return runValidate(ctx, validateOptions{
-				AppID:     resolvedAppID,
-				Version:   trimmedVersion,
-				VersionID: trimmedVersionID,
-				Platform:  normalizedPlatform,
-				Strict:    *strict,
-				Output:    *output.Output,
-				Pretty:    *output.Pretty,
+				AppID:            resolvedAppID,
+				Version:          trimmedVersion,
+				VersionID:        trimmedVersionID,
+				Platform:         normalizedPlatform,
+				Locales:          localeFilter,
+				Strict:           *strict,
+				Output:           normalizedOutput,
+				Pretty:           *output.Pretty,
+				Ignore:           ignoreRules,
+				Concurrency:      *concurrency,
+				GitHubOutputPath: githubOutputPath,
 			})
 		},
 	}
@@ -123,52 +179,95 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		return fmt.Errorf("validate: %w", err)
 	}
 
+	report, err := buildAppStoreReport(ctx, client, opts)
+	if err != nil {
+		return fmt.Errorf("validate: %w", err)
+	}
+
+	switch opts.Output {
+	case outputFormatJUnit:
+		if err := printJUnitReport(*report); err != nil {
+			return fmt.Errorf("validate: %w", err)
+		}
+	case outputFormatGitHub:
+		if err := printGitHubAnnotations(report.Checks, report.Strict); err != nil {
+			return fmt.Errorf("validate: %w", err)
+		}
+	default:
+		if err := shared.PrintOutput(report, opts.Output, opts.Pretty); err != nil {
+			return err
+		}
+	}
+
+	if err := shared.WriteGitHubOutputs(opts.GitHubOutputPath, summaryGitHubOutputs(report.Summary)); err != nil {
+		return fmt.Errorf("validate: %w", err)
+	}
+
+	if report.Summary.Blocking > 0 {
+		return shared.NewBlockingIssuesError(fmt.Errorf("validate: found %d blocking issue(s)", report.Summary.Blocking))
+	}
+
+	return nil
+}
+
+func buildAppStoreReport(ctx context.Context, client *asc.Client, opts validateOptions) (*validation.Report, error) {
 	requestCtx, cancel := shared.ContextWithTimeout(ctx)
 	defer cancel()
 
 	resolvedVersionID := opts.VersionID
 	if resolvedVersionID == "" {
+		var err error
 		resolvedVersionID, err = resolveVersionID(requestCtx, client, opts.AppID, opts.Version, opts.Platform)
 		if err != nil {
-			return fmt.Errorf("validate: %w", err)
+			return nil, err
 		}
 	}
 
 	versionResp, err := client.GetAppStoreVersion(requestCtx, resolvedVersionID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app store version: %w", err)
+		return nil, fmt.Errorf("failed to fetch app store version: %w", err)
 	}
 
 	appResp, err := client.GetApp(requestCtx, opts.AppID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app: %w", err)
+		return nil, fmt.Errorf("failed to fetch app: %w", err)
+	}
+
+	platform := opts.Platform
+	if platform == "" {
+		platform = string(versionResp.Data.Attributes.Platform)
+	}
+
+	versionCount, err := countAppStoreVersions(requestCtx, client, opts.AppID, platform)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app store versions: %w", err)
 	}
 
 	versionLocsResp, err := client.GetAppStoreVersionLocalizations(requestCtx, resolvedVersionID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch version localizations: %w", err)
+		return nil, fmt.Errorf("failed to fetch version localizations: %w", err)
 	}
 
 	appInfosResp, err := client.GetAppInfos(requestCtx, opts.AppID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app info: %w", err)
+		return nil, fmt.Errorf("failed to fetch app info: %w", err)
 	}
 
 	appInfoID := shared.SelectBestAppInfoID(appInfosResp)
 	if strings.TrimSpace(appInfoID) == "" {
-		return fmt.Errorf("validate: failed to select app info for app")
+		return nil, fmt.Errorf("failed to select app info for app")
 	}
 
 	appInfoLocsResp, err := client.GetAppInfoLocalizations(requestCtx, appInfoID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app info localizations: %w", err)
+		return nil, fmt.Errorf("failed to fetch app info localizations: %w", err)
 	}
 
 	primaryCategoryID := ""
 	primaryCategoryResp, err := client.GetAppInfoPrimaryCategoryRelationship(requestCtx, appInfoID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch app primary category: %w", err)
+			return nil, fmt.Errorf("failed to fetch app primary category: %w", err)
 		}
 	} else {
 		primaryCategoryID = primaryCategoryResp.Data.ID
@@ -178,7 +277,7 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	ageRatingResp, err := client.GetAgeRatingDeclarationForAppStoreVersion(requestCtx, resolvedVersionID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch age rating declaration: %w", err)
+			return nil, fmt.Errorf("failed to fetch age rating declaration: %w", err)
 		}
 	} else {
 		ageRatingDecl = mapAgeRatingDeclaration(ageRatingResp.Data.Attributes)
@@ -188,7 +287,7 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	reviewDetailsResp, err := client.GetAppStoreReviewDetailForVersion(requestCtx, resolvedVersionID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch review details: %w", err)
+			return nil, fmt.Errorf("failed to fetch review details: %w", err)
 		}
 	} else {
 		attrs := reviewDetailsResp.Data.Attributes
@@ -206,10 +305,12 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	}
 
 	var attachedBuild *validation.Build
-	buildResp, err := client.GetAppStoreVersionBuild(requestCtx, resolvedVersionID)
+	buildResp, err := asc.RetryOnce(requestCtx, func() (*asc.BuildResponse, error) {
+		return client.GetAppStoreVersionBuild(requestCtx, resolvedVersionID)
+	})
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch attached build: %w", err)
+			return nil, fmt.Errorf("failed to fetch attached build: %w", err)
 		}
 	} else if strings.TrimSpace(buildResp.Data.ID) != "" {
 		attrs := buildResp.Data.Attributes
@@ -225,7 +326,7 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	priceScheduleResp, err := client.GetAppPriceSchedule(requestCtx, opts.AppID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch app price schedule: %w", err)
+			return nil, fmt.Errorf("failed to fetch app price schedule: %w", err)
 		}
 	} else {
 		priceScheduleID = priceScheduleResp.Data.ID
@@ -239,7 +340,7 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		// (e.g. "resource does not exist"). Treat those as "missing" rather than
 		// aborting validation.
 		if !shared.IsAppAvailabilityMissing(err) {
-			return fmt.Errorf("validate: failed to fetch app availability: %w", err)
+			return nil, fmt.Errorf("failed to fetch app availability: %w", err)
 		}
 	} else {
 		availabilityID = availabilityResp.Data.ID
@@ -253,7 +354,7 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 					territoryResp, err = client.GetTerritoryAvailabilities(requestCtx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
 				}
 				if err != nil {
-					return fmt.Errorf("validate: failed to fetch territory availabilities: %w", err)
+					return nil, fmt.Errorf("failed to fetch territory availabilities: %w", err)
 				}
 
 				for _, territoryAvailability := range territoryResp.Data {
@@ -301,14 +402,9 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		})
 	}
 
-	screenshotSets, err := fetchScreenshotSets(requestCtx, client, versionLocsResp.Data)
+	screenshotSets, err := fetchScreenshotSets(requestCtx, client, versionLocsResp.Data, opts.Concurrency)
 	if err != nil {
-		return err
-	}
-
-	platform := opts.Platform
-	if platform == "" {
-		platform = string(versionResp.Data.Attributes.Platform)
+		return nil, err
 	}
 
 	report := validation.Validate(validation.Input{
@@ -317,8 +413,10 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		VersionID:            resolvedVersionID,
 		VersionString:        versionResp.Data.Attributes.VersionString,
 		VersionState:         shared.ResolveAppStoreVersionState(versionResp.Data.Attributes),
+		AppStoreVersionCount: versionCount,
 		Platform:             platform,
 		PrimaryLocale:        appResp.Data.Attributes.PrimaryLocale,
+		Locales:              opts.Locales,
 		VersionLocalizations: versionLocalizations,
 		AppInfoLocalizations: appInfoLocalizations,
 		ReviewDetails:        reviewDetails,
@@ -330,16 +428,26 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		ScreenshotSets:       screenshotSets,
 		AgeRatingDeclaration: ageRatingDecl,
 	}, opts.Strict)
+	report.Summary = opts.Ignore.Apply(report.Checks, opts.Strict)
 
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
-		return err
-	}
+	return &report, nil
+}
 
-	if report.Summary.Blocking > 0 {
-		return shared.NewReportedError(fmt.Errorf("validate: found %d blocking issue(s)", report.Summary.Blocking))
+// parseLocaleFilter parses --locales into canonical App Store locale codes.
+func parseLocaleFilter(value string) ([]string, error) {
+	items := shared.SplitCSV(value)
+	if len(items) == 0 {
+		return nil, nil
 	}
-
-	return nil
+	locales := make([]string, 0, len(items))
+	for _, item := range items {
+		locale, ok := shared.CanonicalAppStoreLocale(item)
+		if !ok {
+			return nil, fmt.Errorf("--locales: unsupported locale %q", item)
+		}
+		locales = append(locales, locale)
+	}
+	return locales, nil
 }
 
 func resolveVersionID(ctx context.Context, client *asc.Client, appID, version, platform string) (string, error) {
@@ -362,81 +470,179 @@ func resolveVersionID(ctx context.Context, client *asc.Client, appID, version, p
 		return "", fmt.Errorf("app store version not found for version %q", version)
 	}
 	if len(resp.Data) > 1 {
+		ambiguousErr := fmt.Errorf("multiple app store versions found for version %q (use --platform or --version-id)", version)
 		if strings.TrimSpace(platform) != "" {
-			return "", fmt.Errorf("multiple app store versions found for version %q and platform %q (use --version-id)", version, platform)
+			ambiguousErr = fmt.Errorf("multiple app store versions found for version %q and platform %q (use --version-id)", version, platform)
 		}
-		return "", fmt.Errorf("multiple app store versions found for version %q (use --platform or --version-id)", version)
+		return shared.SelectAmbiguous(
+			fmt.Sprintf("Multiple app store versions match version %q. Select one:", version),
+			shared.AppStoreVersionChoices(resp.Data),
+			ambiguousErr,
+		)
 	}
 	return resp.Data[0].ID, nil
 }
 
-func fetchScreenshotSets(ctx context.Context, client *asc.Client, localizations []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]validation.ScreenshotSet, error) {
+// countAppStoreVersions returns how many App Store versions exist for the app
+// on the platform, capped at two since callers only need to distinguish the
+// first version from later ones. It returns 0 when the count is unknown.
+func countAppStoreVersions(ctx context.Context, client *asc.Client, appID, platform string) (int, error) {
+	opts := []asc.AppStoreVersionsOption{asc.WithAppStoreVersionsLimit(2)}
+	if strings.TrimSpace(platform) != "" {
+		opts = append(opts, asc.WithAppStoreVersionsPlatforms([]string{platform}))
+	}
+
+	resp, err := client.GetAppStoreVersions(ctx, appID, opts...)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if resp == nil {
+		return 0, nil
+	}
+	return min(len(resp.Data), 2), nil
+}
+
+// defaultConcurrency is the default number of localizations or products whose
+// details are fetched in parallel.
+const defaultConcurrency = 3
+
+func bindConcurrencyFlag(fs *flag.FlagSet, usage string) *int {
+	return fs.Int("concurrency", defaultConcurrency, usage)
+}
+
+// fetchScreenshotSets fetches screenshot sets for each localization with at
+// most concurrency localizations in flight. Sets are returned in localization
+// order, matching a serial fetch; the first failure cancels the rest.
+func fetchScreenshotSets(ctx context.Context, client *asc.Client, localizations []asc.Resource[asc.AppStoreVersionLocalizationAttributes], concurrency int) ([]validation.ScreenshotSet, error) {
+	perLocalization := make([][]validation.ScreenshotSet, len(localizations))
+	err := shared.RunBounded(ctx, len(localizations), concurrency, func(ctx context.Context, index int) error {
+		sets, err := fetchLocalizationScreenshotSets(ctx, client, localizations[index])
+		if err != nil {
+			return err
+		}
+		perLocalization[index] = sets
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var sets []validation.ScreenshotSet
-	for _, loc := range localizations {
-		resp, err := client.GetAppStoreVersionLocalizationScreenshotSets(ctx, loc.ID)
+	for _, localizationSets := range perLocalization {
+		sets = append(sets, localizationSets...)
+	}
+	return sets, nil
+}
+
+func fetchLocalizationScreenshotSets(ctx context.Context, client *asc.Client, loc asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]validation.ScreenshotSet, error) {
+	resp, err := fetchAllScreenshotSets(ctx, client, loc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch screenshot sets for %s: %w", loc.ID, err)
+	}
+
+	sets := make([]validation.ScreenshotSet, 0, len(resp.Data))
+	for _, set := range resp.Data {
+		screenshotsResp, err := fetchAllScreenshots(ctx, client, set.ID)
 		if err != nil {
-			return nil, fmt.Errorf("validate: failed to fetch screenshot sets for %s: %w", loc.ID, err)
+			return nil, fmt.Errorf("failed to fetch screenshots for %s: %w", set.ID, err)
 		}
-		for _, set := range resp.Data {
-			screenshotsResp, err := client.GetAppScreenshots(ctx, set.ID)
-			if err != nil {
-				return nil, fmt.Errorf("validate: failed to fetch screenshots for %s: %w", set.ID, err)
+		screenshots := make([]validation.Screenshot, 0, len(screenshotsResp.Data))
+		for _, shot := range screenshotsResp.Data {
+			width := 0
+			height := 0
+			if shot.Attributes.ImageAsset != nil {
+				width = shot.Attributes.ImageAsset.Width
+				height = shot.Attributes.ImageAsset.Height
 			}
-			screenshots := make([]validation.Screenshot, 0, len(screenshotsResp.Data))
-			for _, shot := range screenshotsResp.Data {
-				width := 0
-				height := 0
-				if shot.Attributes.ImageAsset != nil {
-					width = shot.Attributes.ImageAsset.Width
-					height = shot.Attributes.ImageAsset.Height
-				}
-				screenshots = append(screenshots, validation.Screenshot{
-					ID:       shot.ID,
-					FileName: shot.Attributes.FileName,
-					Width:    width,
-					Height:   height,
-				})
-			}
-			sets = append(sets, validation.ScreenshotSet{
-				ID:             set.ID,
-				DisplayType:    set.Attributes.ScreenshotDisplayType,
-				Locale:         loc.Attributes.Locale,
-				LocalizationID: loc.ID,
-				Screenshots:    screenshots,
+			screenshots = append(screenshots, validation.Screenshot{
+				ID:       shot.ID,
+				FileName: shot.Attributes.FileName,
+				Width:    width,
+				Height:   height,
 			})
 		}
+		sets = append(sets, validation.ScreenshotSet{
+			ID:             set.ID,
+			DisplayType:    set.Attributes.ScreenshotDisplayType,
+			Locale:         loc.Attributes.Locale,
+			LocalizationID: loc.ID,
+			Screenshots:    screenshots,
+		})
 	}
 	return sets, nil
 }
 
+// fetchAllScreenshotSets follows links.next so every screenshot set of a
+// localization is counted.
+func fetchAllScreenshotSets(ctx context.Context, client *asc.Client, localizationID string) (*asc.AppScreenshotSetsResponse, error) {
+	firstPage, err := client.GetAppStoreVersionLocalizationScreenshotSets(ctx, localizationID, asc.WithAppStoreVersionLocalizationScreenshotSetsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizationScreenshotSets(ctx, localizationID, asc.WithAppStoreVersionLocalizationScreenshotSetsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate screenshot sets: %w", err)
+	}
+	sets, ok := paginated.(*asc.AppScreenshotSetsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected screenshot sets response type %T", paginated)
+	}
+	return sets, nil
+}
+
+// fetchAllScreenshots follows links.next so every screenshot in a set is
+// counted.
+func fetchAllScreenshots(ctx context.Context, client *asc.Client, setID string) (*asc.AppScreenshotsResponse, error) {
+	firstPage, err := client.GetAppScreenshots(ctx, setID, asc.WithAppScreenshotsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppScreenshots(ctx, setID, asc.WithAppScreenshotsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate screenshots: %w", err)
+	}
+	screenshots, ok := paginated.(*asc.AppScreenshotsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected screenshots response type %T", paginated)
+	}
+	return screenshots, nil
+}
+
 func mapAgeRatingDeclaration(attrs asc.AgeRatingDeclarationAttributes) *validation.AgeRatingDeclaration {
 	return &validation.AgeRatingDeclaration{
-		Advertising:                                 attrs.Advertising,
-		Gambling:                                    attrs.Gambling,
-		HealthOrWellnessTopics:                      attrs.HealthOrWellnessTopics,
-		LootBox:                                     attrs.LootBox,
-		MessagingAndChat:                            attrs.MessagingAndChat,
-		ParentalControls:                            attrs.ParentalControls,
-		AgeAssurance:                                attrs.AgeAssurance,
-		UnrestrictedWebAccess:                       attrs.UnrestrictedWebAccess,
-		UserGeneratedContent:                        attrs.UserGeneratedContent,
-		AlcoholTobaccoOrDrugUseOrReferences:         attrs.AlcoholTobaccoOrDrugUseOrReferences,
-		Contests:                                    attrs.Contests,
-		GamblingSimulated:                           attrs.GamblingSimulated,
-		GunsOrOtherWeapons:                          attrs.GunsOrOtherWeapons,
-		MedicalOrTreatmentInformation:               attrs.MedicalOrTreatmentInformation,
-		ProfanityOrCrudeHumor:                       attrs.ProfanityOrCrudeHumor,
-		SexualContentGraphicAndNudity:               attrs.SexualContentGraphicAndNudity,
-		SexualContentOrNudity:                       attrs.SexualContentOrNudity,
-		HorrorOrFearThemes:                          attrs.HorrorOrFearThemes,
-		MatureOrSuggestiveThemes:                    attrs.MatureOrSuggestiveThemes,
-		ViolenceCartoonOrFantasy:                    attrs.ViolenceCartoonOrFantasy,
-		ViolenceRealistic:                           attrs.ViolenceRealistic,
+		Advertising:                         attrs.Advertising,
+		Gambling:                            attrs.Gambling,
+		HealthOrWellnessTopics:              attrs.HealthOrWellnessTopics,
+		LootBox:                             attrs.LootBox,
+		MessagingAndChat:                    attrs.MessagingAndChat,
+		ParentalControls:                    attrs.ParentalControls,
+		AgeAssurance:                        attrs.AgeAssurance,
+		UnrestrictedWebAccess:               attrs.UnrestrictedWebAccess,
+		UserGeneratedContent:                attrs.UserGeneratedContent,
+		AlcoholTobaccoOrDrugUseOrReferences: attrs.AlcoholTobaccoOrDrugUseOrReferences,
+		Contests:                            attrs.Contests,
+		GamblingSimulated:                   attrs.GamblingSimulated,
+		GunsOrOtherWeapons:                  attrs.GunsOrOtherWeapons,
+		MedicalOrTreatmentInformation:       attrs.MedicalOrTreatmentInformation,
+		ProfanityOrCrudeHumor:               attrs.ProfanityOrCrudeHumor,
+		SexualContentGraphicAndNudity:       attrs.SexualContentGraphicAndNudity,
+		SexualContentOrNudity:               attrs.SexualContentOrNudity,
+		HorrorOrFearThemes:                  attrs.HorrorOrFearThemes,
+		MatureOrSuggestiveThemes:            attrs.MatureOrSuggestiveThemes,
+		ViolenceCartoonOrFantasy:            attrs.ViolenceCartoonOrFantasy,
+		ViolenceRealistic:                   attrs.ViolenceRealistic,
 		ViolenceRealisticProlongedGraphicOrSadistic: attrs.ViolenceRealisticProlongedGraphicOrSadistic,
-		KidsAgeBand:                                 attrs.KidsAgeBand,
-		AgeRatingOverride:                           attrs.AgeRatingOverride,
-		AgeRatingOverrideV2:                         attrs.AgeRatingOverrideV2,
-		KoreaAgeRatingOverride:                      attrs.KoreaAgeRatingOverride,
-		DeveloperAgeRatingInfoURL:                   attrs.DeveloperAgeRatingInfoURL,
+		KidsAgeBand:               attrs.KidsAgeBand,
+		AgeRatingOverride:         attrs.AgeRatingOverride,
+		AgeRatingOverrideV2:       attrs.AgeRatingOverrideV2,
+		KoreaAgeRatingOverride:    attrs.KoreaAgeRatingOverride,
+		DeveloperAgeRatingInfoURL: attrs.DeveloperAgeRatingInfoURL,
 	}
 }
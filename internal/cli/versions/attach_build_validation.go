@@ -0,0 +1,67 @@
+package versions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const buildProcessingStateValid = "VALID"
+
+// validateBuildForVersion ensures a build can be attached to a version: it
+// must belong to the version's app and have finished processing.
+func validateBuildForVersion(ctx context.Context, client *asc.Client, versionID, buildID string) error {
+	versionResp, err := client.GetAppStoreVersion(ctx, versionID, asc.WithAppStoreVersionInclude([]string{"app"}))
+	if err != nil {
+		return fmt.Errorf("fetch version %q: %w", versionID, err)
+	}
+	versionAppID, err := versionAppID(versionResp)
+	if err != nil {
+		return err
+	}
+
+	buildAppResp, err := client.GetBuildApp(ctx, buildID)
+	if err != nil {
+		return fmt.Errorf("fetch app for build %q: %w", buildID, err)
+	}
+	buildAppID := strings.TrimSpace(buildAppResp.Data.ID)
+	if buildAppID != versionAppID {
+		return fmt.Errorf("build %q belongs to app %q, but version %q belongs to app %q", buildID, buildAppID, versionID, versionAppID)
+	}
+
+	buildResp, err := client.GetBuild(ctx, buildID)
+	if err != nil {
+		return fmt.Errorf("fetch build %q: %w", buildID, err)
+	}
+	state := strings.ToUpper(strings.TrimSpace(buildResp.Data.Attributes.ProcessingState))
+	if state != buildProcessingStateValid {
+		if state == "" {
+			state = "unknown"
+		}
+		return fmt.Errorf("build %q has processing state %s; only %s builds can be attached", buildID, state, buildProcessingStateValid)
+	}
+
+	return nil
+}
+
+func versionAppID(resp *asc.AppStoreVersionResponse) (string, error) {
+	if resp != nil && len(resp.Data.Relationships) > 0 {
+		var relationships struct {
+			App *struct {
+				Data *asc.ResourceData `json:"data"`
+			} `json:"app"`
+		}
+		if err := json.Unmarshal(resp.Data.Relationships, &relationships); err != nil {
+			return "", fmt.Errorf("parse app store version relationships: %w", err)
+		}
+		if relationships.App != nil && relationships.App.Data != nil {
+			if appID := strings.TrimSpace(relationships.App.Data.ID); appID != "" {
+				return appID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("could not determine owning app for version")
+}
@@ -93,7 +93,7 @@ Examples:
 
 				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionExperimentsV2ForVersion(ctx, versionValue, asc.WithAppStoreVersionExperimentsV2NextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("versions experiments-v2 list: %w", err)
 				}
@@ -43,12 +43,17 @@ Examples:
   asc versions phased-release get --version-id "VERSION_ID"
   asc versions phased-release create --version-id "VERSION_ID"
   asc versions phased-release update --id "PHASED_ID" --state PAUSED
+  asc versions phased-release pause --version-id "VERSION_ID"
+  asc versions phased-release resume --version-id "VERSION_ID"
   asc versions phased-release delete --id "PHASED_ID" --confirm`,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PhasedReleaseGetCommand(),
 			PhasedReleaseCreateCommand(),
 			PhasedReleaseUpdateCommand(),
+			PhasedReleasePauseCommand(),
+			PhasedReleaseResumeCommand(),
+			PhasedReleaseCompleteCommand(),
 			PhasedReleaseDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -0,0 +1,143 @@
+package versions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// phasedReleaseTransition describes a state change applied by a control subcommand.
+type phasedReleaseTransition struct {
+	name      string
+	shortHelp string
+	target    asc.PhasedReleaseState
+	from      []asc.PhasedReleaseState
+	// confirm requires --confirm because the transition cannot be undone.
+	confirm bool
+}
+
+var (
+	phasedReleasePause = phasedReleaseTransition{
+		name:      "pause",
+		shortHelp: "Pause an active phased release.",
+		target:    asc.PhasedReleaseStatePaused,
+		from:      []asc.PhasedReleaseState{asc.PhasedReleaseStateActive},
+	}
+	phasedReleaseResume = phasedReleaseTransition{
+		name:      "resume",
+		shortHelp: "Resume a paused phased release.",
+		target:    asc.PhasedReleaseStateActive,
+		from:      []asc.PhasedReleaseState{asc.PhasedReleaseStatePaused},
+	}
+	phasedReleaseComplete = phasedReleaseTransition{
+		name:      "complete",
+		shortHelp: "Release an active or paused phased release to all users.",
+		target:    asc.PhasedReleaseStateComplete,
+		from:      []asc.PhasedReleaseState{asc.PhasedReleaseStateActive, asc.PhasedReleaseStatePaused},
+		confirm:   true,
+	}
+)
+
+// allows reports whether the transition can be applied from the current state.
+func (t phasedReleaseTransition) allows(current asc.PhasedReleaseState) bool {
+	for _, state := range t.from {
+		if current == state {
+			return true
+		}
+	}
+	return false
+}
+
+// PhasedReleasePauseCommand returns the pause subcommand.
+func PhasedReleasePauseCommand() *ffcli.Command {
+	return phasedReleaseTransitionCommand(phasedReleasePause)
+}
+
+// PhasedReleaseResumeCommand returns the resume subcommand.
+func PhasedReleaseResumeCommand() *ffcli.Command {
+	return phasedReleaseTransitionCommand(phasedReleaseResume)
+}
+
+// PhasedReleaseCompleteCommand returns the complete subcommand.
+func PhasedReleaseCompleteCommand() *ffcli.Command {
+	return phasedReleaseTransitionCommand(phasedReleaseComplete)
+}
+
+func phasedReleaseTransitionCommand(transition phasedReleaseTransition) *ffcli.Command {
+	fs := flag.NewFlagSet("phased-release "+transition.name, flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	var confirm *bool
+	if transition.confirm {
+		confirm = fs.Bool("confirm", false, "Confirm releasing to all users (required)")
+	}
+	output := shared.BindOutputFlags(fs)
+
+	from := make([]string, 0, len(transition.from))
+	for _, state := range transition.from {
+		from = append(from, string(state))
+	}
+
+	example := fmt.Sprintf(`asc versions phased-release %s --version-id "VERSION_ID"`, transition.name)
+	if transition.confirm {
+		example += " --confirm"
+	}
+
+	return &ffcli.Command{
+		Name:       transition.name,
+		ShortUsage: fmt.Sprintf("asc versions phased-release %s [flags]", transition.name),
+		ShortHelp:  transition.shortHelp,
+		LongHelp: fmt.Sprintf(`%s
+
+Looks up the phased release for the version and sets its state to %s.
+The current state must be %s.
+
+Examples:
+  %s`, transition.shortHelp, transition.target, strings.Join(from, " or "), example),
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			version := strings.TrimSpace(*versionID)
+			if version == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			if confirm != nil && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("phased-release %s: %w", transition.name, err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			current, err := client.GetAppStoreVersionPhasedRelease(requestCtx, version)
+			if err != nil {
+				return fmt.Errorf("phased-release %s: %w", transition.name, err)
+			}
+
+			currentState := current.Data.Attributes.PhasedReleaseState
+			if !transition.allows(currentState) {
+				return fmt.Errorf("phased-release %s: phased release %q is %s; %s requires %s", transition.name, current.Data.ID, currentState, transition.name, strings.Join(from, " or "))
+			}
+
+			resp, err := client.UpdateAppStoreVersionPhasedRelease(requestCtx, current.Data.ID, transition.target)
+			if err != nil {
+				return fmt.Errorf("phased-release %s: %w", transition.name, err)
+			}
+
+			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+		},
+	}
+}
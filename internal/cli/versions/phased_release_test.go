@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestPhasedReleaseGetCommand_MissingVersion(t *testing.T) {
@@ -219,3 +221,45 @@ func TestPhasedReleaseCommand_DefaultOutputJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestPhasedReleaseTransitionCommands_MissingVersion(t *testing.T) {
+	for _, cmd := range []*ffcli.Command{
+		PhasedReleasePauseCommand(),
+		PhasedReleaseResumeCommand(),
+		PhasedReleaseCompleteCommand(),
+	} {
+		t.Run(cmd.Name, func(t *testing.T) {
+			if err := cmd.FlagSet.Parse([]string{}); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+
+			err := cmd.Exec(context.Background(), []string{})
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Errorf("expected flag.ErrHelp when --version-id is missing, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPhasedReleaseTransitions_Allows(t *testing.T) {
+	tests := []struct {
+		transition phasedReleaseTransition
+		current    asc.PhasedReleaseState
+		want       bool
+	}{
+		{phasedReleasePause, asc.PhasedReleaseStateActive, true},
+		{phasedReleasePause, asc.PhasedReleaseStatePaused, false},
+		{phasedReleasePause, asc.PhasedReleaseStateInactive, false},
+		{phasedReleaseResume, asc.PhasedReleaseStatePaused, true},
+		{phasedReleaseResume, asc.PhasedReleaseStateActive, false},
+		{phasedReleaseComplete, asc.PhasedReleaseStateActive, true},
+		{phasedReleaseComplete, asc.PhasedReleaseStatePaused, true},
+		{phasedReleaseComplete, asc.PhasedReleaseStateComplete, false},
+	}
+
+	for _, test := range tests {
+		if got := test.transition.allows(test.current); got != test.want {
+			t.Errorf("%s from %s: expected %t, got %t", test.transition.name, test.current, test.want, got)
+		}
+	}
+}
@@ -116,7 +116,7 @@ Examples:
 				// Fetch all remaining pages
 				versions, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersions(ctx, resolvedAppID, asc.WithAppStoreVersionsNextURL(nextURL))
-				})
+				}, shared.PaginateOptions()...)
 				if err != nil {
 					return fmt.Errorf("versions list: %w", err)
 				}
@@ -240,6 +240,8 @@ func VersionsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a new app store version.",
 		LongHelp: `Create a new app store version.
 
+Refuses to create a version string that already exists for the same platform.
+
 Examples:
   asc versions create --app "123456789" --version "2.0.0"
   asc versions create --app "123456789" --version "2.0.0" --platform IOS
@@ -271,9 +273,22 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			trimmedVersion := strings.TrimSpace(*versionString)
+			existing, err := client.GetAppStoreVersions(requestCtx, resolvedAppID,
+				asc.WithAppStoreVersionsVersionStrings([]string{trimmedVersion}),
+				asc.WithAppStoreVersionsPlatforms([]string{normalizedPlatform}),
+				asc.WithAppStoreVersionsLimit(1),
+			)
+			if err != nil {
+				return fmt.Errorf("versions create: check existing versions: %w", err)
+			}
+			if len(existing.Data) > 0 {
+				return fmt.Errorf("versions create: version %q already exists for platform %s (id %s): %w", trimmedVersion, normalizedPlatform, existing.Data[0].ID, asc.ErrConflict)
+			}
+
 			attrs := asc.AppStoreVersionCreateAttributes{
 				Platform:      asc.Platform(normalizedPlatform),
-				VersionString: strings.TrimSpace(*versionString),
+				VersionString: trimmedVersion,
 			}
 			if *copyright != "" {
 				attrs.Copyright = *copyright
@@ -438,6 +453,9 @@ func VersionsAttachBuildCommand() *ffcli.Command {
 		ShortHelp:  "Attach a build to an app store version.",
 		LongHelp: `Attach a build to an app store version.
 
+The build must belong to the same app as the version and have a VALID
+processing state.
+
 Examples:
   asc versions attach-build --version-id "VERSION_ID" --build "BUILD_ID"`,
 		FlagSet:   fs,
@@ -460,6 +478,10 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if err := validateBuildForVersion(requestCtx, client, strings.TrimSpace(*versionID), strings.TrimSpace(*buildID)); err != nil {
+				return fmt.Errorf("versions attach-build: %w", err)
+			}
+
 			if err := client.AttachBuildToVersion(requestCtx, strings.TrimSpace(*versionID), strings.TrimSpace(*buildID)); err != nil {
 				return fmt.Errorf("versions attach-build: %w", err)
 			}
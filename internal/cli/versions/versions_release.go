@@ -13,6 +13,16 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
+// releasableVersionState is the only state a manual release can start from.
+const releasableVersionState = "PENDING_DEVELOPER_RELEASE"
+
+func valueOrUnknown(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "unknown"
+	}
+	return value
+}
+
 // VersionsReleaseCommand releases a version in pending developer release.
 func VersionsReleaseCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("versions release", flag.ExitOnError)
@@ -27,6 +37,10 @@ func VersionsReleaseCommand() *ffcli.Command {
 		ShortHelp:  "Release an approved version pending developer release.",
 		LongHelp: `Release an approved version in the Pending Developer Release state.
 
+The version's current state is checked first; any state other than
+PENDING_DEVELOPER_RELEASE is refused. The state after the release request is
+included in the output.
+
 Examples:
   asc versions release --version-id "VERSION_ID" --confirm`,
 		FlagSet:   fs,
@@ -50,6 +64,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			current, err := client.GetAppStoreVersion(requestCtx, version)
+			if err != nil {
+				return fmt.Errorf("versions release: failed to fetch version: %w", err)
+			}
+			if state := shared.ResolveAppStoreVersionState(current.Data.Attributes); state != releasableVersionState {
+				return fmt.Errorf("versions release: version %s is in state %s; only %s versions can be released", version, valueOrUnknown(state), releasableVersionState)
+			}
+
 			resp, err := client.CreateAppStoreVersionReleaseRequest(requestCtx, version)
 			if err != nil {
 				return fmt.Errorf("versions release: %w", err)
@@ -59,6 +81,13 @@ Examples:
 				ReleaseRequestID: resp.Data.ID,
 				VersionID:        version,
 			}
+			// The release already went through, so a failed refresh only
+			// leaves the resulting state out of the output.
+			if updated, err := client.GetAppStoreVersion(requestCtx, version); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to fetch version state after release: %v\n", err)
+			} else {
+				result.State = shared.ResolveAppStoreVersionState(updated.Data.Attributes)
+			}
 
 			return shared.PrintOutput(result, *output.Output, *output.Pretty)
 		},
@@ -41,6 +41,17 @@ type reviewAttachmentDownloadResult struct {
 	MessageID         string `json:"messageId,omitempty"`
 	ReviewRejectionID string `json:"reviewRejectionId,omitempty"`
 	RefreshedURL      bool   `json:"refreshedUrl,omitempty"`
+	Skipped           bool   `json:"skipped,omitempty"`
+}
+
+// reviewAttachmentDownloadOptions controls where attachments land and how
+// existing files are handled. Without overwrite or skipExisting, existing
+// files are kept and new downloads get a numeric suffix.
+type reviewAttachmentDownloadOptions struct {
+	outDir       string
+	pattern      string
+	overwrite    bool
+	skipExisting bool
 }
 
 type reviewThreadDetails struct {
@@ -104,6 +115,24 @@ func filterSubmissionsByState(submissions []webcore.ReviewSubmission, states []s
 	return result
 }
 
+func filterSubmissionsByPlatform(submissions []webcore.ReviewSubmission, platforms []string) []webcore.ReviewSubmission {
+	if len(platforms) == 0 {
+		return submissions
+	}
+	allowed := make(map[string]struct{}, len(platforms))
+	for _, platform := range platforms {
+		allowed[strings.ToUpper(strings.TrimSpace(platform))] = struct{}{}
+	}
+	result := make([]webcore.ReviewSubmission, 0, len(submissions))
+	for _, submission := range submissions {
+		platform := strings.ToUpper(strings.TrimSpace(submission.Platform))
+		if _, ok := allowed[platform]; ok {
+			result = append(result, submission)
+		}
+	}
+	return result
+}
+
 func buildReviewListTableRows(submissions []webcore.ReviewSubmission) [][]string {
 	if len(submissions) == 0 {
 		return [][]string{}
@@ -162,6 +191,77 @@ func renderReviewListMarkdown(submissions []webcore.ReviewSubmission) error {
 	return nil
 }
 
+// reviewItemsConcurrency bounds the per-submission item fetches for
+// --include-items; the web client still paces the requests themselves.
+const reviewItemsConcurrency = 4
+
+type reviewSubmissionWithItems struct {
+	webcore.ReviewSubmission
+	Items []webcore.ReviewSubmissionItem `json:"items"`
+}
+
+// attachSubmissionItems fetches items for every submission with at most limit
+// requests in flight. Results keep the submissions' order.
+func attachSubmissionItems(
+	ctx context.Context,
+	submissions []webcore.ReviewSubmission,
+	limit int,
+	fetch func(ctx context.Context, reviewSubmissionID string) ([]webcore.ReviewSubmissionItem, error),
+) ([]reviewSubmissionWithItems, error) {
+	results := make([]reviewSubmissionWithItems, len(submissions))
+	err := shared.RunBounded(ctx, len(submissions), limit, func(ctx context.Context, index int) error {
+		submission := submissions[index]
+		items, err := fetch(ctx, submission.ID)
+		if err != nil {
+			return fmt.Errorf("submission %s items: %w", submission.ID, err)
+		}
+		if items == nil {
+			items = []webcore.ReviewSubmissionItem{}
+		}
+		results[index] = reviewSubmissionWithItems{ReviewSubmission: submission, Items: items}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func summarizeSubmissionItems(items []webcore.ReviewSubmissionItem) string {
+	if len(items) == 0 {
+		return "n/a"
+	}
+	parts := make([]string, 0, len(items))
+	for _, item := range items {
+		parts = append(parts, summarizeSubmissionItemRelated(item.Related))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func buildReviewListWithItemsTableRows(submissions []reviewSubmissionWithItems) [][]string {
+	plain := make([]webcore.ReviewSubmission, 0, len(submissions))
+	for _, submission := range submissions {
+		plain = append(plain, submission.ReviewSubmission)
+	}
+	rows := buildReviewListTableRows(plain)
+	for index := range rows {
+		rows[index] = append(rows[index], summarizeSubmissionItems(submissions[index].Items))
+	}
+	return rows
+}
+
+func renderReviewListWithItemsTable(submissions []reviewSubmissionWithItems) error {
+	headers := []string{"Submission ID", "State", "Submitted Date", "Version", "Platform", "Items"}
+	asc.RenderTable(headers, buildReviewListWithItemsTableRows(submissions))
+	return nil
+}
+
+func renderReviewListWithItemsMarkdown(submissions []reviewSubmissionWithItems) error {
+	headers := []string{"Submission ID", "State", "Submitted Date", "Version", "Platform", "Items"}
+	asc.RenderMarkdown(headers, buildReviewListWithItemsTableRows(submissions))
+	return nil
+}
+
 func normalizeReviewShowValue(value string) string {
 	value = strings.ReplaceAll(value, "\r\n", " ")
 	value = strings.ReplaceAll(value, "\n", " ")
@@ -446,40 +546,15 @@ func resolveShowOutDir(appID, submissionID, out string) string {
 	return filepath.Join(".asc", "web-review", sanitizePathPart(appID), sanitizePathPart(submissionID))
 }
 
-func sanitizeFilenamePart(value string) string {
-	trimmed := strings.TrimSpace(value)
-	if trimmed == "" {
-		return ""
-	}
-	var b strings.Builder
-	b.Grow(len(trimmed))
-	for _, r := range trimmed {
-		isASCIIAlpha := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
-		isDigit := r >= '0' && r <= '9'
-		switch {
-		case isASCIIAlpha || isDigit || r == '.' || r == '-' || r == '_':
-			b.WriteRune(r)
-		default:
-			b.WriteByte('_')
-		}
-	}
-	sanitized := strings.TrimSpace(b.String())
-	sanitized = strings.Trim(sanitized, "._-")
-	if sanitized == "" || sanitized == "." || sanitized == ".." {
-		return ""
-	}
-	return sanitized
-}
-
 func normalizeAttachmentFilename(attachment webcore.ReviewAttachment) string {
 	name := strings.TrimSpace(attachment.FileName)
 	if name != "" {
-		base := sanitizeFilenamePart(filepath.Base(name))
+		base := shared.SanitizeFilenamePart(filepath.Base(name))
 		if base != "" {
 			return base
 		}
 	}
-	id := sanitizeFilenamePart(strings.TrimSpace(attachment.AttachmentID))
+	id := shared.SanitizeFilenamePart(strings.TrimSpace(attachment.AttachmentID))
 	if id == "" {
 		id = "attachment"
 	}
@@ -603,23 +678,22 @@ func buildThreadDetails(ctx context.Context, client *webcore.Client, threads []w
 	return details, attachments, nil
 }
 
-func downloadAttachmentsForShow(
+func downloadReviewAttachments(
 	ctx context.Context,
 	client *webcore.Client,
 	attachments []webcore.ReviewAttachment,
 	submissionID string,
-	outDir string,
-	pattern string,
-	overwrite bool,
+	opts reviewAttachmentDownloadOptions,
 ) ([]reviewAttachmentDownloadResult, []string, error) {
+	outDir := opts.outDir
 	selected := make([]webcore.ReviewAttachment, 0, len(attachments))
 	for _, attachment := range attachments {
 		attachment.FileName = normalizeAttachmentFilename(attachment)
 		if !attachment.Downloadable || strings.TrimSpace(attachment.DownloadURL) == "" {
 			continue
 		}
-		if strings.TrimSpace(pattern) != "" {
-			matched, err := filepath.Match(pattern, attachment.FileName)
+		if strings.TrimSpace(opts.pattern) != "" {
+			matched, err := filepath.Match(opts.pattern, attachment.FileName)
 			if err != nil {
 				return nil, nil, shared.UsageErrorf("--pattern is invalid: %v", err)
 			}
@@ -641,6 +715,20 @@ func downloadAttachmentsForShow(
 	var refreshedIndex map[string]webcore.ReviewAttachment
 
 	for _, attachment := range selected {
+		if opts.skipExisting {
+			existingPath := filepath.Join(outDir, attachment.FileName)
+			if err := ensurePathWithinDir(outDir, existingPath); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", attachment.FileName, err))
+				continue
+			}
+			if _, err := os.Lstat(existingPath); err == nil {
+				result := attachmentDownloadResult(attachment, existingPath, false)
+				result.Skipped = true
+				results = append(results, result)
+				continue
+			}
+		}
+
 		body, statusCode, downloadErr := client.DownloadAttachment(ctx, attachment.DownloadURL)
 		refreshed := false
 
@@ -667,12 +755,12 @@ func downloadAttachmentsForShow(
 			continue
 		}
 
-		outputPath, err := resolveDownloadPath(outDir, attachment.FileName, overwrite)
+		outputPath, err := resolveDownloadPath(outDir, attachment.FileName, opts.overwrite)
 		if err != nil {
 			failures = append(failures, fmt.Sprintf("%s: %v", attachment.FileName, err))
 			continue
 		}
-		if err := os.WriteFile(outputPath, body, 0o600); err != nil {
+		if err := writeReviewAttachment(outputPath, body, opts.overwrite); err != nil {
 			failures = append(failures, fmt.Sprintf("%s: %v", attachment.FileName, err))
 			continue
 		}
@@ -681,6 +769,23 @@ func downloadAttachmentsForShow(
 	return results, failures, nil
 }
 
+// writeReviewAttachment writes body via temp file + rename and never follows
+// symlinks at the destination.
+func writeReviewAttachment(path string, body []byte, overwrite bool) error {
+	_, err := shared.SafeWriteFileNoSymlink(
+		path,
+		0o600,
+		overwrite,
+		".asc-review-attachment-*",
+		".asc-review-attachment-backup-*",
+		func(f *os.File) (int64, error) {
+			written, err := f.Write(body)
+			return int64(written), err
+		},
+	)
+	return err
+}
+
 // WebReviewCommand returns the detached web review command group.
 func WebReviewCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("web review", flag.ExitOnError)
@@ -695,8 +800,10 @@ App-centric review workflows over Apple web-session /iris endpoints.
 Use --app to scope all operations to one app.
 
 Subcommands:
-  list  List review submissions for an app
-  show  Show one submission with threads/messages/rejections and auto-download screenshots
+  list      List review submissions for an app
+  show      Show one submission with threads/messages/rejections and auto-download screenshots
+  download  Download all attachments for one submission into a directory
+  reply     Reply to App Review on a submission's Resolution Center thread
 
 ` + webWarningText,
 		FlagSet:   fs,
@@ -704,6 +811,8 @@ Subcommands:
 		Subcommands: []*ffcli.Command{
 			WebReviewListCommand(),
 			WebReviewShowCommand(),
+			WebReviewDownloadCommand(),
+			WebReviewReplyCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -717,24 +826,53 @@ func WebReviewListCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App ID")
 	stateCSV := fs.String("state", "", "Optional comma-separated state filter")
+	platformCSV := fs.String("platform", "", "Optional comma-separated platform filter: IOS, MAC_OS, TV_OS, VISION_OS")
+	filterState := fs.String("filter-state", "", "Alias for --state")
+	filterPlatform := fs.String("filter-platform", "", "Alias for --platform")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	includeItems := fs.Bool("include-items", false, "Fetch each submission's items and include their types and target IDs")
 	authFlags := bindWebSessionFlags(fs)
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "list",
-		ShortUsage: "asc web review list --app APP_ID [--state CSV] [flags]",
+		ShortUsage: "asc web review list --app APP_ID [--state CSV] [--platform CSV] [--paginate] [--include-items] [flags]",
 		ShortHelp:  "EXPERIMENTAL: List app review submissions.",
-		FlagSet:    fs,
-		UsageFunc:  shared.DefaultUsageFunc,
+		LongHelp: `EXPERIMENTAL / UNOFFICIAL / DISCOURAGED
+
+List review submissions for an app. Table and markdown output show "n/a" for
+missing versions, platforms, states, and dates.
+
+Examples:
+  asc web review list --app "123456789"
+  asc web review list --app "123456789" --filter-state UNRESOLVED_ISSUES --filter-platform IOS
+  asc web review list --app "123456789" --paginate --output table
+  asc web review list --app "123456789" --include-items --output table
+
+` + webWarningText,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedAppID := strings.TrimSpace(*appID)
 			if trimmedAppID == "" {
 				return shared.UsageError("--app is required")
 			}
-			states, err := parseSubmissionStates(*stateCSV)
+			stateValue, err := shared.ResolveFlagAlias("--state", *stateCSV, "--filter-state", *filterState)
 			if err != nil {
 				return err
 			}
+			platformValue, err := shared.ResolveFlagAlias("--platform", *platformCSV, "--filter-platform", *filterPlatform)
+			if err != nil {
+				return err
+			}
+			states, err := parseSubmissionStates(stateValue)
+			if err != nil {
+				return err
+			}
+			platforms, err := shared.NormalizeAppStoreVersionPlatforms(shared.SplitCSVUpper(platformValue))
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
 
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
@@ -745,11 +883,28 @@ func WebReviewListCommand() *ffcli.Command {
 			}
 			client := webcore.NewClient(session)
 
-			submissions, err := client.ListReviewSubmissions(requestCtx, trimmedAppID)
+			listSubmissions := client.ListReviewSubmissions
+			if *paginate {
+				listSubmissions = client.ListAllReviewSubmissions
+			}
+			submissions, err := listSubmissions(requestCtx, trimmedAppID)
 			if err != nil {
 				return withWebAuthHint(err, "web review list")
 			}
-			filtered := filterSubmissionsByState(submissions, states)
+			filtered := filterSubmissionsByPlatform(filterSubmissionsByState(submissions, states), platforms)
+			if *includeItems {
+				withItems, err := attachSubmissionItems(requestCtx, filtered, reviewItemsConcurrency, client.ListReviewSubmissionItems)
+				if err != nil {
+					return withWebAuthHint(err, "web review list")
+				}
+				return shared.PrintOutputWithRenderers(
+					withItems,
+					*output.Output,
+					*output.Pretty,
+					func() error { return renderReviewListWithItemsTable(withItems) },
+					func() error { return renderReviewListWithItemsMarkdown(withItems) },
+				)
+			}
 			return shared.PrintOutputWithRenderers(
 				filtered,
 				*output.Output,
@@ -840,14 +995,16 @@ Selection:
 				return withWebAuthHint(err, "web review show")
 			}
 			outDirResolved := resolveShowOutDir(trimmedAppID, selectedSubmission.ID, *outDir)
-			downloads, downloadFailures, err := downloadAttachmentsForShow(
+			downloads, downloadFailures, err := downloadReviewAttachments(
 				requestCtx,
 				client,
 				attachmentsWithURL,
 				selectedSubmission.ID,
-				outDirResolved,
-				trimmedPattern,
-				*overwrite,
+				reviewAttachmentDownloadOptions{
+					outDir:    outDirResolved,
+					pattern:   trimmedPattern,
+					overwrite: *overwrite,
+				},
 			)
 			if err != nil {
 				return err
@@ -884,3 +1041,136 @@ Selection:
 		},
 	}
 }
+
+type reviewDownloadOutput struct {
+	SubmissionID     string                           `json:"submissionId"`
+	OutputDirectory  string                           `json:"outputDirectory"`
+	Downloads        []reviewAttachmentDownloadResult `json:"downloads"`
+	DownloadFailures []string                         `json:"downloadFailures,omitempty"`
+}
+
+func buildReviewDownloadTableRows(payload reviewDownloadOutput) [][]string {
+	rows := make([][]string, 0, len(payload.Downloads)+len(payload.DownloadFailures))
+	for _, download := range payload.Downloads {
+		status := "downloaded"
+		if download.Skipped {
+			status = "skipped"
+		}
+		rows = append(rows, []string{download.AttachmentID, download.FileName, status, download.Path})
+	}
+	for _, failure := range payload.DownloadFailures {
+		rows = append(rows, []string{"", "", "failed", failure})
+	}
+	return rows
+}
+
+// WebReviewDownloadCommand downloads every attachment for a review submission.
+func WebReviewDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("web review download", flag.ExitOnError)
+
+	submissionID := fs.String("submission", "", "Review submission ID (required)")
+	outDir := fs.String("out", "", "Output directory (default: ./.asc/web-review/submissions/<submission>)")
+	pattern := fs.String("pattern", "", "Optional filename glob filter (for example: *.png)")
+	skipExisting := fs.Bool("skip-existing", false, "Skip attachments whose file already exists in the output directory")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files instead of suffixing")
+	authFlags := bindWebSessionFlags(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc web review download --submission ID [--out DIR] [--pattern GLOB] [--skip-existing | --overwrite] [flags]",
+		ShortHelp:  "EXPERIMENTAL: Download all attachments for a review submission.",
+		LongHelp: `EXPERIMENTAL / UNOFFICIAL / DISCOURAGED
+
+Download every downloadable attachment from a review submission's Resolution
+Center threads. Filenames are sanitized and files are written atomically.
+
+Existing files:
+  default          Keep the existing file and save the new one with a numeric suffix
+  --skip-existing  Leave existing files alone and skip the download (safe to re-run)
+  --overwrite      Replace existing files
+
+Examples:
+  asc web review download --submission "SUBMISSION_ID" --out ./review-files
+  asc web review download --submission "SUBMISSION_ID" --out ./review-files --skip-existing
+
+` + webWarningText,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedSubmissionID := strings.TrimSpace(*submissionID)
+			if trimmedSubmissionID == "" {
+				return shared.UsageError("--submission is required")
+			}
+			if *skipExisting && *overwrite {
+				return shared.UsageError("--skip-existing and --overwrite are mutually exclusive")
+			}
+			trimmedPattern := strings.TrimSpace(*pattern)
+			if trimmedPattern != "" {
+				if _, err := filepath.Match(trimmedPattern, "sample.png"); err != nil {
+					return shared.UsageErrorf("--pattern is invalid: %v", err)
+				}
+			}
+			outDirResolved := strings.TrimSpace(*outDir)
+			if outDirResolved == "" {
+				outDirResolved = filepath.Join(".asc", "web-review", "submissions", sanitizePathPart(trimmedSubmissionID))
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			session, err := resolveWebSessionForCommand(requestCtx, authFlags)
+			if err != nil {
+				return err
+			}
+			client := webcore.NewClient(session)
+
+			attachments, err := client.ListReviewAttachmentsBySubmission(requestCtx, trimmedSubmissionID, true)
+			if err != nil {
+				return withWebAuthHint(err, "web review download")
+			}
+			downloads, downloadFailures, err := downloadReviewAttachments(
+				requestCtx,
+				client,
+				attachments,
+				trimmedSubmissionID,
+				reviewAttachmentDownloadOptions{
+					outDir:       outDirResolved,
+					pattern:      trimmedPattern,
+					overwrite:    *overwrite,
+					skipExisting: *skipExisting,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			payload := reviewDownloadOutput{
+				SubmissionID:     trimmedSubmissionID,
+				OutputDirectory:  outDirResolved,
+				Downloads:        downloads,
+				DownloadFailures: downloadFailures,
+			}
+			headers := []string{"Attachment ID", "File", "Status", "Path"}
+			if err := shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error {
+					asc.RenderTable(headers, buildReviewDownloadTableRows(payload))
+					return nil
+				},
+				func() error {
+					asc.RenderMarkdown(headers, buildReviewDownloadTableRows(payload))
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+			if len(downloadFailures) > 0 {
+				return fmt.Errorf("web review download completed with %d download failure(s)", len(downloadFailures))
+			}
+			return nil
+		},
+	}
+}
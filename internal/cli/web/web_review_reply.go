@@ -0,0 +1,158 @@
+package web
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"html"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	webcore "github.com/rudrankriyam/App-Store-Connect-CLI/internal/web"
+)
+
+type reviewReplyOutput struct {
+	SubmissionID string                          `json:"submissionId"`
+	ThreadID     string                          `json:"threadId"`
+	Message      webcore.ResolutionCenterMessage `json:"message"`
+}
+
+// replyMessageBody converts plain text into the HTML body the resolution
+// center expects, escaping markup and keeping line breaks.
+func replyMessageBody(text string) string {
+	normalized := strings.ReplaceAll(strings.TrimSpace(text), "\r\n", "\n")
+	return strings.ReplaceAll(html.EscapeString(normalized), "\n", "<br>")
+}
+
+// selectReplyThread picks the thread to reply on. An explicit thread must
+// belong to the submission; otherwise exactly one thread must accept
+// developer notes.
+func selectReplyThread(threads []webcore.ResolutionCenterThread, submissionID, threadID string) (string, error) {
+	threadID = strings.TrimSpace(threadID)
+	if threadID != "" {
+		for _, thread := range threads {
+			if strings.TrimSpace(thread.ID) == threadID {
+				return threadID, nil
+			}
+		}
+		return "", fmt.Errorf("thread %q was not found for submission %q", threadID, submissionID)
+	}
+
+	candidates := make([]string, 0, len(threads))
+	for _, thread := range threads {
+		if thread.CanDeveloperAddNote {
+			candidates = append(candidates, strings.TrimSpace(thread.ID))
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("no resolution center thread accepts replies for submission %q", submissionID)
+	case 1:
+		return candidates[0], nil
+	default:
+		return "", fmt.Errorf("multiple threads accept replies for submission %q; pass --thread (one of: %s)", submissionID, strings.Join(candidates, ", "))
+	}
+}
+
+// WebReviewReplyCommand posts a developer reply on a review submission thread.
+func WebReviewReplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("web review reply", flag.ExitOnError)
+
+	submissionID := fs.String("submission", "", "Review submission ID (required)")
+	threadID := fs.String("thread", "", "Resolution center thread ID (default: the only thread accepting replies)")
+	message := fs.String("message", "", "Reply text")
+	messageFile := fs.String("message-file", "", "Read reply text from a file")
+	confirm := fs.Bool("confirm", false, "Confirm sending the reply to App Review (required)")
+	authFlags := bindWebSessionFlags(fs)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "reply",
+		ShortUsage: "asc web review reply --submission ID (--message TEXT | --message-file PATH) [--thread ID] --confirm [flags]",
+		ShortHelp:  "EXPERIMENTAL: Reply to App Review on a submission.",
+		LongHelp: `EXPERIMENTAL / UNOFFICIAL / DISCOURAGED
+
+Post a developer reply to the Resolution Center thread of a review submission.
+Text is sent as plain text: markup is escaped and line breaks are preserved.
+File attachments are not supported.
+
+Examples:
+  asc web review reply --submission "SUBMISSION_ID" --message "Fixed in 1.2.4" --confirm
+  asc web review reply --submission "SUBMISSION_ID" --message-file ./reply.txt --thread "THREAD_ID" --confirm
+
+` + webWarningText,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedSubmissionID := strings.TrimSpace(*submissionID)
+			if trimmedSubmissionID == "" {
+				return shared.UsageError("--submission is required")
+			}
+			text := *message
+			trimmedFile := strings.TrimSpace(*messageFile)
+			if strings.TrimSpace(text) != "" && trimmedFile != "" {
+				return shared.UsageError("--message and --message-file are mutually exclusive")
+			}
+			if trimmedFile != "" {
+				data, err := os.ReadFile(trimmedFile)
+				if err != nil {
+					return fmt.Errorf("web review reply: read --message-file: %w", err)
+				}
+				text = string(data)
+			}
+			if strings.TrimSpace(text) == "" {
+				return shared.UsageError("--message or --message-file is required")
+			}
+			if !*confirm {
+				return shared.UsageError("--confirm is required")
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			session, err := resolveWebSessionForCommand(requestCtx, authFlags)
+			if err != nil {
+				return err
+			}
+			client := webcore.NewClient(session)
+
+			threads, err := client.ListResolutionCenterThreadsBySubmission(requestCtx, trimmedSubmissionID)
+			if err != nil {
+				return withWebAuthHint(err, "web review reply")
+			}
+			selectedThreadID, err := selectReplyThread(threads, trimmedSubmissionID, *threadID)
+			if err != nil {
+				return err
+			}
+			created, err := client.CreateResolutionCenterMessage(requestCtx, selectedThreadID, replyMessageBody(text))
+			if err != nil {
+				return withWebAuthHint(err, "web review reply")
+			}
+
+			payload := reviewReplyOutput{
+				SubmissionID: trimmedSubmissionID,
+				ThreadID:     selectedThreadID,
+				Message:      *created,
+			}
+			headers := []string{"Submission ID", "Thread ID", "Message ID", "Created"}
+			rows := [][]string{{payload.SubmissionID, payload.ThreadID, created.ID, created.CreatedDate}}
+			return shared.PrintOutputWithRenderers(
+				payload,
+				*output.Output,
+				*output.Pretty,
+				func() error {
+					asc.RenderTable(headers, rows)
+					return nil
+				},
+				func() error {
+					asc.RenderMarkdown(headers, rows)
+					return nil
+				},
+			)
+		},
+	}
+}
@@ -1,8 +1,14 @@
 package web
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	webcore "github.com/rudrankriyam/App-Store-Connect-CLI/internal/web"
@@ -230,3 +236,198 @@ func assertRowEquals(t *testing.T, rows [][]string, section, field, value string
 	}
 	t.Fatalf("expected row section=%q field=%q value=%q", section, field, value)
 }
+
+type reviewAttachmentRoundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn reviewAttachmentRoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func newReviewAttachmentTestClient(t *testing.T, downloads *[]string) *webcore.Client {
+	t.Helper()
+	return webcore.NewClient(&webcore.AuthSession{
+		Client: &http.Client{
+			Transport: reviewAttachmentRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+				*downloads = append(*downloads, req.URL.Path)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("new:" + req.URL.Path)),
+					Header:     http.Header{},
+				}, nil
+			}),
+		},
+	})
+}
+
+func TestDownloadReviewAttachmentsSkipExisting(t *testing.T) {
+	outDir := t.TempDir()
+	existingPath := filepath.Join(outDir, "existing.png")
+	if err := os.WriteFile(existingPath, []byte("old"), 0o600); err != nil {
+		t.Fatalf("write existing file: %v", err)
+	}
+
+	var downloads []string
+	client := newReviewAttachmentTestClient(t, &downloads)
+	attachments := []webcore.ReviewAttachment{
+		{AttachmentID: "a1", FileName: "existing.png", Downloadable: true, DownloadURL: "https://files.apple.com/a1"},
+		{AttachmentID: "a2", FileName: "../fresh.png", Downloadable: true, DownloadURL: "https://files.apple.com/a2"},
+		{AttachmentID: "a3", FileName: "pending.png", Downloadable: false},
+	}
+
+	results, failures, err := downloadReviewAttachments(context.Background(), client, attachments, "SUB_1", reviewAttachmentDownloadOptions{
+		outDir:       outDir,
+		skipExisting: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(failures) != 0 {
+		t.Fatalf("unexpected failures: %v", failures)
+	}
+	if len(downloads) != 1 || downloads[0] != "/a2" {
+		t.Fatalf("expected only the missing attachment to be downloaded, got %v", downloads)
+	}
+	if len(results) != 2 || !results[0].Skipped || results[1].Skipped {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+
+	if data, _ := os.ReadFile(existingPath); string(data) != "old" {
+		t.Fatalf("expected existing file to be untouched, got %q", data)
+	}
+	freshPath := filepath.Join(outDir, "fresh.png")
+	if results[1].Path != freshPath {
+		t.Fatalf("expected sanitized destination %q, got %q", freshPath, results[1].Path)
+	}
+	if data, _ := os.ReadFile(freshPath); string(data) != "new:/a2" {
+		t.Fatalf("unexpected downloaded content %q", data)
+	}
+}
+
+func TestDownloadReviewAttachmentsSuffixesExistingByDefault(t *testing.T) {
+	outDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(outDir, "shot.png"), []byte("old"), 0o600); err != nil {
+		t.Fatalf("write existing file: %v", err)
+	}
+
+	var downloads []string
+	client := newReviewAttachmentTestClient(t, &downloads)
+	attachments := []webcore.ReviewAttachment{
+		{AttachmentID: "a1", FileName: "shot.png", Downloadable: true, DownloadURL: "https://files.apple.com/a1"},
+	}
+
+	results, failures, err := downloadReviewAttachments(context.Background(), client, attachments, "SUB_1", reviewAttachmentDownloadOptions{outDir: outDir})
+	if err != nil || len(failures) != 0 {
+		t.Fatalf("unexpected error %v / failures %v", err, failures)
+	}
+	if len(results) != 1 || results[0].Path != filepath.Join(outDir, "shot-1.png") {
+		t.Fatalf("expected suffixed destination, got %+v", results)
+	}
+}
+
+func TestReplyMessageBodyEscapesMarkupAndKeepsLineBreaks(t *testing.T) {
+	got := replyMessageBody("  Fixed <b>login</b> & crash.\r\nSee 1.2.4  ")
+	want := "Fixed &lt;b&gt;login&lt;/b&gt; &amp; crash.<br>See 1.2.4"
+	if got != want {
+		t.Fatalf("replyMessageBody() = %q, want %q", got, want)
+	}
+}
+
+func TestSelectReplyThread(t *testing.T) {
+	threads := []webcore.ResolutionCenterThread{
+		{ID: "closed", CanDeveloperAddNote: false},
+		{ID: "open", CanDeveloperAddNote: true},
+	}
+
+	if got, err := selectReplyThread(threads, "SUB_1", ""); err != nil || got != "open" {
+		t.Fatalf("expected the only open thread, got %q (%v)", got, err)
+	}
+	if got, err := selectReplyThread(threads, "SUB_1", "closed"); err != nil || got != "closed" {
+		t.Fatalf("expected explicit thread, got %q (%v)", got, err)
+	}
+	if _, err := selectReplyThread(threads, "SUB_1", "missing"); err == nil || !strings.Contains(err.Error(), `thread "missing" was not found`) {
+		t.Fatalf("expected unknown thread error, got %v", err)
+	}
+	if _, err := selectReplyThread(threads[:1], "SUB_1", ""); err == nil || !strings.Contains(err.Error(), "no resolution center thread accepts replies") {
+		t.Fatalf("expected no-open-thread error, got %v", err)
+	}
+
+	ambiguous := append(threads, webcore.ResolutionCenterThread{ID: "open-2", CanDeveloperAddNote: true})
+	if _, err := selectReplyThread(ambiguous, "SUB_1", ""); err == nil || !strings.Contains(err.Error(), "pass --thread (one of: open, open-2)") {
+		t.Fatalf("expected ambiguous thread error, got %v", err)
+	}
+}
+
+func TestFilterSubmissionsByPlatform(t *testing.T) {
+	submissions := []webcore.ReviewSubmission{
+		{ID: "ios", Platform: "IOS"},
+		{ID: "mac", Platform: "mac_os"},
+		{ID: "unknown"},
+	}
+
+	got := filterSubmissionsByPlatform(submissions, []string{"MAC_OS"})
+	if len(got) != 1 || got[0].ID != "mac" {
+		t.Fatalf("expected only the MAC_OS submission, got %#v", got)
+	}
+	if got := filterSubmissionsByPlatform(submissions, nil); len(got) != len(submissions) {
+		t.Fatalf("expected no filtering without platforms, got %#v", got)
+	}
+}
+
+func TestAttachSubmissionItemsKeepsOrderAndBoundsConcurrency(t *testing.T) {
+	submissions := []webcore.ReviewSubmission{{ID: "sub-1"}, {ID: "sub-2"}, {ID: "sub-3"}, {ID: "sub-4"}}
+	var inFlight, maxInFlight atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{}, len(submissions))
+
+	go func() {
+		// Let the first batch block briefly so the limit is observable.
+		for range 2 {
+			<-started
+		}
+		close(release)
+	}()
+
+	got, err := attachSubmissionItems(context.Background(), submissions, 2, func(ctx context.Context, id string) ([]webcore.ReviewSubmissionItem, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		started <- struct{}{}
+		<-release
+		return []webcore.ReviewSubmissionItem{{
+			ID:      "item-" + id,
+			Type:    "reviewSubmissionItems",
+			Related: []webcore.ReviewSubmissionItemRelation{{Relationship: "appStoreVersion", Type: "appStoreVersions", ID: "v-" + id}},
+		}}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if maxInFlight.Load() > 2 {
+		t.Fatalf("expected at most 2 concurrent fetches, saw %d", maxInFlight.Load())
+	}
+	for index, submission := range got {
+		if submission.ID != submissions[index].ID || len(submission.Items) != 1 || submission.Items[0].ID != "item-"+submission.ID {
+			t.Fatalf("unexpected result at %d: %#v", index, submission)
+		}
+	}
+
+	rows := buildReviewListWithItemsTableRows(got)
+	if len(rows) != 4 || rows[0][5] != "appStoreVersion:appStoreVersions:v-sub-1" {
+		t.Fatalf("expected items column in rows, got %#v", rows)
+	}
+}
+
+func TestAttachSubmissionItemsReturnsFetchError(t *testing.T) {
+	expected := errors.New("boom")
+	_, err := attachSubmissionItems(context.Background(), []webcore.ReviewSubmission{{ID: "sub-1"}}, 4, func(ctx context.Context, id string) ([]webcore.ReviewSubmissionItem, error) {
+		return nil, expected
+	})
+	if !errors.Is(err, expected) || !strings.Contains(err.Error(), "submission sub-1 items") {
+		t.Fatalf("expected wrapped fetch error, got %v", err)
+	}
+}
@@ -204,6 +204,7 @@ func xcodeCloudActionsList(ctx context.Context, runID string, limit int, next st
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetCiBuildActions(ctx, resolvedRunID, asc.WithCiBuildActionsNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud actions: %w", err)
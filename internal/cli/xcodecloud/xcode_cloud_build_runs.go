@@ -135,6 +135,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCiBuildRunBuilds(ctx, runIDValue, asc.WithCiBuildRunBuildsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud build-runs builds: %w", err)
@@ -189,6 +190,7 @@ func xcodeCloudBuildRunsList(ctx context.Context, workflowID string, limit int,
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetCiBuildRuns(ctx, resolvedWorkflowID, asc.WithCiBuildRunsNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud build-runs: %w", err)
@@ -219,6 +219,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCiProductBuildRuns(ctx, idValue, asc.WithCiBuildRunsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products build-runs: %w", err)
@@ -294,6 +295,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCiWorkflows(ctx, idValue, asc.WithCiWorkflowsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products workflows: %w", err)
@@ -369,6 +371,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCiProductPrimaryRepositories(ctx, idValue, asc.WithCiProductRepositoriesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products primary-repositories: %w", err)
@@ -444,6 +447,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCiProductAdditionalRepositories(ctx, idValue, asc.WithCiProductRepositoriesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud products additional-repositories: %w", err)
@@ -542,6 +546,7 @@ func xcodeCloudProductsList(ctx context.Context, appID string, limit int, next s
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetCiProducts(ctx, asc.WithCiProductsNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud products: %w", err)
@@ -720,6 +725,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCiMacOsVersionXcodeVersions(ctx, idValue, asc.WithCiXcodeVersionsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud macos-versions xcode-versions: %w", err)
@@ -768,6 +774,7 @@ func xcodeCloudMacOSVersionsList(ctx context.Context, limit int, next string, pa
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetCiMacOsVersions(ctx, asc.WithCiMacOsVersionsNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud macos-versions: %w", err)
@@ -936,6 +943,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCiXcodeVersionMacOsVersions(ctx, idValue, asc.WithCiMacOsVersionsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud xcode-versions macos-versions: %w", err)
@@ -984,6 +992,7 @@ func xcodeCloudXcodeVersionsList(ctx context.Context, limit int, next string, pa
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetCiXcodeVersions(ctx, asc.WithCiXcodeVersionsNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud xcode-versions: %w", err)
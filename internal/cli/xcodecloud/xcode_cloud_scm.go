@@ -208,6 +208,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetScmProviderRepositories(ctx, idValue, asc.WithScmRepositoriesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud scm providers repositories: %w", err)
@@ -374,6 +375,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetScmGitReferences(ctx, idValue, asc.WithScmGitReferencesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud scm repositories git-references: %w", err)
@@ -445,6 +447,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetScmRepositoryPullRequests(ctx, idValue, asc.WithScmPullRequestsNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud scm repositories pull-requests: %w", err)
@@ -540,6 +543,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetScmRepositoryGitReferencesRelationships(ctx, idValue, asc.WithLinkagesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud scm repositories relationships git-references: %w", err)
@@ -611,6 +615,7 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetScmRepositoryPullRequestsRelationships(ctx, idValue, asc.WithLinkagesNextURL(nextURL))
 					},
+					shared.PaginateOptions()...,
 				)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud scm repositories relationships pull-requests: %w", err)
@@ -788,6 +793,7 @@ func xcodeCloudScmProvidersList(ctx context.Context, limit int, next string, pag
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetScmProviders(ctx, asc.WithScmProvidersNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud scm providers: %w", err)
@@ -835,6 +841,7 @@ func xcodeCloudScmRepositoriesList(ctx context.Context, limit int, next string,
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetScmRepositories(ctx, asc.WithScmRepositoriesNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud scm repositories: %w", err)
@@ -357,6 +357,7 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsNextURL(nextURL))
 			},
+			shared.PaginateOptions()...,
 		)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud workflows: %w", err)
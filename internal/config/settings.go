@@ -0,0 +1,127 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// setting describes a config.json field that `asc config` can read and write.
+type setting struct {
+	key     string
+	aliases []string
+	get     func(*Config) string
+	set     func(*Config, string) error
+}
+
+func stringSetting(key string, field func(*Config) *string, aliases ...string) setting {
+	return setting{
+		key:     key,
+		aliases: aliases,
+		get:     func(c *Config) string { return *field(c) },
+		set: func(c *Config, value string) error {
+			*field(c) = value
+			return nil
+		},
+	}
+}
+
+func durationSetting(key string, field func(*Config) *DurationValue) setting {
+	return setting{
+		key: key,
+		get: func(c *Config) string { return field(c).String() },
+		set: func(c *Config, value string) error {
+			parsed, err := ParseDurationValue(value)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			*field(c) = parsed
+			return nil
+		},
+	}
+}
+
+// settings lists the non-credential defaults persisted in config.json.
+// Credentials are managed by `asc auth` instead.
+var settings = []setting{
+	stringSetting("app_id", func(c *Config) *string { return &c.AppID }, "app"),
+	stringSetting("vendor_number", func(c *Config) *string { return &c.VendorNumber }),
+	stringSetting("analytics_vendor_number", func(c *Config) *string { return &c.AnalyticsVendorNumber }),
+	durationSetting("timeout", func(c *Config) *DurationValue { return &c.Timeout }),
+	durationSetting("upload_timeout", func(c *Config) *DurationValue { return &c.UploadTimeout }),
+	stringSetting("max_retries", func(c *Config) *string { return &c.MaxRetries }),
+	stringSetting("base_delay", func(c *Config) *string { return &c.BaseDelay }),
+	stringSetting("max_delay", func(c *Config) *string { return &c.MaxDelay }),
+	stringSetting("retry_log", func(c *Config) *string { return &c.RetryLog }),
+	stringSetting("debug", func(c *Config) *string { return &c.Debug }),
+}
+
+// SettingKeys returns the keys accepted by GetSetting and SetSetting.
+func SettingKeys() []string {
+	keys := make([]string, 0, len(settings))
+	for _, s := range settings {
+		keys = append(keys, s.key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func lookupSetting(name string) (setting, error) {
+	normalized := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "-", "_")
+	for _, s := range settings {
+		if s.key == normalized {
+			return s, nil
+		}
+		for _, alias := range s.aliases {
+			if alias == normalized {
+				return s, nil
+			}
+		}
+	}
+	return setting{}, fmt.Errorf("unknown config key %q (valid keys: %s)", name, strings.Join(SettingKeys(), ", "))
+}
+
+// ResolveSettingKey returns the canonical key for a setting name or alias.
+func ResolveSettingKey(name string) (string, error) {
+	s, err := lookupSetting(name)
+	if err != nil {
+		return "", err
+	}
+	return s.key, nil
+}
+
+// GetSetting returns the stored value for a setting key or alias.
+func (c *Config) GetSetting(name string) (string, error) {
+	s, err := lookupSetting(name)
+	if err != nil {
+		return "", err
+	}
+	return s.get(c), nil
+}
+
+// SetSetting stores value for a setting key or alias; an empty value clears it.
+// The resulting config must still pass Validate.
+func (c *Config) SetSetting(name, value string) error {
+	s, err := lookupSetting(name)
+	if err != nil {
+		return err
+	}
+	updated := *c
+	if err := s.set(&updated, strings.TrimSpace(value)); err != nil {
+		return wrapInvalidConfig(err)
+	}
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+	*c = updated
+	return nil
+}
+
+// Settings returns all setting keys mapped to their stored values.
+func (c *Config) Settings() map[string]string {
+	values := make(map[string]string, len(settings))
+	for _, s := range settings {
+		values[s.key] = s.get(c)
+	}
+	return values
+}
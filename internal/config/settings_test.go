@@ -0,0 +1,50 @@
+package config
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetSettingAcceptsAliasesAndValidates(t *testing.T) {
+	cfg := &Config{}
+
+	if err := cfg.SetSetting("app", " 123456789 "); err != nil {
+		t.Fatalf("SetSetting(app) error: %v", err)
+	}
+	if cfg.AppID != "123456789" {
+		t.Fatalf("expected app_id to be set, got %q", cfg.AppID)
+	}
+	if got, err := cfg.GetSetting("app_id"); err != nil || got != "123456789" {
+		t.Fatalf("GetSetting(app_id) = %q, %v", got, err)
+	}
+
+	if err := cfg.SetSetting("upload-timeout", "2m"); err != nil {
+		t.Fatalf("SetSetting(upload-timeout) error: %v", err)
+	}
+	if got := cfg.UploadTimeout.String(); got != "2m" {
+		t.Fatalf("expected upload_timeout 2m, got %q", got)
+	}
+
+	if err := cfg.SetSetting("max_retries", "99"); !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected ErrInvalidConfig for out-of-range max_retries, got %v", err)
+	}
+	if cfg.MaxRetries != "" {
+		t.Fatalf("expected invalid value to leave config unchanged, got %q", cfg.MaxRetries)
+	}
+
+	if err := cfg.SetSetting("app", ""); err != nil {
+		t.Fatalf("SetSetting(app, \"\") error: %v", err)
+	}
+	if cfg.AppID != "" {
+		t.Fatalf("expected empty value to clear app_id, got %q", cfg.AppID)
+	}
+}
+
+func TestSettingRejectsUnknownAndCredentialKeys(t *testing.T) {
+	cfg := &Config{}
+	for _, key := range []string{"nope", "key_id", "private_key_path"} {
+		if _, err := cfg.GetSetting(key); err == nil {
+			t.Fatalf("expected error for key %q", key)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package validation
+
+// AllReport combines the App Store version, IAP, and subscription reports
+// into one submission gate.
+type AllReport struct {
+	AppID         string               `json:"appId"`
+	Summary       Summary              `json:"summary"`
+	AppStore      *Report              `json:"appStore"`
+	IAP           *IAPReport           `json:"iap"`
+	Subscriptions *SubscriptionsReport `json:"subscriptions"`
+	Strict        bool                 `json:"strict,omitempty"`
+}
+
+// CombineReports merges per-domain reports. The combined summary is the sum
+// of each section's summary, so Blocking covers every domain.
+func CombineReports(appStore *Report, iap *IAPReport, subscriptions *SubscriptionsReport, strict bool) AllReport {
+	report := AllReport{
+		AppStore:      appStore,
+		IAP:           iap,
+		Subscriptions: subscriptions,
+		Strict:        strict,
+	}
+	for _, section := range report.Sections() {
+		if report.AppID == "" {
+			report.AppID = section.AppID
+		}
+		report.Summary.Errors += section.Summary.Errors
+		report.Summary.Warnings += section.Summary.Warnings
+		report.Summary.Infos += section.Summary.Infos
+		report.Summary.Blocking += section.Summary.Blocking
+		report.Summary.Ignored += section.Summary.Ignored
+	}
+	return report
+}
+
+// AllReportSection is one domain of an AllReport.
+type AllReportSection struct {
+	Domain  string
+	AppID   string
+	Summary Summary
+	Checks  []CheckResult
+}
+
+// Sections returns the populated domains in a stable order.
+func (r *AllReport) Sections() []AllReportSection {
+	var sections []AllReportSection
+	if r.AppStore != nil {
+		sections = append(sections, AllReportSection{Domain: "appStore", AppID: r.AppStore.AppID, Summary: r.AppStore.Summary, Checks: r.AppStore.Checks})
+	}
+	if r.IAP != nil {
+		sections = append(sections, AllReportSection{Domain: "iap", AppID: r.IAP.AppID, Summary: r.IAP.Summary, Checks: r.IAP.Checks})
+	}
+	if r.Subscriptions != nil {
+		sections = append(sections, AllReportSection{Domain: "subscriptions", AppID: r.Subscriptions.AppID, Summary: r.Subscriptions.Summary, Checks: r.Subscriptions.Checks})
+	}
+	return sections
+}
@@ -0,0 +1,28 @@
+package validation
+
+import "testing"
+
+func TestCombineReports_SumsSectionSummaries(t *testing.T) {
+	appStore := &Report{AppID: "app-1", Summary: Summary{Errors: 1, Blocking: 1}}
+	iap := &IAPReport{AppID: "app-1", Summary: Summary{Warnings: 2, Blocking: 2, Ignored: 1}}
+	subscriptions := &SubscriptionsReport{AppID: "app-1", Summary: Summary{Infos: 1}}
+
+	report := CombineReports(appStore, iap, subscriptions, true)
+	if report.AppID != "app-1" {
+		t.Fatalf("expected app ID app-1, got %q", report.AppID)
+	}
+	want := Summary{Errors: 1, Warnings: 2, Infos: 1, Blocking: 3, Ignored: 1}
+	if report.Summary != want {
+		t.Fatalf("expected summary %+v, got %+v", want, report.Summary)
+	}
+	if sections := report.Sections(); len(sections) != 3 || sections[0].Domain != "appStore" {
+		t.Fatalf("unexpected sections: %+v", sections)
+	}
+}
+
+func TestCombineReports_SkipsMissingSections(t *testing.T) {
+	report := CombineReports(nil, &IAPReport{AppID: "app-1"}, nil, false)
+	if sections := report.Sections(); len(sections) != 1 || sections[0].Domain != "iap" {
+		t.Fatalf("unexpected sections: %+v", sections)
+	}
+}
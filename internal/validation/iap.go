@@ -12,12 +12,23 @@ type IAP struct {
 	ProductID string
 	Type      string
 	State     string
+	// HasPrice reports whether the IAP has a price schedule with at least
+	// one manual price.
+	HasPrice bool
+	// HasAvailability reports whether the IAP is available in at least one
+	// territory.
+	HasAvailability bool
+	// HasReviewScreenshot reports whether an App Store review screenshot is
+	// attached. Only checked for IAPs that still need review.
+	HasReviewScreenshot bool
+	Localizations       []ProductLocalization
 }
 
 // IAPInput collects in-app purchase validation inputs.
 type IAPInput struct {
-	AppID string
-	IAPs  []IAP
+	AppID         string
+	PrimaryLocale string
+	IAPs          []IAP
 }
 
 // IAPReport is the top-level validate iap output.
@@ -32,6 +43,10 @@ type IAPReport struct {
 // ValidateIAP validates IAP review readiness and returns a report.
 func ValidateIAP(input IAPInput, strict bool) IAPReport {
 	checks := iapReviewReadinessChecks(input.IAPs)
+	checks = append(checks, iapPricingChecks(input.IAPs)...)
+	checks = append(checks, iapLocalizationChecks(input.IAPs, input.PrimaryLocale)...)
+	checks = append(checks, iapReviewScreenshotChecks(input.IAPs)...)
+	sortChecks(checks)
 	summary := summarize(checks, strict)
 
 	return IAPReport{
@@ -46,29 +61,12 @@ func ValidateIAP(input IAPInput, strict bool) IAPReport {
 func iapReviewReadinessChecks(iaps []IAP) []CheckResult {
 	// These checks are warnings by default. Many apps have legacy IAPs that
 	// aren't relevant to a given release. Use --strict to gate in CI.
-	okStates := map[string]struct{}{
-		"APPROVED":                {},
-		"WAITING_FOR_REVIEW":      {},
-		"IN_REVIEW":               {},
-		"PENDING_BINARY_APPROVAL": {},
-	}
-	ignoreStates := map[string]struct{}{
-		"DEVELOPER_REMOVED_FROM_SALE": {},
-		"REMOVED_FROM_SALE":           {},
-	}
-
 	var checks []CheckResult
 	for _, iap := range iaps {
-		state := strings.ToUpper(strings.TrimSpace(iap.State))
-		if state == "" {
-			continue
-		}
-		if _, ok := okStates[state]; ok {
-			continue
-		}
-		if _, ok := ignoreStates[state]; ok {
+		if !IAPRequiresReview(iap.State) {
 			continue
 		}
+		state := strings.ToUpper(strings.TrimSpace(iap.State))
 
 		label := formatIAPLabel(iap)
 		message := fmt.Sprintf("%s is %s", label, state)
@@ -80,6 +78,7 @@ func iapReviewReadinessChecks(iaps []IAP) []CheckResult {
 			Field:        "state",
 			ResourceType: "inAppPurchaseV2",
 			ResourceID:   strings.TrimSpace(iap.ID),
+			ProductID:    strings.TrimSpace(iap.ProductID),
 			Message:      message,
 			Remediation:  remediation,
 		})
@@ -88,6 +87,98 @@ func iapReviewReadinessChecks(iaps []IAP) []CheckResult {
 	return checks
 }
 
+func iapPricingChecks(iaps []IAP) []CheckResult {
+	var checks []CheckResult
+	for _, iap := range iaps {
+		if isIAPRemovedFromSale(iap.State) {
+			continue
+		}
+		label := formatIAPLabel(iap)
+
+		if !iap.HasPrice {
+			checks = append(checks, CheckResult{
+				ID:           "iap.pricing.missing",
+				Severity:     SeverityError,
+				Field:        "priceSchedule",
+				ResourceType: "inAppPurchaseV2",
+				ResourceID:   strings.TrimSpace(iap.ID),
+				ProductID:    strings.TrimSpace(iap.ProductID),
+				Message:      fmt.Sprintf("%s has no price set", label),
+				Remediation:  "Create a price schedule with asc iap price-schedules create or in App Store Connect",
+			})
+		}
+		if !iap.HasAvailability {
+			checks = append(checks, CheckResult{
+				ID:           "iap.availability.empty",
+				Severity:     SeverityWarning,
+				Field:        "availability",
+				ResourceType: "inAppPurchaseV2",
+				ResourceID:   strings.TrimSpace(iap.ID),
+				ProductID:    strings.TrimSpace(iap.ProductID),
+				Message:      fmt.Sprintf("%s is not available in any territory", label),
+				Remediation:  "Set availability with asc iap availability set or in App Store Connect",
+			})
+		}
+	}
+
+	return checks
+}
+
+func iapLocalizationChecks(iaps []IAP, primaryLocale string) []CheckResult {
+	var checks []CheckResult
+	for _, iap := range iaps {
+		if isIAPRemovedFromSale(iap.State) {
+			continue
+		}
+		for _, check := range productLocalizationChecks("iap", "inAppPurchaseV2", iap.ID, formatIAPLabel(iap), iap.Localizations, primaryLocale) {
+			check.ProductID = strings.TrimSpace(iap.ProductID)
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+func iapReviewScreenshotChecks(iaps []IAP) []CheckResult {
+	var checks []CheckResult
+	for _, iap := range iaps {
+		if !IAPRequiresReview(iap.State) || iap.HasReviewScreenshot {
+			continue
+		}
+		checks = append(checks, CheckResult{
+			ID:           "iap.review_screenshot.missing",
+			Severity:     SeverityWarning,
+			Field:        "appStoreReviewScreenshot",
+			ResourceType: "inAppPurchaseV2",
+			ResourceID:   strings.TrimSpace(iap.ID),
+			ProductID:    strings.TrimSpace(iap.ProductID),
+			Message:      fmt.Sprintf("%s has no App Store review screenshot", formatIAPLabel(iap)),
+			Remediation:  "Upload a review screenshot with asc iap review-screenshots create or in App Store Connect",
+		})
+	}
+	return checks
+}
+
+// IAPRequiresReview reports whether an IAP in the given state still has to go
+// through App Review: it is neither approved, already submitted, nor removed
+// from sale.
+func IAPRequiresReview(state string) bool {
+	switch strings.ToUpper(strings.TrimSpace(state)) {
+	case "", "APPROVED", "WAITING_FOR_REVIEW", "IN_REVIEW", "PENDING_BINARY_APPROVAL":
+		return false
+	default:
+		return !isIAPRemovedFromSale(state)
+	}
+}
+
+func isIAPRemovedFromSale(state string) bool {
+	switch strings.ToUpper(strings.TrimSpace(state)) {
+	case "DEVELOPER_REMOVED_FROM_SALE", "REMOVED_FROM_SALE":
+		return true
+	default:
+		return false
+	}
+}
+
 func formatIAPLabel(iap IAP) string {
 	name := strings.TrimSpace(iap.Name)
 	productID := strings.TrimSpace(iap.ProductID)
@@ -41,3 +41,43 @@ func TestIAPReviewReadinessChecks_IgnoresRemovedFromSale(t *testing.T) {
 		t.Fatalf("expected no checks, got %d (%v)", len(checks), checks)
 	}
 }
+
+func TestIAPPricingChecks_MissingPriceBlocksAndEmptyAvailabilityWarns(t *testing.T) {
+	checks := iapPricingChecks([]IAP{
+		{ID: "iap-1", ProductID: "com.example.pro", State: "READY_TO_SUBMIT"},
+	})
+	if len(checks) != 2 {
+		t.Fatalf("expected 2 checks, got %d (%v)", len(checks), checks)
+	}
+	if checks[0].ID != "iap.pricing.missing" || checks[0].Severity != SeverityError {
+		t.Fatalf("expected blocking pricing check, got %+v", checks[0])
+	}
+	if checks[1].ID != "iap.availability.empty" || checks[1].Severity != SeverityWarning {
+		t.Fatalf("expected availability warning, got %+v", checks[1])
+	}
+}
+
+func TestIAPPricingChecks_SkipsPricedAndRemovedIAPs(t *testing.T) {
+	checks := iapPricingChecks([]IAP{
+		{ID: "iap-1", State: "APPROVED", HasPrice: true, HasAvailability: true},
+		{ID: "iap-2", State: "REMOVED_FROM_SALE"},
+	})
+	if len(checks) != 0 {
+		t.Fatalf("expected no checks, got %d (%v)", len(checks), checks)
+	}
+}
+
+func TestIAPReviewScreenshotChecks_OnlyForIAPsNeedingReview(t *testing.T) {
+	checks := iapReviewScreenshotChecks([]IAP{
+		{ID: "iap-1", State: "READY_TO_SUBMIT"},
+		{ID: "iap-2", State: "READY_TO_SUBMIT", HasReviewScreenshot: true},
+		{ID: "iap-3", State: "APPROVED"},
+		{ID: "iap-4", State: "REMOVED_FROM_SALE"},
+	})
+	if len(checks) != 1 || checks[0].ResourceID != "iap-1" {
+		t.Fatalf("expected one check for iap-1, got %v", checks)
+	}
+	if checks[0].ID != "iap.review_screenshot.missing" || checks[0].Severity != SeverityWarning {
+		t.Fatalf("unexpected check: %+v", checks[0])
+	}
+}
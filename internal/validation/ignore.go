@@ -0,0 +1,93 @@
+package validation
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// IgnoreRules suppresses known, accepted findings. Each rule has the form
+// "checkID:target", where target is matched against a check's product ID or
+// resource ID. Both parts accept path.Match wildcards, so "iap.*:*" ignores
+// every IAP finding and "iap.pricing.missing:com.example.pro" ignores one.
+type IgnoreRules []ignoreRule
+
+type ignoreRule struct {
+	check  string
+	target string
+}
+
+// ParseIgnoreRules reads one rule per line. Blank lines and lines starting
+// with "#" are skipped.
+func ParseIgnoreRules(r io.Reader) (IgnoreRules, error) {
+	var rules IgnoreRules
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		check, target, ok := strings.Cut(line, ":")
+		check = strings.TrimSpace(check)
+		target = strings.TrimSpace(target)
+		if !ok || check == "" || target == "" {
+			return nil, fmt.Errorf("line %d: expected check:productID or check:*, got %q", lineNumber, line)
+		}
+		for _, pattern := range []string{check, target} {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("line %d: invalid pattern %q: %w", lineNumber, pattern, err)
+			}
+		}
+		rules = append(rules, ignoreRule{check: check, target: target})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// LoadIgnoreFile parses ignore rules from a file.
+func LoadIgnoreFile(filePath string) (IgnoreRules, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read ignore file: %w", err)
+	}
+	defer file.Close()
+
+	rules, err := ParseIgnoreRules(file)
+	if err != nil {
+		return nil, fmt.Errorf("ignore file %s: %w", filePath, err)
+	}
+	return rules, nil
+}
+
+// Apply marks checks matched by the rules as ignored and returns the summary
+// recomputed without them, so Blocking only counts actionable findings.
+func (rules IgnoreRules) Apply(checks []CheckResult, strict bool) Summary {
+	for i := range checks {
+		if rules.matches(checks[i]) {
+			checks[i].Ignored = true
+		}
+	}
+	return summarize(checks, strict)
+}
+
+func (rules IgnoreRules) matches(check CheckResult) bool {
+	for _, rule := range rules {
+		if ok, _ := path.Match(rule.check, check.ID); !ok {
+			continue
+		}
+		for _, target := range []string{check.ProductID, check.ResourceID} {
+			if ok, _ := path.Match(rule.target, target); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
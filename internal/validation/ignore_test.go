@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseIgnoreRules_SkipsCommentsAndBlankLines(t *testing.T) {
+	rules, err := ParseIgnoreRules(strings.NewReader("# accepted\n\niap.pricing.missing:com.example.pro\n  subscriptions.*:*  \n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d (%v)", len(rules), rules)
+	}
+}
+
+func TestParseIgnoreRules_RejectsMalformedLines(t *testing.T) {
+	for _, input := range []string{"iap.pricing.missing", "iap.pricing.missing:", ":com.example.pro", "iap.[:*"} {
+		if _, err := ParseIgnoreRules(strings.NewReader(input)); err == nil {
+			t.Fatalf("expected error for %q", input)
+		}
+	}
+}
+
+func TestIgnoreRulesApply_MatchesProductAndWildcard(t *testing.T) {
+	rules, err := ParseIgnoreRules(strings.NewReader("iap.pricing.missing:com.example.pro\niap.availability.*:*\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checks := []CheckResult{
+		{ID: "iap.pricing.missing", Severity: SeverityError, ProductID: "com.example.pro"},
+		{ID: "iap.pricing.missing", Severity: SeverityError, ProductID: "com.example.coins"},
+		{ID: "iap.availability.empty", Severity: SeverityWarning, ProductID: "com.example.coins"},
+	}
+
+	summary := rules.Apply(checks, true)
+	if !checks[0].Ignored || checks[1].Ignored || !checks[2].Ignored {
+		t.Fatalf("unexpected ignored flags: %+v", checks)
+	}
+	if summary.Errors != 1 || summary.Warnings != 0 || summary.Blocking != 1 || summary.Ignored != 2 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
+
+func TestIgnoreRulesApply_NilRulesKeepSummary(t *testing.T) {
+	var rules IgnoreRules
+	checks := []CheckResult{{ID: "iap.pricing.missing", Severity: SeverityError}}
+	summary := rules.Apply(checks, false)
+	if summary.Blocking != 1 || summary.Ignored != 0 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
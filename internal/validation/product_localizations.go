@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProductLocalization is a display name/description localization for an IAP
+// or subscription.
+type ProductLocalization struct {
+	Locale      string
+	Name        string
+	Description string
+}
+
+// productLocalizationChecks flags products with no localizations, no
+// localization for the app's primary locale, or localizations missing a
+// display name or description. Apple requires both fields for review.
+func productLocalizationChecks(checkPrefix, resourceType, resourceID, label string, localizations []ProductLocalization, primaryLocale string) []CheckResult {
+	resourceID = strings.TrimSpace(resourceID)
+	primaryLocale = strings.TrimSpace(primaryLocale)
+
+	if len(localizations) == 0 {
+		return []CheckResult{{
+			ID:           checkPrefix + ".localization.missing",
+			Severity:     SeverityError,
+			Field:        "localizations",
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      fmt.Sprintf("%s has no localizations", label),
+			Remediation:  "Add a localization with a display name and description",
+		}}
+	}
+
+	var checks []CheckResult
+	if primaryLocale != "" {
+		found := false
+		for _, loc := range localizations {
+			if strings.EqualFold(strings.TrimSpace(loc.Locale), primaryLocale) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			checks = append(checks, CheckResult{
+				ID:           checkPrefix + ".localization.primary_locale_missing",
+				Severity:     SeverityError,
+				Locale:       primaryLocale,
+				Field:        "localizations",
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Message:      fmt.Sprintf("%s has no localization for the primary locale %s", label, primaryLocale),
+				Remediation:  fmt.Sprintf("Add a %s localization with a display name and description", primaryLocale),
+			})
+		}
+	}
+
+	for _, loc := range localizations {
+		locale := strings.TrimSpace(loc.Locale)
+		if strings.TrimSpace(loc.Name) == "" {
+			checks = append(checks, CheckResult{
+				ID:           checkPrefix + ".localization.name_missing",
+				Severity:     SeverityError,
+				Locale:       locale,
+				Field:        "name",
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Message:      fmt.Sprintf("%s is missing a display name for %s", label, locale),
+				Remediation:  "Set the display name for this localization",
+			})
+		}
+		if strings.TrimSpace(loc.Description) == "" {
+			checks = append(checks, CheckResult{
+				ID:           checkPrefix + ".localization.description_missing",
+				Severity:     SeverityError,
+				Locale:       locale,
+				Field:        "description",
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Message:      fmt.Sprintf("%s is missing a description for %s", label, locale),
+				Remediation:  "Set the description for this localization",
+			})
+		}
+	}
+
+	return checks
+}
@@ -0,0 +1,46 @@
+package validation
+
+import "testing"
+
+func TestProductLocalizationChecks_Complete(t *testing.T) {
+	checks := productLocalizationChecks("iap", "inAppPurchaseV2", "iap-1", "IAP", []ProductLocalization{
+		{Locale: "en-US", Name: "Pro", Description: "Unlock everything"},
+	}, "en-US")
+	if len(checks) != 0 {
+		t.Fatalf("expected no checks, got %d (%v)", len(checks), checks)
+	}
+}
+
+func TestProductLocalizationChecks_NoLocalizations(t *testing.T) {
+	checks := productLocalizationChecks("subscriptions", "subscription", "sub-1", "Subscription", nil, "en-US")
+	if len(checks) != 1 || checks[0].ID != "subscriptions.localization.missing" {
+		t.Fatalf("expected missing localization check, got %v", checks)
+	}
+	if checks[0].Severity != SeverityError {
+		t.Fatalf("expected error severity, got %s", checks[0].Severity)
+	}
+}
+
+func TestProductLocalizationChecks_PrimaryLocaleAndFields(t *testing.T) {
+	checks := productLocalizationChecks("iap", "inAppPurchaseV2", "iap-1", "IAP", []ProductLocalization{
+		{Locale: "de-DE", Name: "", Description: ""},
+	}, "en-US")
+	for _, id := range []string{
+		"iap.localization.primary_locale_missing",
+		"iap.localization.name_missing",
+		"iap.localization.description_missing",
+	} {
+		if !hasCheckID(checks, id) {
+			t.Fatalf("expected %s check, got %v", id, checks)
+		}
+	}
+}
+
+func TestProductLocalizationChecks_PrimaryLocaleCaseInsensitive(t *testing.T) {
+	checks := productLocalizationChecks("iap", "inAppPurchaseV2", "iap-1", "IAP", []ProductLocalization{
+		{Locale: "EN-us", Name: "Pro", Description: "Unlock everything"},
+	}, "en-US")
+	if len(checks) != 0 {
+		t.Fatalf("expected no checks, got %d (%v)", len(checks), checks)
+	}
+}
@@ -1,10 +1,15 @@
 package validation
 
+import (
+	"sort"
+	"strings"
+)
+
 // Validate runs all validation rules and returns a report.
 func Validate(input Input, strict bool) Report {
 	checks := make([]CheckResult, 0)
-	checks = append(checks, metadataLengthChecks(input.VersionLocalizations, input.AppInfoLocalizations)...)
-	checks = append(checks, requiredFieldChecks(input.PrimaryLocale, input.VersionString, input.VersionState, input.VersionLocalizations, input.AppInfoLocalizations)...)
+	checks = append(checks, scopeChecksToLocales(metadataLengthChecks(input.VersionLocalizations, input.AppInfoLocalizations), input.Locales)...)
+	checks = append(checks, scopeChecksToLocales(requiredFieldChecks(input.PrimaryLocale, input.VersionString, input.VersionState, input.AppStoreVersionCount, input.VersionLocalizations, input.AppInfoLocalizations), input.Locales)...)
 	checks = append(checks, reviewDetailsChecks(input.ReviewDetails)...)
 	checks = append(checks, categoryChecks(input.AppInfoID, input.PrimaryCategoryID)...)
 	checks = append(checks, buildChecks(input.Build)...)
@@ -13,6 +18,7 @@ func Validate(input Input, strict bool) Report {
 	checks = append(checks, screenshotPresenceChecks(input.PrimaryLocale, input.VersionLocalizations, input.ScreenshotSets)...)
 	checks = append(checks, screenshotChecks(input.Platform, input.ScreenshotSets)...)
 	checks = append(checks, ageRatingChecks(input.AgeRatingDeclaration)...)
+	sortChecks(checks)
 
 	summary := summarize(checks, strict)
 
@@ -27,9 +33,43 @@ func Validate(input Input, strict bool) Report {
 	}
 }
 
+// sortChecks orders checks by ID, severity (errors first), and message so
+// reports are stable across runs regardless of the order rules or concurrent
+// fetches produced them. The sort is stable, so checks that tie keep their
+// rule order (for example, per-locale findings stay in locale order).
+func sortChecks(checks []CheckResult) {
+	sort.SliceStable(checks, func(i, j int) bool {
+		a, b := checks[i], checks[j]
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		if ra, rb := severityRank(a.Severity), severityRank(b.Severity); ra != rb {
+			return ra < rb
+		}
+		return a.Message < b.Message
+	})
+}
+
+func severityRank(severity Severity) int {
+	switch severity {
+	case SeverityError:
+		return 0
+	case SeverityWarning:
+		return 1
+	case SeverityInfo:
+		return 2
+	default:
+		return 3
+	}
+}
+
 func summarize(checks []CheckResult, strict bool) Summary {
 	summary := Summary{}
 	for _, check := range checks {
+		if check.Ignored {
+			summary.Ignored++
+			continue
+		}
 		switch check.Severity {
 		case SeverityError:
 			summary.Errors++
@@ -45,3 +85,28 @@ func summarize(checks []CheckResult, strict bool) Summary {
 	}
 	return summary
 }
+
+// scopeChecksToLocales drops locale-specific findings for locales outside
+// locales. Findings without a locale are always kept, and an empty locales
+// list keeps everything.
+func scopeChecksToLocales(checks []CheckResult, locales []string) []CheckResult {
+	if len(locales) == 0 {
+		return checks
+	}
+	scoped := make([]CheckResult, 0, len(checks))
+	for _, check := range checks {
+		if strings.TrimSpace(check.Locale) == "" || containsLocale(locales, check.Locale) {
+			scoped = append(scoped, check)
+		}
+	}
+	return scoped
+}
+
+func containsLocale(locales []string, locale string) bool {
+	for _, candidate := range locales {
+		if strings.EqualFold(strings.TrimSpace(candidate), strings.TrimSpace(locale)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortChecks_OrdersByIDSeverityAndMessage(t *testing.T) {
+	checks := []CheckResult{
+		{ID: "b.check", Severity: SeverityWarning, Message: "z"},
+		{ID: "a.check", Severity: SeverityInfo, Message: "a"},
+		{ID: "b.check", Severity: SeverityError, Message: "y"},
+		{ID: "a.check", Severity: SeverityError, Message: "b"},
+		{ID: "a.check", Severity: SeverityError, Message: "a"},
+	}
+
+	sortChecks(checks)
+
+	want := []CheckResult{
+		{ID: "a.check", Severity: SeverityError, Message: "a"},
+		{ID: "a.check", Severity: SeverityError, Message: "b"},
+		{ID: "a.check", Severity: SeverityInfo, Message: "a"},
+		{ID: "b.check", Severity: SeverityError, Message: "y"},
+		{ID: "b.check", Severity: SeverityWarning, Message: "z"},
+	}
+	if !reflect.DeepEqual(checks, want) {
+		t.Fatalf("unexpected order:\n got %+v\nwant %+v", checks, want)
+	}
+}
+
+func TestSortChecks_KeepsOrderOfTies(t *testing.T) {
+	checks := []CheckResult{
+		{ID: "screenshots.missing", Severity: SeverityError, Message: "missing", Locale: "fr-FR"},
+		{ID: "screenshots.missing", Severity: SeverityError, Message: "missing", Locale: "de-DE"},
+		{ID: "screenshots.missing", Severity: SeverityError, Message: "missing", Locale: "en-US"},
+	}
+
+	sortChecks(checks)
+
+	for i, locale := range []string{"fr-FR", "de-DE", "en-US"} {
+		if checks[i].Locale != locale {
+			t.Fatalf("checks[%d].Locale = %q, want %q", i, checks[i].Locale, locale)
+		}
+	}
+}
+
+func TestValidate_ReturnsSortedChecks(t *testing.T) {
+	report := Validate(Input{AppID: "app-1", VersionID: "ver-1"}, false)
+	if len(report.Checks) < 2 {
+		t.Fatalf("expected several checks for empty input, got %d", len(report.Checks))
+	}
+
+	sorted := append([]CheckResult(nil), report.Checks...)
+	sortChecks(sorted)
+	if !reflect.DeepEqual(report.Checks, sorted) {
+		t.Fatalf("expected report checks to be sorted, got %+v", report.Checks)
+	}
+}
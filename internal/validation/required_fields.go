@@ -6,7 +6,7 @@ import (
 	"strings"
 )
 
-func requiredFieldChecks(primaryLocale string, versionString string, versionState string, versionLocs []VersionLocalization, appInfoLocs []AppInfoLocalization) []CheckResult {
+func requiredFieldChecks(primaryLocale string, versionString string, versionState string, versionCount int, versionLocs []VersionLocalization, appInfoLocs []AppInfoLocalization) []CheckResult {
 	var checks []CheckResult
 
 	normalizedState := strings.ToUpper(strings.TrimSpace(versionState))
@@ -50,11 +50,10 @@ func requiredFieldChecks(primaryLocale string, versionString string, versionStat
 		}
 	}
 
-	// Apple doesn't support a "What's New" section on initial App Store releases.
-	// In practice, these initial releases commonly use versionString "1.0" (or
-	// equivalent like "1.0.0"), and attempting to set `whatsNew` is rejected by
-	// the API. Avoid warning users for an uneditable field.
-	skipWhatsNew := isInitialReleaseVersionString(versionString)
+	// Apple doesn't support a "What's New" section on initial App Store releases,
+	// and attempting to set `whatsNew` is rejected by the API. Avoid warning
+	// users for an uneditable field.
+	skipWhatsNew := isFirstVersion(versionString, versionCount)
 
 	for _, loc := range versionLocs {
 		if strings.TrimSpace(loc.Description) == "" {
@@ -190,6 +189,18 @@ func hasLocaleAppInfo(localizations []AppInfoLocalization, locale string) bool {
 	return false
 }
 
+// isFirstVersion reports whether the version is the app's first App Store
+// release. When the number of App Store versions is known it is authoritative;
+// otherwise fall back to the version string heuristic.
+func isFirstVersion(versionString string, versionCount int) bool {
+	if versionCount > 0 {
+		return versionCount == 1
+	}
+	return isInitialReleaseVersionString(versionString)
+}
+
+// isInitialReleaseVersionString reports whether the version string looks like
+// an initial release. These commonly use "1.0" (or equivalents like "1.0.0").
 func isInitialReleaseVersionString(versionString string) bool {
 	trimmed := strings.TrimSpace(versionString)
 	if trimmed == "" {
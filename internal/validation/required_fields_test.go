@@ -3,7 +3,7 @@ package validation
 import "testing"
 
 func TestRequiredFieldChecks_MissingPrimaryLocale(t *testing.T) {
-	checks := requiredFieldChecks("en-US", "1.2.3", "PREPARE_FOR_SUBMISSION", []VersionLocalization{
+	checks := requiredFieldChecks("en-US", "1.2.3", "PREPARE_FOR_SUBMISSION", 0, []VersionLocalization{
 		{Locale: "fr-FR", Description: "desc", Keywords: "kw", SupportURL: "https://example.com"},
 	}, []AppInfoLocalization{
 		{Locale: "fr-FR", Name: "Name", PrivacyPolicyURL: "https://example.com/privacy"},
@@ -15,7 +15,7 @@ func TestRequiredFieldChecks_MissingPrimaryLocale(t *testing.T) {
 }
 
 func TestRequiredFieldChecks_MissingFields(t *testing.T) {
-	checks := requiredFieldChecks("", "1.2.3", "PREPARE_FOR_SUBMISSION", []VersionLocalization{
+	checks := requiredFieldChecks("", "1.2.3", "PREPARE_FOR_SUBMISSION", 0, []VersionLocalization{
 		{Locale: "en-US"},
 	}, []AppInfoLocalization{
 		{Locale: "en-US"},
@@ -36,7 +36,7 @@ func TestRequiredFieldChecks_MissingFields(t *testing.T) {
 }
 
 func TestRequiredFieldChecks_SkipsWhatsNewOnInitialRelease(t *testing.T) {
-	checks := requiredFieldChecks("", "1.0", "PREPARE_FOR_SUBMISSION", []VersionLocalization{
+	checks := requiredFieldChecks("", "1.0", "PREPARE_FOR_SUBMISSION", 0, []VersionLocalization{
 		{Locale: "en-US", Description: "desc", Keywords: "kw", SupportURL: "https://example.com"},
 	}, []AppInfoLocalization{
 		{Locale: "en-US", Name: "Name", PrivacyPolicyURL: "https://example.com/privacy"},
@@ -48,7 +48,7 @@ func TestRequiredFieldChecks_SkipsWhatsNewOnInitialRelease(t *testing.T) {
 }
 
 func TestRequiredFieldChecks_WarnsWhatsNewOnUpdateRelease(t *testing.T) {
-	checks := requiredFieldChecks("", "1.0.1", "PREPARE_FOR_SUBMISSION", []VersionLocalization{
+	checks := requiredFieldChecks("", "1.0.1", "PREPARE_FOR_SUBMISSION", 0, []VersionLocalization{
 		{Locale: "en-US", Description: "desc", Keywords: "kw", SupportURL: "https://example.com"},
 	}, []AppInfoLocalization{
 		{Locale: "en-US", Name: "Name", PrivacyPolicyURL: "https://example.com/privacy"},
@@ -60,7 +60,7 @@ func TestRequiredFieldChecks_WarnsWhatsNewOnUpdateRelease(t *testing.T) {
 }
 
 func TestRequiredFieldChecks_FailsForNonEditableVersionState(t *testing.T) {
-	checks := requiredFieldChecks("", "1.2.3", "WAITING_FOR_REVIEW", []VersionLocalization{
+	checks := requiredFieldChecks("", "1.2.3", "WAITING_FOR_REVIEW", 0, []VersionLocalization{
 		{Locale: "en-US", Description: "desc", Keywords: "kw", SupportURL: "https://example.com"},
 	}, []AppInfoLocalization{
 		{Locale: "en-US", Name: "Name", PrivacyPolicyURL: "https://example.com/privacy"},
@@ -72,7 +72,7 @@ func TestRequiredFieldChecks_FailsForNonEditableVersionState(t *testing.T) {
 }
 
 func TestRequiredFieldChecks_WarnsWhenPrivacyPolicyMissing(t *testing.T) {
-	checks := requiredFieldChecks("", "1.2.3", "PREPARE_FOR_SUBMISSION", []VersionLocalization{
+	checks := requiredFieldChecks("", "1.2.3", "PREPARE_FOR_SUBMISSION", 0, []VersionLocalization{
 		{Locale: "en-US", Description: "desc", Keywords: "kw", SupportURL: "https://example.com"},
 	}, []AppInfoLocalization{
 		{Locale: "en-US", Name: "Name", Subtitle: "Subtitle"},
@@ -82,3 +82,66 @@ func TestRequiredFieldChecks_WarnsWhenPrivacyPolicyMissing(t *testing.T) {
 		t.Fatalf("expected privacy policy check")
 	}
 }
+
+func TestRequiredFieldChecks_WarnsWhatsNewWhenPriorVersionsExist(t *testing.T) {
+	checks := requiredFieldChecks("", "1.0", "PREPARE_FOR_SUBMISSION", 2, []VersionLocalization{
+		{Locale: "en-US", Description: "desc", Keywords: "kw", SupportURL: "https://example.com"},
+	}, []AppInfoLocalization{
+		{Locale: "en-US", Name: "Name", PrivacyPolicyURL: "https://example.com/privacy"},
+	})
+
+	if !hasCheckID(checks, "metadata.required.whats_new") {
+		t.Fatalf("expected whatsNew warning when the app has prior versions")
+	}
+}
+
+func TestRequiredFieldChecks_SkipsWhatsNewForOnlyVersion(t *testing.T) {
+	checks := requiredFieldChecks("", "2.3", "PREPARE_FOR_SUBMISSION", 1, []VersionLocalization{
+		{Locale: "en-US", Description: "desc", Keywords: "kw", SupportURL: "https://example.com"},
+	}, []AppInfoLocalization{
+		{Locale: "en-US", Name: "Name", PrivacyPolicyURL: "https://example.com/privacy"},
+	})
+
+	if hasCheckID(checks, "metadata.required.whats_new") {
+		t.Fatalf("did not expect whatsNew warning for the app's only version")
+	}
+}
+
+func TestValidate_LocalesScopeLocalizationFindings(t *testing.T) {
+	report := Validate(Input{
+		PrimaryLocale: "en-US",
+		Locales:       []string{"fr-FR"},
+		VersionLocalizations: []VersionLocalization{
+			{Locale: "en-US"},
+			{Locale: "fr-FR", Description: "desc", SupportURL: "https://example.com"},
+		},
+		AppInfoLocalizations: []AppInfoLocalization{
+			{Locale: "en-US"},
+			{Locale: "fr-FR", Name: "Nom", Subtitle: "Sous-titre", PrivacyPolicyURL: "https://example.com/privacy"},
+		},
+	}, false)
+
+	for _, check := range report.Checks {
+		if check.Locale == "en-US" {
+			t.Fatalf("expected en-US findings to be omitted, got %+v", check)
+		}
+	}
+	found := false
+	for _, check := range report.Checks {
+		if check.ID == "metadata.required.keywords" && check.Locale == "fr-FR" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected fr-FR keywords finding, got %+v", report.Checks)
+	}
+	hasGlobal := false
+	for _, check := range report.Checks {
+		if check.Locale == "" {
+			hasGlobal = true
+		}
+	}
+	if !hasGlobal {
+		t.Fatalf("expected locale-independent findings to be kept, got %+v", report.Checks)
+	}
+}
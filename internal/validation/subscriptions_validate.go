@@ -2,22 +2,61 @@ package validation
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 )
 
 // Subscription represents an auto-renewable subscription for review-readiness validation.
 type Subscription struct {
-	ID        string
-	Name      string
-	ProductID string
-	State     string
-	GroupID   string
+	ID            string
+	Name          string
+	ProductID     string
+	State         string
+	GroupID       string
+	Localizations []ProductLocalization
+	// PricingChecked is set when territories and prices were fetched, which
+	// only happens for the optional price-consistency check.
+	PricingChecked bool
+	Territories    []string
+	Prices         []SubscriptionTerritoryPrice
+	// IntroductoryOffers holds every configured introductory offer, one per
+	// territory as returned by App Store Connect.
+	IntroductoryOffers []IntroductoryOffer
+}
+
+// IntroductoryOffer is a subscription introductory offer. EndDate uses the
+// App Store Connect YYYY-MM-DD format and is empty for open-ended offers.
+type IntroductoryOffer struct {
+	ID      string
+	EndDate string
+}
+
+// SubscriptionTerritoryPrice is a scheduled subscription price in one territory.
+// CustomerPrice is empty when the price has no resolvable price point (tier).
+type SubscriptionTerritoryPrice struct {
+	Territory     string
+	PricePointID  string
+	CustomerPrice string
+}
+
+// SubscriptionGracePeriod is the app's billing grace period configuration.
+// App Store Connect configures it per app, not per subscription.
+type SubscriptionGracePeriod struct {
+	ID    string
+	OptIn bool
 }
 
 // SubscriptionsInput collects subscription validation inputs.
 type SubscriptionsInput struct {
 	AppID         string
+	PrimaryLocale string
 	Subscriptions []Subscription
+	// GracePeriod is nil when the configuration could not be read.
+	GracePeriod *SubscriptionGracePeriod
+	// AsOf is the date offers are checked against; expiry is not checked
+	// when it is zero.
+	AsOf time.Time
 }
 
 // SubscriptionsReport is the top-level validate subscriptions output.
@@ -32,6 +71,12 @@ type SubscriptionsReport struct {
 // ValidateSubscriptions validates subscription review readiness and returns a report.
 func ValidateSubscriptions(input SubscriptionsInput, strict bool) SubscriptionsReport {
 	checks := subscriptionReviewReadinessChecks(input.Subscriptions)
+	checks = append(checks, subscriptionMetadataChecks(input.Subscriptions, input.PrimaryLocale)...)
+	checks = append(checks, subscriptionLocalizationChecks(input.Subscriptions, input.PrimaryLocale)...)
+	checks = append(checks, subscriptionPricingChecks(input.Subscriptions)...)
+	checks = append(checks, subscriptionGracePeriodChecks(input.Subscriptions, input.GracePeriod)...)
+	checks = append(checks, subscriptionIntroductoryOfferChecks(input.Subscriptions, input.AsOf)...)
+	sortChecks(checks)
 	summary := summarize(checks, strict)
 
 	return SubscriptionsReport{
@@ -52,11 +97,6 @@ func subscriptionReviewReadinessChecks(subs []Subscription) []CheckResult {
 		"IN_REVIEW":               {},
 		"PENDING_BINARY_APPROVAL": {},
 	}
-	ignoreStates := map[string]struct{}{
-		"DEVELOPER_REMOVED_FROM_SALE": {},
-		"REMOVED_FROM_SALE":           {},
-	}
-
 	var checks []CheckResult
 	for _, sub := range subs {
 		state := strings.ToUpper(strings.TrimSpace(sub.State))
@@ -66,7 +106,9 @@ func subscriptionReviewReadinessChecks(subs []Subscription) []CheckResult {
 		if _, ok := okStates[state]; ok {
 			continue
 		}
-		if _, ok := ignoreStates[state]; ok {
+		if isSubscriptionRemovedFromSale(state) || state == "MISSING_METADATA" {
+			// MISSING_METADATA is reported as a blocking error by
+			// subscriptionMetadataChecks.
 			continue
 		}
 
@@ -80,6 +122,7 @@ func subscriptionReviewReadinessChecks(subs []Subscription) []CheckResult {
 			Field:        "state",
 			ResourceType: "subscription",
 			ResourceID:   strings.TrimSpace(sub.ID),
+			ProductID:    strings.TrimSpace(sub.ProductID),
 			Message:      message,
 			Remediation:  remediation,
 		})
@@ -88,6 +131,266 @@ func subscriptionReviewReadinessChecks(subs []Subscription) []CheckResult {
 	return checks
 }
 
+func subscriptionLocalizationChecks(subs []Subscription, primaryLocale string) []CheckResult {
+	var checks []CheckResult
+	for _, sub := range subs {
+		if isSubscriptionRemovedFromSale(sub.State) {
+			continue
+		}
+		for _, check := range productLocalizationChecks("subscriptions", "subscription", sub.ID, formatSubscriptionLabel(sub), sub.Localizations, primaryLocale) {
+			check.ProductID = strings.TrimSpace(sub.ProductID)
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+// subscriptionMetadataChecks reports subscriptions that cannot be submitted
+// because App Store Connect considers their metadata incomplete: anything in
+// MISSING_METADATA, and anything waiting for review whose fetched data has
+// gaps. The message names the likely cause when it can be inferred.
+func subscriptionMetadataChecks(subs []Subscription, primaryLocale string) []CheckResult {
+	var checks []CheckResult
+	for _, sub := range subs {
+		state := strings.ToUpper(strings.TrimSpace(sub.State))
+		gaps := subscriptionMetadataGaps(sub, primaryLocale)
+		label := formatSubscriptionLabel(sub)
+
+		switch {
+		case state == "MISSING_METADATA":
+			message := fmt.Sprintf("%s is MISSING_METADATA", label)
+			if len(gaps) > 0 {
+				message += ": likely " + strings.Join(gaps, ", ")
+			} else {
+				message += ": check its review screenshot, price, and localizations"
+			}
+			checks = append(checks, CheckResult{
+				ID:           "subscriptions.metadata.missing",
+				Severity:     SeverityError,
+				Field:        "state",
+				ResourceType: "subscription",
+				ResourceID:   strings.TrimSpace(sub.ID),
+				ProductID:    strings.TrimSpace(sub.ProductID),
+				Message:      message,
+				Remediation:  "Add the missing metadata in App Store Connect; the subscription cannot be submitted until its state leaves MISSING_METADATA",
+			})
+		case state == "WAITING_FOR_REVIEW" && len(gaps) > 0:
+			checks = append(checks, CheckResult{
+				ID:           "subscriptions.metadata.incomplete",
+				Severity:     SeverityError,
+				Field:        "state",
+				ResourceType: "subscription",
+				ResourceID:   strings.TrimSpace(sub.ID),
+				ProductID:    strings.TrimSpace(sub.ProductID),
+				Message:      fmt.Sprintf("%s is WAITING_FOR_REVIEW but incomplete: %s", label, strings.Join(gaps, ", ")),
+				Remediation:  "Complete the missing metadata so the subscription is not rejected in review",
+			})
+		}
+	}
+	return checks
+}
+
+// subscriptionMetadataGaps lists the incomplete metadata detectable from the
+// fetched data. Pricing gaps are only known when pricing was fetched.
+func subscriptionMetadataGaps(sub Subscription, primaryLocale string) []string {
+	var gaps []string
+	if len(sub.Localizations) == 0 {
+		gaps = append(gaps, "no localizations")
+	} else if locale := strings.TrimSpace(primaryLocale); locale != "" {
+		found := false
+		for _, loc := range sub.Localizations {
+			if strings.EqualFold(strings.TrimSpace(loc.Locale), locale) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			gaps = append(gaps, fmt.Sprintf("no %s localization", locale))
+		}
+	}
+	if sub.PricingChecked {
+		if len(sub.Territories) == 0 {
+			gaps = append(gaps, "not available in any territory")
+		}
+		priced := false
+		for _, price := range sub.Prices {
+			if strings.TrimSpace(price.CustomerPrice) != "" {
+				priced = true
+				break
+			}
+		}
+		if !priced {
+			gaps = append(gaps, "no price")
+		}
+	}
+	return gaps
+}
+
+// subscriptionPricingChecks warns about available territories without a price
+// and prices without a tier. They stay warnings because pricing strategy
+// varies legitimately across territories.
+func subscriptionPricingChecks(subs []Subscription) []CheckResult {
+	var checks []CheckResult
+	for _, sub := range subs {
+		if !sub.PricingChecked || isSubscriptionRemovedFromSale(sub.State) {
+			continue
+		}
+		label := formatSubscriptionLabel(sub)
+
+		priced := make(map[string]struct{}, len(sub.Prices))
+		var missingTier []string
+		for _, price := range sub.Prices {
+			territory := strings.ToUpper(strings.TrimSpace(price.Territory))
+			if strings.TrimSpace(price.PricePointID) == "" || strings.TrimSpace(price.CustomerPrice) == "" {
+				missingTier = append(missingTier, territory)
+				continue
+			}
+			priced[territory] = struct{}{}
+		}
+
+		var missingPrice []string
+		for _, territory := range sub.Territories {
+			territory = strings.ToUpper(strings.TrimSpace(territory))
+			if _, ok := priced[territory]; !ok {
+				missingPrice = append(missingPrice, territory)
+			}
+		}
+
+		if len(missingPrice) > 0 {
+			sort.Strings(missingPrice)
+			checks = append(checks, CheckResult{
+				ID:           "subscriptions.pricing.territory_missing",
+				Severity:     SeverityWarning,
+				Field:        "prices",
+				ResourceType: "subscription",
+				ResourceID:   strings.TrimSpace(sub.ID),
+				ProductID:    strings.TrimSpace(sub.ProductID),
+				Message:      fmt.Sprintf("%s has no price in %d available territories: %s", label, len(missingPrice), strings.Join(missingPrice, ", ")),
+				Remediation:  "Set a price for these territories or remove them from the subscription's availability",
+			})
+		}
+		if len(missingTier) > 0 {
+			sort.Strings(missingTier)
+			checks = append(checks, CheckResult{
+				ID:           "subscriptions.pricing.tier_missing",
+				Severity:     SeverityWarning,
+				Field:        "subscriptionPricePoint",
+				ResourceType: "subscription",
+				ResourceID:   strings.TrimSpace(sub.ID),
+				ProductID:    strings.TrimSpace(sub.ProductID),
+				Message:      fmt.Sprintf("%s has prices without a price point in: %s", label, strings.Join(missingTier, ", ")),
+				Remediation:  "Re-apply a price point for these territories in App Store Connect",
+			})
+		}
+	}
+	return checks
+}
+
+// subscriptionGracePeriodChecks warns when billing grace period is turned off
+// while the app sells subscriptions, since failed renewals then lapse
+// immediately instead of keeping access during billing retry.
+func subscriptionGracePeriodChecks(subs []Subscription, gracePeriod *SubscriptionGracePeriod) []CheckResult {
+	if gracePeriod == nil || gracePeriod.OptIn {
+		return nil
+	}
+	active := false
+	for _, sub := range subs {
+		if !isSubscriptionRemovedFromSale(sub.State) {
+			active = true
+			break
+		}
+	}
+	if !active {
+		return nil
+	}
+
+	return []CheckResult{{
+		ID:           "subscriptions.grace_period.disabled",
+		Severity:     SeverityWarning,
+		Field:        "optIn",
+		ResourceType: "subscriptionGracePeriod",
+		ResourceID:   strings.TrimSpace(gracePeriod.ID),
+		Message:      "Billing grace period is disabled, so subscribers lose access as soon as a renewal payment fails",
+		Remediation:  fmt.Sprintf("Enable it with: asc subscriptions grace-periods update --id %q --opt-in true --duration SIXTEEN_DAYS", strings.TrimSpace(gracePeriod.ID)),
+	}}
+}
+
+// subscriptionIntroductoryOfferChecks warns about introductory offers that
+// have already ended, and about subscriptions whose localizations advertise a
+// free trial without any current introductory offer.
+func subscriptionIntroductoryOfferChecks(subs []Subscription, asOf time.Time) []CheckResult {
+	var checks []CheckResult
+	today := ""
+	if !asOf.IsZero() {
+		today = asOf.UTC().Format("2006-01-02")
+	}
+
+	for _, sub := range subs {
+		if isSubscriptionRemovedFromSale(sub.State) {
+			continue
+		}
+		label := formatSubscriptionLabel(sub)
+
+		var ended []string
+		current := 0
+		for _, offer := range sub.IntroductoryOffers {
+			endDate := strings.TrimSpace(offer.EndDate)
+			// YYYY-MM-DD dates compare correctly as strings.
+			if today != "" && endDate != "" && endDate < today {
+				ended = append(ended, endDate)
+				continue
+			}
+			current++
+		}
+
+		if len(ended) > 0 {
+			sort.Strings(ended)
+			checks = append(checks, CheckResult{
+				ID:           "subscriptions.intro_offer.expired",
+				Severity:     SeverityWarning,
+				Field:        "endDate",
+				ResourceType: "subscription",
+				ResourceID:   strings.TrimSpace(sub.ID),
+				ProductID:    strings.TrimSpace(sub.ProductID),
+				Message:      fmt.Sprintf("%s has %d introductory offer(s) that already ended (latest %s)", label, len(ended), ended[len(ended)-1]),
+				Remediation:  "Delete the expired offers or create new ones with a future end date",
+			})
+		}
+		if current == 0 && marketsFreeTrial(sub.Localizations) {
+			checks = append(checks, CheckResult{
+				ID:           "subscriptions.intro_offer.missing",
+				Severity:     SeverityWarning,
+				Field:        "introductoryOffers",
+				ResourceType: "subscription",
+				ResourceID:   strings.TrimSpace(sub.ID),
+				ProductID:    strings.TrimSpace(sub.ProductID),
+				Message:      fmt.Sprintf("%s mentions a free trial but has no current introductory offer", label),
+				Remediation:  "Create a FREE_TRIAL introductory offer, or remove the free trial wording from its localizations",
+			})
+		}
+	}
+	return checks
+}
+
+func marketsFreeTrial(localizations []ProductLocalization) bool {
+	for _, loc := range localizations {
+		text := strings.ToLower(loc.Name + " " + loc.Description)
+		if strings.Contains(text, "free trial") {
+			return true
+		}
+	}
+	return false
+}
+
+func isSubscriptionRemovedFromSale(state string) bool {
+	switch strings.ToUpper(strings.TrimSpace(state)) {
+	case "DEVELOPER_REMOVED_FROM_SALE", "REMOVED_FROM_SALE":
+		return true
+	default:
+		return false
+	}
+}
+
 func formatSubscriptionLabel(sub Subscription) string {
 	name := strings.TrimSpace(sub.Name)
 	productID := strings.TrimSpace(sub.ProductID)
@@ -1,6 +1,9 @@
 package validation
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestSubscriptionReviewReadinessChecks_Empty(t *testing.T) {
 	checks := subscriptionReviewReadinessChecks(nil)
@@ -41,3 +44,176 @@ func TestSubscriptionReviewReadinessChecks_IgnoresRemovedFromSale(t *testing.T)
 		t.Fatalf("expected no checks, got %d (%v)", len(checks), checks)
 	}
 }
+
+func TestSubscriptionPricingChecks_SkipsWhenNotChecked(t *testing.T) {
+	checks := subscriptionPricingChecks([]Subscription{
+		{ID: "sub-1", State: "APPROVED", Territories: []string{"USA"}},
+	})
+	if len(checks) != 0 {
+		t.Fatalf("expected no checks, got %v", checks)
+	}
+}
+
+func TestSubscriptionPricingChecks_WarnsForMissingTerritoryAndTier(t *testing.T) {
+	checks := subscriptionPricingChecks([]Subscription{
+		{
+			ID:             "sub-1",
+			ProductID:      "com.example.monthly",
+			State:          "APPROVED",
+			PricingChecked: true,
+			Territories:    []string{"USA", "GBR", "JPN"},
+			Prices: []SubscriptionTerritoryPrice{
+				{Territory: "USA", PricePointID: "pp-usa", CustomerPrice: "4.99"},
+				{Territory: "GBR", PricePointID: "", CustomerPrice: ""},
+			},
+		},
+	})
+	if !hasCheckID(checks, "subscriptions.pricing.territory_missing") || !hasCheckID(checks, "subscriptions.pricing.tier_missing") {
+		t.Fatalf("expected territory and tier warnings, got %v", checks)
+	}
+	for _, check := range checks {
+		if check.Severity != SeverityWarning {
+			t.Fatalf("expected warning severity, got %s", check.Severity)
+		}
+		if check.ID == "subscriptions.pricing.territory_missing" && check.Message != `Subscription com.example.monthly has no price in 2 available territories: GBR, JPN` {
+			t.Fatalf("unexpected message: %q", check.Message)
+		}
+	}
+}
+
+func TestSubscriptionPricingChecks_AllTerritoriesPriced(t *testing.T) {
+	checks := subscriptionPricingChecks([]Subscription{
+		{
+			ID:             "sub-1",
+			State:          "APPROVED",
+			PricingChecked: true,
+			Territories:    []string{"usa"},
+			Prices:         []SubscriptionTerritoryPrice{{Territory: "USA", PricePointID: "pp-usa", CustomerPrice: "4.99"}},
+		},
+	})
+	if len(checks) != 0 {
+		t.Fatalf("expected no checks, got %v", checks)
+	}
+}
+
+func TestSubscriptionMetadataChecks_MissingMetadataIsBlocking(t *testing.T) {
+	checks := subscriptionMetadataChecks([]Subscription{
+		{ID: "sub-1", ProductID: "com.example.monthly", State: "MISSING_METADATA"},
+	}, "en-US")
+	if len(checks) != 1 || checks[0].ID != "subscriptions.metadata.missing" {
+		t.Fatalf("expected missing metadata check, got %v", checks)
+	}
+	if checks[0].Severity != SeverityError {
+		t.Fatalf("expected error severity, got %s", checks[0].Severity)
+	}
+	if checks[0].Message != "Subscription com.example.monthly is MISSING_METADATA: likely no localizations" {
+		t.Fatalf("unexpected message: %q", checks[0].Message)
+	}
+}
+
+func TestSubscriptionMetadataChecks_MissingMetadataWithoutKnownCause(t *testing.T) {
+	checks := subscriptionMetadataChecks([]Subscription{
+		{ID: "sub-1", State: "MISSING_METADATA", Localizations: []ProductLocalization{{Locale: "en-US", Name: "Monthly", Description: "Monthly"}}},
+	}, "en-US")
+	if len(checks) != 1 || checks[0].Message != "Subscription is MISSING_METADATA: check its review screenshot, price, and localizations" {
+		t.Fatalf("unexpected checks: %v", checks)
+	}
+}
+
+func TestSubscriptionMetadataChecks_WaitingForReviewButIncomplete(t *testing.T) {
+	checks := subscriptionMetadataChecks([]Subscription{
+		{ID: "sub-1", State: "WAITING_FOR_REVIEW", Localizations: []ProductLocalization{{Locale: "de-DE"}}, PricingChecked: true, Territories: []string{"USA"}},
+		{ID: "sub-2", State: "WAITING_FOR_REVIEW", Localizations: []ProductLocalization{{Locale: "en-US"}}},
+	}, "en-US")
+	if len(checks) != 1 || checks[0].ID != "subscriptions.metadata.incomplete" || checks[0].ResourceID != "sub-1" {
+		t.Fatalf("expected incomplete check for sub-1 only, got %v", checks)
+	}
+	if checks[0].Message != "Subscription is WAITING_FOR_REVIEW but incomplete: no en-US localization, no price" {
+		t.Fatalf("unexpected message: %q", checks[0].Message)
+	}
+}
+
+func TestSubscriptionReviewReadinessChecks_SkipsMissingMetadata(t *testing.T) {
+	checks := subscriptionReviewReadinessChecks([]Subscription{{ID: "sub-1", State: "MISSING_METADATA"}})
+	if len(checks) != 0 {
+		t.Fatalf("expected MISSING_METADATA to be left to metadata checks, got %v", checks)
+	}
+}
+
+func TestSubscriptionGracePeriodChecks(t *testing.T) {
+	subs := []Subscription{{ID: "sub-1", State: "APPROVED"}}
+
+	if checks := subscriptionGracePeriodChecks(subs, nil); len(checks) != 0 {
+		t.Fatalf("expected no checks for unknown grace period, got %v", checks)
+	}
+	if checks := subscriptionGracePeriodChecks(subs, &SubscriptionGracePeriod{ID: "gp-1", OptIn: true}); len(checks) != 0 {
+		t.Fatalf("expected no checks when enabled, got %v", checks)
+	}
+	if checks := subscriptionGracePeriodChecks([]Subscription{{ID: "sub-1", State: "REMOVED_FROM_SALE"}}, &SubscriptionGracePeriod{ID: "gp-1"}); len(checks) != 0 {
+		t.Fatalf("expected no checks without subscriptions on sale, got %v", checks)
+	}
+
+	checks := subscriptionGracePeriodChecks(subs, &SubscriptionGracePeriod{ID: "gp-1"})
+	if len(checks) != 1 || checks[0].ID != "subscriptions.grace_period.disabled" || checks[0].Severity != SeverityWarning {
+		t.Fatalf("expected grace period warning, got %v", checks)
+	}
+}
+
+func TestSubscriptionIntroductoryOfferChecks_WarnsForEndedOffers(t *testing.T) {
+	asOf := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	checks := subscriptionIntroductoryOfferChecks([]Subscription{
+		{
+			ID:    "sub-1",
+			State: "APPROVED",
+			IntroductoryOffers: []IntroductoryOffer{
+				{ID: "offer-1", EndDate: "2025-12-31"},
+				{ID: "offer-2", EndDate: "2026-02-28"},
+				{ID: "offer-3", EndDate: "2026-03-01"},
+				{ID: "offer-4"},
+			},
+		},
+	}, asOf)
+	if len(checks) != 1 || checks[0].ID != "subscriptions.intro_offer.expired" {
+		t.Fatalf("expected one expired offer check, got %v", checks)
+	}
+	if checks[0].Message != "Subscription has 2 introductory offer(s) that already ended (latest 2026-02-28)" {
+		t.Fatalf("unexpected message: %q", checks[0].Message)
+	}
+}
+
+func TestSubscriptionIntroductoryOfferChecks_FreeTrialWithoutOffer(t *testing.T) {
+	asOf := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	subs := []Subscription{
+		{
+			ID:            "sub-1",
+			State:         "APPROVED",
+			Localizations: []ProductLocalization{{Locale: "en-US", Name: "Pro", Description: "Start your Free Trial today"}},
+			IntroductoryOffers: []IntroductoryOffer{
+				{ID: "offer-1", EndDate: "2026-01-01"},
+			},
+		},
+		{
+			ID:            "sub-2",
+			State:         "APPROVED",
+			Localizations: []ProductLocalization{{Locale: "en-US", Name: "Pro", Description: "Monthly access"}},
+		},
+	}
+	checks := subscriptionIntroductoryOfferChecks(subs, asOf)
+	if !hasCheckID(checks, "subscriptions.intro_offer.missing") {
+		t.Fatalf("expected missing offer check, got %v", checks)
+	}
+	for _, check := range checks {
+		if check.ResourceID != "sub-1" || check.Severity != SeverityWarning {
+			t.Fatalf("unexpected check: %+v", check)
+		}
+	}
+}
+
+func TestSubscriptionIntroductoryOfferChecks_ZeroAsOfSkipsExpiry(t *testing.T) {
+	checks := subscriptionIntroductoryOfferChecks([]Subscription{
+		{ID: "sub-1", State: "APPROVED", IntroductoryOffers: []IntroductoryOffer{{ID: "offer-1", EndDate: "2000-01-01"}}},
+	}, time.Time{})
+	if len(checks) != 0 {
+		t.Fatalf("expected no checks, got %v", checks)
+	}
+}
@@ -12,6 +12,7 @@ func ValidateTestFlight(input TestFlightInput, strict bool) TestFlightReport {
 	checks = append(checks, testflightBuildAppChecks(input.AppID, input.BuildAppID, input.Build)...)
 	checks = append(checks, betaReviewDetailsChecks(input.BetaReviewDetails)...)
 	checks = append(checks, betaWhatsNewChecks(input.AppPrimaryLocale, input.BetaBuildLocalizations)...)
+	sortChecks(checks)
 
 	summary := summarize(checks, strict)
 
@@ -19,6 +19,10 @@ type CheckResult struct {
 	Field        string   `json:"field,omitempty"`
 	ResourceType string   `json:"resourceType,omitempty"`
 	ResourceID   string   `json:"resourceId,omitempty"`
+	ProductID    string   `json:"productId,omitempty"`
+	// Ignored is set when an ignore rule suppressed the finding; ignored
+	// checks are excluded from the summary counts.
+	Ignored bool `json:"ignored,omitempty"`
 }
 
 // Summary aggregates check counts by severity.
@@ -27,6 +31,7 @@ type Summary struct {
 	Warnings int `json:"warnings"`
 	Infos    int `json:"infos"`
 	Blocking int `json:"blocking"`
+	Ignored  int `json:"ignored,omitempty"`
 }
 
 // Report is the top-level validation output.
@@ -47,8 +52,11 @@ type Input struct {
 	VersionID            string
 	VersionString        string
 	VersionState         string
+	AppStoreVersionCount int
 	Platform             string
 	PrimaryLocale        string
+	// Locales scopes localization findings to these locales; empty means all.
+	Locales              []string
 	VersionLocalizations []VersionLocalization
 	AppInfoLocalizations []AppInfoLocalization
 	ReviewDetails        *ReviewDetails
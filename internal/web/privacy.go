@@ -192,34 +192,45 @@ func normalizeNextPath(nextLink, baseURL string) (string, error) {
 }
 
 func (c *Client) listPaginatedResources(ctx context.Context, path, responseName string) ([]jsonAPIResource, error) {
+	payload, err := c.listPaginatedPayload(ctx, path, responseName)
+	if err != nil {
+		return nil, err
+	}
+	return payload.Data, nil
+}
+
+// listPaginatedPayload follows links.next from path and merges every page's
+// data and included resources into one payload.
+func (c *Client) listPaginatedPayload(ctx context.Context, path, responseName string) (jsonAPIListPayload, error) {
 	nextPath := strings.TrimSpace(path)
 	if nextPath == "" {
-		return nil, fmt.Errorf("%s path is required", responseName)
+		return jsonAPIListPayload{}, fmt.Errorf("%s path is required", responseName)
 	}
 
-	allResources := make([]jsonAPIResource, 0, 128)
+	merged := jsonAPIListPayload{Data: make([]jsonAPIResource, 0, 128)}
 	visited := map[string]struct{}{}
 
 	for nextPath != "" {
 		if _, seen := visited[nextPath]; seen {
-			return nil, fmt.Errorf("%s pagination loop detected", responseName)
+			return jsonAPIListPayload{}, fmt.Errorf("%s pagination loop detected", responseName)
 		}
 		visited[nextPath] = struct{}{}
 
 		responseBody, err := c.doRequest(ctx, http.MethodGet, nextPath, nil)
 		if err != nil {
-			return nil, err
+			return jsonAPIListPayload{}, err
 		}
 
 		var payload jsonAPIListPayload
 		if err := json.Unmarshal(responseBody, &payload); err != nil {
-			return nil, fmt.Errorf("failed to parse %s response: %w", responseName, err)
+			return jsonAPIListPayload{}, fmt.Errorf("failed to parse %s response: %w", responseName, err)
 		}
-		allResources = append(allResources, payload.Data...)
+		merged.Data = append(merged.Data, payload.Data...)
+		merged.Included = append(merged.Included, payload.Included...)
 
 		nextLink, err := extractNextLink(payload.Links)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse %s pagination links: %w", responseName, err)
+			return jsonAPIListPayload{}, fmt.Errorf("failed to parse %s pagination links: %w", responseName, err)
 		}
 		if strings.TrimSpace(nextLink) == "" {
 			nextPath = ""
@@ -228,11 +239,11 @@ func (c *Client) listPaginatedResources(ctx context.Context, path, responseName
 
 		nextPath, err = normalizeNextPath(nextLink, c.baseURL)
 		if err != nil {
-			return nil, fmt.Errorf("failed to normalize %s pagination link: %w", responseName, err)
+			return jsonAPIListPayload{}, fmt.Errorf("failed to normalize %s pagination link: %w", responseName, err)
 		}
 	}
 
-	return allResources, nil
+	return merged, nil
 }
 
 func normalizeDataUsageTuple(tuple DataUsageTuple) (DataUsageTuple, error) {
@@ -364,17 +364,35 @@ func decodeReviewSubmissions(resources []jsonAPIResource, included []jsonAPIReso
 	return result
 }
 
+func reviewSubmissionsPath(appID string) string {
+	query := url.Values{}
+	query.Set("include", reviewSubmissionsInclude)
+	query.Set("limit", "2000")
+	query.Set("limit[items]", "0")
+	return queryPath("/apps/"+url.PathEscape(appID)+"/reviewSubmissions", query)
+}
+
+// ListAllReviewSubmissions lists review submissions for an app, following
+// links.next until every page has been fetched.
+func (c *Client) ListAllReviewSubmissions(ctx context.Context, appID string) ([]ReviewSubmission, error) {
+	appID = strings.TrimSpace(appID)
+	if appID == "" {
+		return nil, fmt.Errorf("app id is required")
+	}
+	payload, err := c.listPaginatedPayload(ctx, reviewSubmissionsPath(appID), "review submissions")
+	if err != nil {
+		return nil, err
+	}
+	return decodeReviewSubmissions(payload.Data, payload.Included), nil
+}
+
 // ListReviewSubmissions lists review submissions for a specific app ID.
 func (c *Client) ListReviewSubmissions(ctx context.Context, appID string) ([]ReviewSubmission, error) {
 	appID = strings.TrimSpace(appID)
 	if appID == "" {
 		return nil, fmt.Errorf("app id is required")
 	}
-	query := url.Values{}
-	query.Set("include", reviewSubmissionsInclude)
-	query.Set("limit", "2000")
-	query.Set("limit[items]", "0")
-	path := queryPath("/apps/"+url.PathEscape(appID)+"/reviewSubmissions", query)
+	path := reviewSubmissionsPath(appID)
 
 	responseBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -484,6 +502,47 @@ func (c *Client) ListResolutionCenterThreadsBySubmission(ctx context.Context, re
 	return decodeResolutionCenterThreads(payload.Data), nil
 }
 
+// CreateResolutionCenterMessage posts a developer message to a resolution
+// center thread. messageBody is sent as-is, so callers own any HTML escaping.
+func (c *Client) CreateResolutionCenterMessage(ctx context.Context, threadID, messageBody string) (*ResolutionCenterMessage, error) {
+	threadID = strings.TrimSpace(threadID)
+	if threadID == "" {
+		return nil, fmt.Errorf("thread id is required")
+	}
+	if strings.TrimSpace(messageBody) == "" {
+		return nil, fmt.Errorf("message body is required")
+	}
+
+	requestBody := map[string]any{
+		"data": map[string]any{
+			"type": "resolutionCenterMessages",
+			"attributes": map[string]any{
+				"messageBody": messageBody,
+			},
+			"relationships": map[string]any{
+				"resolutionCenterThread": map[string]any{
+					"data": map[string]any{
+						"type": "resolutionCenterThreads",
+						"id":   threadID,
+					},
+				},
+			},
+		},
+	}
+	responseBody, err := c.doRequest(ctx, http.MethodPost, "/resolutionCenterMessages", requestBody)
+	if err != nil {
+		return nil, err
+	}
+	var payload struct {
+		Data jsonAPIResource `json:"data"`
+	}
+	if err := json.Unmarshal(responseBody, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse create resolution center message response: %w", err)
+	}
+	messages := decodeResolutionCenterMessages([]jsonAPIResource{payload.Data}, nil, false)
+	return &messages[0], nil
+}
+
 func decodeResolutionCenterMessages(resources []jsonAPIResource, included []jsonAPIResource, plainText bool) []ResolutionCenterMessage {
 	if len(resources) == 0 {
 		return []ResolutionCenterMessage{}
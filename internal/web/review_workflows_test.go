@@ -2,6 +2,7 @@ package web
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -428,3 +429,109 @@ func TestDownloadAttachmentRejectsUntrustedHost(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestCreateResolutionCenterMessageBuildsExpectedRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/resolutionCenterMessages" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Data struct {
+				Type       string `json:"type"`
+				Attributes struct {
+					MessageBody string `json:"messageBody"`
+				} `json:"attributes"`
+				Relationships struct {
+					Thread struct {
+						Data struct {
+							Type string `json:"type"`
+							ID   string `json:"id"`
+						} `json:"data"`
+					} `json:"resolutionCenterThread"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body.Data.Type != "resolutionCenterMessages" || body.Data.Attributes.MessageBody != "Fixed in 1.2.4" {
+			t.Fatalf("unexpected message payload: %#v", body.Data)
+		}
+		if body.Data.Relationships.Thread.Data.Type != "resolutionCenterThreads" || body.Data.Relationships.Thread.Data.ID != "thread-1" {
+			t.Fatalf("unexpected thread relationship: %#v", body.Data.Relationships.Thread)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"msg-new","type":"resolutionCenterMessages","attributes":{"messageBody":"Fixed in 1.2.4","createdDate":"2026-03-01T00:00:00Z"}}}`))
+	}))
+	defer server.Close()
+
+	client := testWebClient(server)
+	message, err := client.CreateResolutionCenterMessage(context.Background(), "thread-1", "Fixed in 1.2.4")
+	if err != nil {
+		t.Fatalf("CreateResolutionCenterMessage() error = %v", err)
+	}
+	if message.ID != "msg-new" || message.CreatedDate != "2026-03-01T00:00:00Z" {
+		t.Fatalf("unexpected message: %#v", message)
+	}
+}
+
+func TestCreateResolutionCenterMessageRequiresThreadAndBody(t *testing.T) {
+	client := &Client{httpClient: &http.Client{}, baseURL: "https://example.invalid"}
+	if _, err := client.CreateResolutionCenterMessage(context.Background(), " ", "hello"); err == nil {
+		t.Fatal("expected missing thread error")
+	}
+	if _, err := client.CreateResolutionCenterMessage(context.Background(), "thread-1", " "); err == nil {
+		t.Fatal("expected missing body error")
+	}
+}
+
+func TestListAllReviewSubmissionsFollowsPaginationAndMergesIncluded(t *testing.T) {
+	nextLink := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/iris/v1/apps/app-123/reviewSubmissions" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "page-2" {
+			_, _ = w.Write([]byte(`{
+				"data": [{
+					"id": "sub-2",
+					"type": "reviewSubmissions",
+					"attributes": {"state": "COMPLETE"},
+					"relationships": {"appStoreVersionForReview": {"data": {"type": "appStoreVersions", "id": "v2"}}}
+				}],
+				"included": [{"id":"v2","type":"appStoreVersions","attributes":{"versionString":"1.1.0","platform":"MAC_OS"}}]
+			}`))
+			return
+		}
+		_, _ = w.Write([]byte(strings.ReplaceAll(`{
+			"data": [{
+				"id": "sub-1",
+				"type": "reviewSubmissions",
+				"attributes": {"state": "UNRESOLVED_ISSUES"},
+				"relationships": {"appStoreVersionForReview": {"data": {"type": "appStoreVersions", "id": "v1"}}}
+			}],
+			"included": [{"id":"v1","type":"appStoreVersions","attributes":{"versionString":"1.2.0","platform":"IOS"}}],
+			"links": {"next": "__NEXT__"}
+		}`, "__NEXT__", nextLink)))
+	}))
+	defer server.Close()
+
+	nextLink = server.URL + "/iris/v1/apps/app-123/reviewSubmissions?cursor=page-2"
+
+	client := testWebClient(server)
+	client.baseURL = server.URL + "/iris/v1"
+
+	got, err := client.ListAllReviewSubmissions(context.Background(), "app-123")
+	if err != nil {
+		t.Fatalf("ListAllReviewSubmissions() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected two submissions, got %d", len(got))
+	}
+	if got[1].ID != "sub-2" || got[1].Platform != "MAC_OS" || got[1].AppStoreVersionForReview == nil || got[1].AppStoreVersionForReview.Version != "1.1.0" {
+		t.Fatalf("expected second page to resolve its included version, got %#v", got[1])
+	}
+}
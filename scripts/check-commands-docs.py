@@ -37,7 +37,7 @@ def parse_live_commands(help_text: str) -> set[str]:
 
 def parse_documented_commands(path: Path) -> set[str]:
     text = path.read_text()
-    entries = set(re.findall(r"^- `([a-z0-9-]+)`", text, flags=re.MULTILINE))
+    entries = set(re.findall(r"^- `([a-z0-9][a-z0-9-]*)`", text, flags=re.MULTILINE))
     return {entry for entry in entries if not entry.startswith("--")}
 
 
@@ -69,7 +69,7 @@ def parse_help(help_text: str) -> tuple[str, list[tuple[str, str]], list[tuple[s
             groups[current_group_index][1].append((command, description))
             continue
 
-        flag_match = re.match(r"^\s{2}(--[a-z0-9-]+)\s+(.*\S)\s*$", line)
+        flag_match = re.match(r"^\s{2}(--?[a-z0-9-]+)\s+(.*\S)\s*$", line)
         if flag_match and in_flags:
             flag, description = flag_match.group(1), flag_match.group(2)
             flags.append((flag, description))
@@ -129,6 +129,12 @@ def render(usage: str, flags: list[tuple[str, str]], groups: list[tuple[str, lis
             "",
             "- JSON output is minified by default and optimized for machine parsing.",
             "- Use `--output table` or `--output markdown` for human-readable output.",
+            "- Use `--output yaml` when piping into YAML-based tooling.",
+            "- Use `--output csv` on list commands to export rows to spreadsheets.",
+            "- Use `--select data.0.attributes.name` to print a single value from JSON output.",
+            "- Use `--count` on list commands to append a `{\"count\":N,\"pages\":P}` summary line.",
+            "- Use `--filter attributes.processingState=VALID` (also `!=` and `~=` for contains; repeat to AND) to filter list items client-side.",
+            "- Use `--sort-by attributes.uploadedDate` (with `--sort-desc`) to sort list items client-side by numbers, dates, or text.",
             "- Use `--paginate` on list commands to fetch all pages automatically.",
             "- Use `--limit` and `--next` for manual pagination control.",
             "- Prefer explicit flags and deterministic outputs in CI scripts.",